@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/article"
+	repository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/article"
+	auditRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/audit"
+	mediaRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/media"
+	outboxRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/outbox"
+	seriesRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/series"
+	usecase "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/usecase/article"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/audit"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/tenant"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/transaction"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// runImportCommand menjalankan bulk import dari CLI
+// contoh: go run ./cmd/api import -file articles.csv -tenant brand-a -dry-run
+func runImportCommand(args []string, config *viper.Viper, DB *gorm.DB, log *zap.Logger) error {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	filePath := flags.String("file", "", "path to csv or ndjson file")
+	format := flags.String("format", "", "file format: csv or ndjson (default from file extension)")
+	dryRun := flags.Bool("dry-run", false, "validate rows without saving")
+	tenantID := flags.String("tenant", tenant.NewResolver(config).Default, "tenant id the articles belong to")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *filePath == "" {
+		flags.Usage()
+		return fmt.Errorf("flag -file is required")
+	}
+
+	importTenant, err := tenant.Normalize(*tenantID)
+	if err != nil {
+		return fmt.Errorf("flag -tenant: %w", err)
+	}
+
+	importFormat, err := dto.DetectImportFormat(*format, *filePath)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(*filePath)
+	if err != nil {
+		return fmt.Errorf("failed to open import file: %w", err)
+	}
+	defer file.Close()
+
+	payload, err := dto.ParseImportFile(file, importFormat)
+	if err != nil {
+		return fmt.Errorf("failed to parse import file: %w", err)
+	}
+
+	articleRepo := repository.NewArticleRepository(DB, log)
+	mediaRepo := mediaRepository.NewMediaRepository(DB, log)
+	seriesRepo := seriesRepository.NewSeriesRepository(DB, log)
+	auditRepo := auditRepository.NewAuditRepository(DB, log)
+	outboxRepo := outboxRepository.NewOutboxRepository(DB, log)
+	articleUsecase := usecase.NewArticleUsecase(articleRepo, mediaRepo, seriesRepo, auditRepo, outboxRepo, transaction.NewTransactor(DB), nil, config, log)
+
+	// Audit log mencatat import dari CLI atas nama user OS
+	ctx := tenant.WithTenant(context.Background(), importTenant)
+	ctx = audit.WithMetadata(ctx, audit.Metadata{Actor: "cli:" + os.Getenv("USER")})
+
+	report, err := articleUsecase.Import(ctx, payload, *dryRun)
+	if err != nil {
+		return err
+	}
+
+	// Print report sebagai JSON supaya bisa di pipe ke tool lain
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
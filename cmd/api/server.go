@@ -1,37 +1,39 @@
 package main
 
 import (
+	"context"
 	"os"
+	"time"
 
 	"github.com/enrichoalkalas01/test-sharing-vision-golang/configs"
 	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/database"
 	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/metrics"
 	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/servers"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/tenant"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/tracing"
 	"go.uber.org/zap"
 )
 
 func main() {
-	// Step 1
-	log, err := logger.NewLogger(logger.Config{
-		Environtment: getEnv("APP_ENV", "development"),
-		LogLevel:     getEnv("LOG_LEVEL", "info"),
-		OutputPath:   "stdout",
-	})
+	// Step 1, konfigurasi di baca lebih dulu karena logger memakai LOG_*
+	configEnv, err := configs.NewViper(".env", "env", ".", "../../")
+	if err != nil {
+		panic("Failed to load configuration: " + err.Error())
+	}
 
+	// Step 2
+	log, err := logger.NewLogger(logger.ConfigFromViper(configEnv))
 	if err != nil {
 		panic("Failed to init logger: " + err.Error())
 	}
 
-	defer log.Sync()
+	defer log.Close()
 
-	log.Info("Starting application...")
+	// Fallback logger.FromContext untuk context di luar request (worker, import CLI)
+	zap.ReplaceGlobals(log.Logger)
 
-	// Step 2
-	configEnv, err := configs.NewViper(".env", "env", ".", "../../")
-	if err != nil {
-		log.Fatal("Failed to load configuration", zap.Error(err))
-		panic(err)
-	}
+	log.Info("Starting application...")
 
 	log.Info("ENV configuration loaded successfully",
 		zap.String("app_name", configEnv.GetString("APP_NAME")),
@@ -39,6 +41,20 @@ func main() {
 		zap.String("app_version", configEnv.GetString("APP_VERSION")),
 	)
 
+	// Tracing, exporter dipilih dari TRACING_EXPORTER (none | stdout | otlp)
+	shutdownTracing, err := tracing.Setup(configEnv, log.Logger)
+	if err != nil {
+		log.Fatal("Failed to init tracing", zap.Error(err))
+		panic(err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			log.Error("failed to flush traces", zap.Error(err))
+		}
+	}()
+
 	// Step 3 Init Mysql
 	mysql, err := database.NewMySQL(configEnv, log.Logger)
 	if err != nil {
@@ -48,8 +64,34 @@ func main() {
 
 	log.Info("mysql connected successfully")
 
+	// Semua query ke tabel tenant otomatis di filter tenant_id dari context
+	if err := tenant.RegisterScope(mysql, tenant.ScopedTables...); err != nil {
+		log.Fatal("failed to register tenant scope", zap.Error(err))
+		panic(err)
+	}
+
+	// Span untuk setiap statement SQL
+	if err := tracing.RegisterGorm(mysql, "mysql"); err != nil {
+		log.Error("failed to register database tracing", zap.Error(err))
+	}
+
+	// Durasi query dan statistik connection pool untuk /metrics
+	if !configEnv.IsSet("METRICS_ENABLED") || configEnv.GetBool("METRICS_ENABLED") {
+		if err := metrics.RegisterGorm(mysql, "mysql"); err != nil {
+			log.Error("failed to register database metrics", zap.Error(err))
+		}
+	}
+
+	// CLI subcommand, contoh: server import -file articles.csv -dry-run
+	if len(os.Args) > 1 && os.Args[1] == "import" {
+		if err := runImportCommand(os.Args[2:], configEnv, mysql, log.Logger); err != nil {
+			log.Fatal("Failed to import articles", zap.Error(err))
+		}
+		return
+	}
+
 	// Init Echo Server
-	server := servers.NewFiberServer(configEnv, log.Logger, mysql)
+	server := servers.NewFiberServer(configEnv, log.Logger, log.Level, mysql)
 	server.SetupMiddlewares()
 	server.SetupRoutes()
 
@@ -57,12 +99,3 @@ func main() {
 		log.Fatal("Failed to start server", zap.Error(err))
 	}
 }
-
-// Helper Get Env
-func getEnv(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
-	}
-
-	return defaultValue
-}
@@ -2,30 +2,83 @@ package domain
 
 import (
 	"errors"
+	"html"
+	"regexp"
+	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultExcerptLength = 200
+	// MaxExcerptLength kolom excerpt VARCHAR(500), dikurangi satu untuk karakter "…"
+	MaxExcerptLength = 499
+	WordsPerMinute   = 200
 )
 
+var htmlTagPattern = regexp.MustCompile(`<[^>]*>`)
+
 type Article struct {
-	ID          uint
-	Title       string
-	Content     string
-	Category    string
-	CreatedDate time.Time
-	UpdatedDate time.Time
-	Status      string
+	ID            uint
+	TenantID      string
+	Title         string
+	Slug          string
+	Content       string
+	ContentFormat string
+	ContentHTML   string
+	Excerpt       string
+	WordCount     int
+	ReadingTime   int // dalam menit
+	CoverMediaID  *uint
+	ApprovedAt    *time.Time // di isi ketika semua reviewer di round terakhir approve
+	Category      string
+	CreatedDate   time.Time
+	UpdatedDate   time.Time
+	Status        string
+
+	// Locale konten yang sedang ditampilkan, kosong berarti default locale
+	Locale string `gorm:"-"`
+
+	// Relasi media, di load terpisah oleh usecase
+	Cover       *Media  `gorm:"-"`
+	Attachments []Media `gorm:"-"`
+
+	// Navigasi series, hanya di isi jika request detail membawa konteks series
+	Series *SeriesNavigation `gorm:"-"`
 }
 
 type ArticleStatus string
 
 const (
-	StatusPublish ArticleStatus = "Publish"
-	StatusDraft   ArticleStatus = "Draft"
-	StatusTrash   ArticleStatus = "Thrash"
+	StatusPublish  ArticleStatus = "Publish"
+	StatusDraft    ArticleStatus = "Draft"
+	StatusInReview ArticleStatus = "In Review"
+	StatusTrash    ArticleStatus = "Thrash"
 )
 
+type ContentFormat string
+
+const (
+	FormatMarkdown ContentFormat = "markdown"
+	FormatHTML     ContentFormat = "html"
+	FormatPlain    ContentFormat = "plain"
+)
+
+func (f ContentFormat) IsValid() bool {
+	switch f {
+	case FormatMarkdown, FormatHTML, FormatPlain:
+		return true
+	default:
+		return false
+	}
+}
+
 func (s ArticleStatus) IsValid() bool {
 	switch s {
-	case StatusPublish, StatusDraft, StatusTrash:
+	case StatusPublish, StatusDraft, StatusInReview, StatusTrash:
 		return true
 	default:
 		return false
@@ -53,6 +106,11 @@ func (a *Article) Validate() error {
 		return errors.New("category is required")
 	}
 
+	if a.ContentFormat != "" && !ContentFormat(a.ContentFormat).IsValid() {
+		return errors.New("invalid content format, must be one of: markdown, html, plain")
+	}
+
+	// "In Review" tidak bisa di set langsung, hanya lewat submit review
 	validStatuses := map[string]bool{
 		"Publish": true,
 		"Draft":   true,
@@ -65,6 +123,17 @@ func (a *Article) Validate() error {
 	return nil
 }
 
+// ArticleSetVersion penanda sekumpulan artikel (misal semua yang published), dipakai cache untuk
+// tahu apakah ada perubahan tanpa membaca ulang semua artikel
+type ArticleSetVersion struct {
+	Count       int64
+	LastUpdated time.Time
+}
+
+func (v ArticleSetVersion) Equal(other ArticleSetVersion) bool {
+	return v.Count == other.Count && v.LastUpdated.Equal(other.LastUpdated)
+}
+
 func (a *Article) IsPublished() bool {
 	return a.Status == string(StatusPublish)
 }
@@ -73,6 +142,94 @@ func (a *Article) IsDraft() bool {
 	return a.Status == string(StatusDraft)
 }
 
+func (a *Article) IsInReview() bool {
+	return a.Status == string(StatusInReview)
+}
+
+func (a *Article) IsApproved() bool {
+	return a.ApprovedAt != nil
+}
+
 func (a *Article) IsInTrash() bool {
 	return a.Status == string(StatusTrash)
 }
+
+// Slugify build url friendly slug dari title, contoh "Hello World!" -> "hello-world".
+// Title tanpa huruf / angka (misal "???") dapat slug acak supaya tidak bentrok di unique slug
+func Slugify(title string) string {
+	var b strings.Builder
+	lastDash := true
+
+	for _, r := range strings.ToLower(strings.TrimSpace(title)) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+			lastDash = false
+			continue
+		}
+
+		if !lastDash {
+			b.WriteRune('-')
+			lastDash = true
+		}
+	}
+
+	slug := strings.TrimSuffix(b.String(), "-")
+	if slug == "" {
+		return "untitled-" + strings.ReplaceAll(uuid.NewString(), "-", "")[:12]
+	}
+
+	return slug
+}
+
+func (a *Article) EnsureSlug() {
+	if a.Slug == "" {
+		a.Slug = Slugify(a.Title)
+	}
+}
+
+// ComputeMetadata hitung excerpt, word count dan reading time dari content,
+// pakai ContentHTML kalau ada supaya markup markdown tidak ikut terhitung
+func (a *Article) ComputeMetadata(excerptLength int) {
+	if excerptLength <= 0 {
+		excerptLength = DefaultExcerptLength
+	}
+	if excerptLength > MaxExcerptLength {
+		excerptLength = MaxExcerptLength
+	}
+
+	source := a.ContentHTML
+	if source == "" {
+		source = a.Content
+	}
+
+	text := strings.Join(strings.Fields(StripMarkup(source)), " ")
+
+	a.WordCount = len(strings.Fields(text))
+	a.ReadingTime = 0
+	if a.WordCount > 0 {
+		a.ReadingTime = (a.WordCount + WordsPerMinute - 1) / WordsPerMinute
+	}
+	a.Excerpt = truncateWords(text, excerptLength)
+}
+
+// StripMarkup buang tag html dan decode entity menjadi plain text
+func StripMarkup(content string) string {
+	return html.UnescapeString(htmlTagPattern.ReplaceAllString(content, " "))
+}
+
+// truncateWords potong text maksimal limit karakter tanpa memotong di tengah kata
+func truncateWords(text string, limit int) string {
+	if utf8.RuneCountInString(text) <= limit {
+		return text
+	}
+
+	runes := []rune(text)
+	cut := string(runes[:limit])
+	if idx := strings.LastIndexFunc(cut, unicode.IsSpace); idx > 0 && !unicode.IsSpace(runes[limit]) {
+		cut = cut[:idx]
+	}
+
+	return strings.TrimRightFunc(cut, func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsPunct(r)
+	}) + "…"
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		name  string
+		title string
+		want  string
+	}{
+		{name: "words and punctuation", title: "Hello World!", want: "hello-world"},
+		{name: "surrounding spaces", title: "  Belajar Go  ", want: "belajar-go"},
+		{name: "repeated separators collapse", title: "Go -- Fiber & GORM", want: "go-fiber-gorm"},
+		{name: "digits are kept", title: "Top 10 Tips 2026", want: "top-10-tips-2026"},
+		{name: "unicode letters are kept", title: "Café Déjà Vu", want: "café-déjà-vu"},
+		{name: "leading punctuation", title: "...Halo Dunia", want: "halo-dunia"},
+		{name: "trailing punctuation", title: "Selesai?!", want: "selesai"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Slugify(tt.title); got != tt.want {
+				t.Errorf("Slugify(%q) = %q, want %q", tt.title, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSlugifyFallback(t *testing.T) {
+	fallback := regexp.MustCompile(`^untitled-[0-9a-f]{12}$`)
+
+	for _, title := range []string{"", "   ", "???", "!!! ---"} {
+		t.Run(title, func(t *testing.T) {
+			first, second := Slugify(title), Slugify(title)
+			if !fallback.MatchString(first) {
+				t.Fatalf("Slugify(%q) = %q, want untitled-<12 hex>", title, first)
+			}
+			if first == second {
+				t.Errorf("Slugify(%q) returned the same fallback twice: %q", title, first)
+			}
+		})
+	}
+}
+
+func TestTruncateWords(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		limit int
+		want  string
+	}{
+		{name: "shorter than limit", text: "hello world", limit: 20, want: "hello world"},
+		{name: "exactly the limit", text: "hello", limit: 5, want: "hello"},
+		{name: "cut inside a word", text: "hello world foo", limit: 8, want: "hello…"},
+		{name: "cut on a word boundary", text: "hello world foo", limit: 11, want: "hello world…"},
+		{name: "trailing punctuation trimmed", text: "hello, world", limit: 6, want: "hello…"},
+		{name: "single long word", text: "supercalifragilistic", limit: 5, want: "super…"},
+		{name: "multibyte runes", text: "héllo wörld", limit: 8, want: "héllo…"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := truncateWords(tt.text, tt.limit); got != tt.want {
+				t.Errorf("truncateWords(%q, %d) = %q, want %q", tt.text, tt.limit, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestComputeMetadataExcerptLength(t *testing.T) {
+	content := strings.Repeat("kata ", 400)
+
+	tests := []struct {
+		name          string
+		excerptLength int
+		wantMax       int
+	}{
+		{name: "default when zero", excerptLength: 0, wantMax: DefaultExcerptLength + 1},
+		{name: "configured length", excerptLength: 50, wantMax: 51},
+		{name: "clamped to column size", excerptLength: 10000, wantMax: MaxExcerptLength + 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			article := &Article{Content: content}
+			article.ComputeMetadata(tt.excerptLength)
+
+			// +1 untuk ellipsis
+			if got := utf8.RuneCountInString(article.Excerpt); got > tt.wantMax {
+				t.Errorf("excerpt length = %d, want at most %d", got, tt.wantMax)
+			}
+			if article.WordCount != 400 {
+				t.Errorf("word count = %d, want 400", article.WordCount)
+			}
+			if article.ReadingTime != 2 {
+				t.Errorf("reading time = %d, want 2", article.ReadingTime)
+			}
+		})
+	}
+}
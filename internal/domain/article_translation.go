@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+type ArticleTranslation struct {
+	ID            uint
+	TenantID      string
+	ArticleID     uint
+	Locale        string
+	Title         string
+	Content       string
+	ContentFormat string
+	ContentHTML   string
+	Excerpt       string
+	WordCount     int
+	ReadingTime   int
+	Status        string
+	CreatedDate   time.Time
+	UpdatedDate   time.Time
+}
+
+func (t *ArticleTranslation) Validate() error {
+	if t.Locale == "" {
+		return errors.New("locale is required")
+	}
+
+	if len(t.Title) < 3 || len(t.Title) > 200 {
+		return errors.New("title must be between 3 and 200 characters")
+	}
+
+	if len(t.Content) < 10 {
+		return errors.New("content must be at least 10 characters")
+	}
+
+	if t.ContentFormat != "" && !ContentFormat(t.ContentFormat).IsValid() {
+		return errors.New("invalid content format, must be one of: markdown, html, plain")
+	}
+
+	if !ArticleStatus(t.Status).IsValid() {
+		return errors.New("invalid status, must be one of: Publish, Draft, Thrash")
+	}
+
+	return nil
+}
+
+// ApplyTo timpa field yang di terjemahkan pada article
+func (t *ArticleTranslation) ApplyTo(article *Article) {
+	article.Locale = t.Locale
+	article.Title = t.Title
+	article.Content = t.Content
+	article.ContentFormat = t.ContentFormat
+	article.ContentHTML = t.ContentHTML
+	article.Excerpt = t.Excerpt
+	article.WordCount = t.WordCount
+	article.ReadingTime = t.ReadingTime
+	article.Status = t.Status
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// AuditLog satu perubahan data. Tabel audit hanya boleh di insert, tidak pernah di update / delete
+type AuditLog struct {
+	ID          uint
+	TenantID    string
+	Actor       string
+	RequestID   string
+	IP          string
+	Action      string
+	Entity      string
+	EntityID    uint
+	Before      json.RawMessage // kosong untuk create
+	After       json.RawMessage // kosong untuk delete
+	CreatedDate time.Time
+}
+
+type AuditAction string
+
+const (
+	AuditCreate AuditAction = "create"
+	AuditUpdate AuditAction = "update"
+	AuditDelete AuditAction = "delete"
+)
+
+func (a AuditAction) IsValid() bool {
+	switch a {
+	case AuditCreate, AuditUpdate, AuditDelete:
+		return true
+	}
+	return false
+}
+
+// Entity yang dicatat di audit log
+const AuditEntityArticle = "article"
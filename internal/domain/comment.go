@@ -0,0 +1,40 @@
+package domain
+
+import "time"
+
+type Comment struct {
+	ID          uint
+	TenantID    string
+	ArticleID   uint
+	ParentID    *uint
+	AuthorName  string
+	AuthorEmail string
+	Content     string
+	Status      string
+	CreatedDate time.Time
+	UpdatedDate time.Time
+
+	// Balasan yang sudah approved, di susun oleh usecase
+	Replies []Comment `gorm:"-"`
+}
+
+type CommentStatus string
+
+const (
+	CommentPending  CommentStatus = "pending"
+	CommentApproved CommentStatus = "approved"
+	CommentRejected CommentStatus = "rejected"
+)
+
+func (s CommentStatus) IsValid() bool {
+	switch s {
+	case CommentPending, CommentApproved, CommentRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *Comment) IsApproved() bool {
+	return CommentStatus(c.Status) == CommentApproved
+}
@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+type Media struct {
+	ID           uint
+	TenantID     string
+	FileName     string
+	ContentType  string
+	Size         int64
+	StorageKey   string
+	URL          string
+	ThumbnailKey string
+	ThumbnailURL string
+	Width        int
+	Height       int
+	CreatedDate  time.Time
+}
+
+func (m *Media) IsImage() bool {
+	return strings.HasPrefix(m.ContentType, "image/")
+}
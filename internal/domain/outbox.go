@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// OutboxEvent domain event yang ditulis dalam transaction yang sama dengan perubahan data,
+// lalu dikirim oleh relay. PublishedAt terisi setelah terkirim, FailedAt terisi setelah
+// melewati batas retry (dead letter)
+type OutboxEvent struct {
+	ID            uint
+	EventID       string
+	TenantID      string
+	EventType     string
+	AggregateType string
+	AggregateID   uint
+	Payload       json.RawMessage
+	OccurredAt    time.Time
+	Attempts      int
+	NextAttemptAt time.Time
+	PublishedAt   *time.Time
+	FailedAt      *time.Time
+	LastError     string
+}
@@ -0,0 +1,36 @@
+package domain
+
+import "time"
+
+// Review satu permintaan review untuk satu reviewer. Setiap submit membuat round baru
+type Review struct {
+	ID          uint
+	ArticleID   uint
+	Round       int
+	Author      string
+	Reviewer    string
+	Status      string
+	Note        string // catatan author ketika submit
+	Comment     string // komentar reviewer ketika approve / request changes
+	CreatedDate time.Time
+	DecidedDate *time.Time
+}
+
+type ReviewStatus string
+
+const (
+	ReviewPending          ReviewStatus = "pending"
+	ReviewApproved         ReviewStatus = "approved"
+	ReviewChangesRequested ReviewStatus = "changes_requested"
+	ReviewCancelled        ReviewStatus = "cancelled"
+)
+
+func (r *Review) IsPending() bool {
+	return ReviewStatus(r.Status) == ReviewPending
+}
+
+// ReviewTransition status artikel setelah satu langkah review, ApprovedAt nil berarti approval dihapus
+type ReviewTransition struct {
+	Status     ArticleStatus
+	ApprovedAt *time.Time
+}
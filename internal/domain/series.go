@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+type Series struct {
+	ID          uint
+	TenantID    string
+	Title       string
+	Slug        string
+	Description string
+	CreatedDate time.Time
+	UpdatedDate time.Time
+
+	// Anggota series sesuai urutan, di load terpisah oleh usecase
+	Articles []Article `gorm:"-"`
+}
+
+// SeriesNavigation posisi sebuah article di dalam series beserta link sebelum / sesudahnya
+type SeriesNavigation struct {
+	SeriesID uint
+	Title    string
+	Slug     string
+	Position int
+	Total    int
+	Previous *Article
+	Next     *Article
+}
+
+func (s *Series) Validate() error {
+	if s.Title == "" {
+		return errors.New("title is required")
+	}
+
+	if len(s.Title) < 3 || len(s.Title) > 200 {
+		return errors.New("title must be between 3 and 200 characters")
+	}
+
+	return nil
+}
+
+func (s *Series) EnsureSlug() {
+	if s.Slug == "" {
+		s.Slug = Slugify(s.Title)
+	}
+}
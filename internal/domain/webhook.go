@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+)
+
+// WildcardEvent subscribe ke semua tipe event
+const WildcardEvent = "*"
+
+// WebhookSubscription endpoint partner yang menerima event artikel
+type WebhookSubscription struct {
+	ID          uint
+	TenantID    string
+	URL         string
+	EventTypes  []string `gorm:"serializer:json"`
+	Secret      string
+	Active      bool
+	CreatedDate time.Time
+	UpdatedDate time.Time
+}
+
+func (s *WebhookSubscription) Matches(eventType string) bool {
+	return s.Active && (slices.Contains(s.EventTypes, WildcardEvent) || slices.Contains(s.EventTypes, eventType))
+}
+
+type DeliveryStatus string
+
+const (
+	DeliveryPending   DeliveryStatus = "pending" // menunggu dikirim atau di retry
+	DeliveryDelivered DeliveryStatus = "delivered"
+	DeliveryDead      DeliveryStatus = "dead" // retry habis, hanya bisa dikirim ulang lewat redeliver
+)
+
+func (s DeliveryStatus) IsValid() bool {
+	switch s {
+	case DeliveryPending, DeliveryDelivered, DeliveryDead:
+		return true
+	}
+	return false
+}
+
+// WebhookDelivery satu event untuk satu subscription. Payload adalah body yang dikirim apa adanya,
+// sehingga redeliver mengirim body dan signature yang sama
+type WebhookDelivery struct {
+	ID             uint
+	TenantID       string
+	SubscriptionID uint
+	EventID        string
+	EventType      string
+	Payload        json.RawMessage
+	Status         string
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastStatusCode int
+	LastError      string
+	DeliveredAt    *time.Time
+	CreatedDate    time.Time
+	UpdatedDate    time.Time
+}
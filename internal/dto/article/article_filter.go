@@ -2,6 +2,14 @@ package dto
 
 import "github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/filter"
 
+// Status yang boleh dipakai sebagai filter list dan stream
+var filterStatuses = map[string]bool{
+	"Publish":   true,
+	"Draft":     true,
+	"In Review": true,
+	"Thrash":    true,
+}
+
 type ArticleFilter struct {
 	filter.BaseFilter[ArticleFilterFields]
 }
@@ -9,6 +17,7 @@ type ArticleFilter struct {
 type ArticleFilterFields struct {
 	Category string `query:"category"`
 	Status   string `query:"status"`
+	Locale   string `query:"lang"` // di isi handler hasil negosiasi locale
 }
 
 func NewArticleFilter() *ArticleFilter {
@@ -29,15 +38,8 @@ func (af *ArticleFilter) Validate() error {
 	}
 
 	// Validasi status jika ada
-	if af.Filters.Status != "" {
-		validStatuses := map[string]bool{
-			"Publish": true,
-			"Draft":   true,
-			"Thrash":  true,
-		}
-		if !validStatuses[af.Filters.Status] {
-			return ErrInvalidFilterStatus
-		}
+	if af.Filters.Status != "" && !filterStatuses[af.Filters.Status] {
+		return ErrInvalidFilterStatus
 	}
 
 	return nil
@@ -51,6 +53,10 @@ func (af *ArticleFilter) GetStatus() string {
 	return af.Filters.Status
 }
 
+func (af *ArticleFilter) GetLocale() string {
+	return af.Filters.Locale
+}
+
 func (af *ArticleFilter) HasCategory() bool {
 	return af.GetCategory() != ""
 }
@@ -0,0 +1,270 @@
+package dto
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/apperror"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/validation"
+)
+
+type ImportFormat string
+
+const (
+	ImportFormatCSV    ImportFormat = "csv"
+	ImportFormatNDJSON ImportFormat = "ndjson"
+)
+
+type ImportJobStatus string
+
+const (
+	ImportJobPending   ImportJobStatus = "pending"
+	ImportJobRunning   ImportJobStatus = "running"
+	ImportJobCompleted ImportJobStatus = "completed"
+	ImportJobFailed    ImportJobStatus = "failed"
+)
+
+// ImportArticleRow satu baris dari file import, Line dipakai untuk error report
+type ImportArticleRow struct {
+	Line int    `json:"-"`
+	Slug string `json:"slug"`
+	CreateArticleRequest
+}
+
+// ImportPayload hasil parse file import, baris yang gagal di parse disimpan di ParseErrors
+type ImportPayload struct {
+	Rows        []ImportArticleRow
+	ParseErrors []ImportLineError
+}
+
+func (p *ImportPayload) Len() int {
+	return len(p.Rows) + len(p.ParseErrors)
+}
+
+type ImportLineError struct {
+	Line      int       `json:"line"`
+	ErrorCode ErrorCode `json:"error_code"`
+	Message   string    `json:"message"`
+}
+
+type ImportReport struct {
+	DryRun  bool              `json:"dry_run"`
+	Total   int               `json:"total"`
+	Created int               `json:"created"`
+	Updated int               `json:"updated"`
+	Failed  int               `json:"failed"`
+	Errors  []ImportLineError `json:"errors"`
+}
+
+type ImportJob struct {
+	ID         string          `json:"id"`
+	TenantID   string          `json:"-"`
+	Status     ImportJobStatus `json:"status"`
+	DryRun     bool            `json:"dry_run"`
+	TotalRows  int             `json:"total_rows"`
+	Report     *ImportReport   `json:"report,omitempty"`
+	Error      string          `json:"error,omitempty"`
+	CreatedAt  time.Time       `json:"created_at"`
+	FinishedAt *time.Time      `json:"finished_at,omitempty"`
+}
+
+func (r *ImportReport) AddError(line int, err error) {
+	r.Failed++
+	r.Errors = append(r.Errors, ImportLineError{
+		Line:      line,
+		ErrorCode: importErrorCode(err),
+		Message:   importErrorMessage(err),
+	})
+}
+
+// importErrorCode validasi memakai code field pertama yang gagal (misal TITLE_REQUIRED),
+// error domain membawa code sendiri
+func importErrorCode(err error) ErrorCode {
+	var fieldErrs validation.Errors
+	if errors.As(err, &fieldErrs) {
+		return ErrorCode(fieldErrs.Code())
+	}
+
+	if code := apperror.CodeOf(err); code != "" {
+		return ErrorCode(code)
+	}
+
+	return ErrCodeInternalError
+}
+
+// importErrorMessage pesan error domain tanpa cause, error database tidak di tampilkan ke client
+func importErrorMessage(err error) string {
+	var fieldErrs validation.Errors
+	if errors.As(err, &fieldErrs) {
+		return fieldErrs.Error()
+	}
+
+	if appErr, ok := apperror.As(err); ok {
+		return appErr.Message
+	}
+
+	return "failed to import row"
+}
+
+// DetectImportFormat ambil format dari parameter, kalau kosong fallback ke extension file
+func DetectImportFormat(format, filename string) (ImportFormat, error) {
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(filename)) {
+		case ".csv":
+			format = string(ImportFormatCSV)
+		case ".ndjson", ".jsonl":
+			format = string(ImportFormatNDJSON)
+		}
+	}
+
+	switch ImportFormat(strings.ToLower(format)) {
+	case ImportFormatCSV:
+		return ImportFormatCSV, nil
+	case ImportFormatNDJSON, "jsonl":
+		return ImportFormatNDJSON, nil
+	default:
+		return "", ErrImportUnsupportedFormat
+	}
+}
+
+// ParseImportFile parse isi file menjadi rows, baris yang gagal di parse tidak menghentikan proses
+func ParseImportFile(r io.Reader, format ImportFormat) (*ImportPayload, error) {
+	var rows []ImportArticleRow
+	var lineErrors []ImportLineError
+	var err error
+
+	switch format {
+	case ImportFormatCSV:
+		rows, lineErrors, err = parseImportCSV(r)
+	case ImportFormatNDJSON:
+		rows, lineErrors, err = parseImportNDJSON(r)
+	default:
+		return nil, ErrImportUnsupportedFormat
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &ImportPayload{Rows: rows, ParseErrors: lineErrors}
+	if payload.Len() == 0 {
+		return nil, ErrImportEmpty
+	}
+
+	return payload, nil
+}
+
+func parseImportCSV(r io.Reader) ([]ImportArticleRow, []ImportLineError, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil, ErrImportEmpty
+		}
+		return nil, nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	for _, required := range []string{"title", "content", "category", "status"} {
+		if _, ok := columns[required]; !ok {
+			return nil, nil, ErrImportInvalidHeader
+		}
+	}
+
+	get := func(record []string, name string) string {
+		idx, ok := columns[name]
+		if !ok || idx >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[idx])
+	}
+
+	var rows []ImportArticleRow
+	var lineErrors []ImportLineError
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			line := 0
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				line = parseErr.Line
+			}
+			lineErrors = append(lineErrors, ImportLineError{
+				Line:      line,
+				ErrorCode: ErrCodeValidation,
+				Message:   err.Error(),
+			})
+			continue
+		}
+
+		line, _ := reader.FieldPos(0)
+		rows = append(rows, ImportArticleRow{
+			Line: line,
+			Slug: get(record, "slug"),
+			CreateArticleRequest: CreateArticleRequest{
+				Title:         get(record, "title"),
+				Content:       get(record, "content"),
+				ContentFormat: get(record, "content_format"),
+				Category:      get(record, "category"),
+				Status:        get(record, "status"),
+			},
+		})
+	}
+
+	return rows, lineErrors, nil
+}
+
+func parseImportNDJSON(r io.Reader) ([]ImportArticleRow, []ImportLineError, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+
+	var rows []ImportArticleRow
+	var lineErrors []ImportLineError
+
+	line := 0
+	for scanner.Scan() {
+		line++
+
+		raw := strings.TrimSpace(scanner.Text())
+		if raw == "" {
+			continue
+		}
+
+		var row ImportArticleRow
+		if err := json.Unmarshal([]byte(raw), &row); err != nil {
+			lineErrors = append(lineErrors, ImportLineError{
+				Line:      line,
+				ErrorCode: ErrCodeValidation,
+				Message:   "invalid json: " + err.Error(),
+			})
+			continue
+		}
+
+		row.Line = line
+		rows = append(rows, row)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return rows, lineErrors, nil
+}
@@ -1,73 +1,42 @@
 package dto
 
+import "github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/validation"
+
 type CreateArticleRequest struct {
-	Title    string `json:"title" validate:"required,min=3,max=200"`
-	Content  string `json:"content" validate:"required,min=10"`
-	Category string `json:"category" validate:"required,min=3,max=100"`
-	Status   string `json:"status" validate:"required,oneof=Publish Draft Thrash"`
+	Title         string `json:"title" validate:"required,min=3,max=200"`
+	Content       string `json:"content" validate:"required,min=10"`
+	ContentFormat string `json:"content_format" validate:"omitempty,oneof=markdown html plain"`
+	Category      string `json:"category" validate:"required,min=3,max=100"`
+	Status        string `json:"status" validate:"required,oneof=Publish Draft Thrash"`
+	CoverMediaID  *uint  `json:"cover_media_id"`
+	AttachmentIDs []uint `json:"attachment_ids"`
 }
 
 type UpdateArticleRequest struct {
-	Title    string `json:"title" validate:"omitempty,min=3,max=200"`
-	Content  string `json:"content" validate:"omitempty,min=10"`
-	Category string `json:"category" validate:"omitempty,min=3,max=100"`
-	Status   string `json:"status" validate:"omitempty,oneof=Publish Draft Thrash"`
+	Title         string `json:"title" validate:"omitempty,min=3,max=200"`
+	Content       string `json:"content" validate:"omitempty,min=10"`
+	ContentFormat string `json:"content_format" validate:"omitempty,oneof=markdown html plain"`
+	Category      string `json:"category" validate:"omitempty,min=3,max=100"`
+	Status        string `json:"status" validate:"omitempty,oneof=Publish Draft Thrash"`
+	CoverMediaID  *uint  `json:"cover_media_id"`
+	AttachmentIDs []uint `json:"attachment_ids"` // nil berarti tidak diubah, [] berarti hapus semua
 }
 
-func (r *CreateArticleRequest) Validate() error {
-	if r.Title == "" {
-		return ErrTitleRequired
-	}
-	if len(r.Title) < 3 || len(r.Title) > 200 {
-		return ErrTitleLength
-	}
-
-	if r.Content == "" {
-		return ErrContentRequired
-	}
-	if len(r.Content) < 10 {
-		return ErrContentTooShort
-	}
-
-	if r.Category == "" {
-		return ErrCategoryRequired
-	}
-
-	validStatuses := map[string]bool{
-		"Publish": true,
-		"Draft":   true,
-		"Thrash":  true,
-	}
-	if !validStatuses[r.Status] {
-		return ErrInvalidStatus
-	}
+// articleRules error_code per field yang sudah dipakai client sebelum validasi lewat tag
+var articleRules = validation.Rules{
+	"title":          {"required": ErrTitleRequired, "*": ErrTitleLength},
+	"content":        {"required": ErrContentRequired, "*": ErrContentTooShort},
+	"content_format": {"*": ErrInvalidContentFormat},
+	"category":       {"required": ErrCategoryRequired, "*": ErrCategoryLength},
+	"status":         {"*": ErrInvalidStatus},
+	"locale":         {"required": ErrLocaleRequired},
+}
 
-	return nil
+// Validate jalankan tag validate, semua field yang gagal dikembalikan sekaligus sebagai validation.Errors
+func (r *CreateArticleRequest) Validate() error {
+	return validation.StructWithRules(r, articleRules).Err()
 }
 
 func (r *UpdateArticleRequest) Validate() error {
-	if r.Title != "" {
-		if len(r.Title) < 3 || len(r.Title) > 200 {
-			return ErrTitleLength
-		}
-	}
-
-	if r.Content != "" {
-		if len(r.Content) < 10 {
-			return ErrContentTooShort
-		}
-	}
-
-	if r.Status != "" {
-		validStatuses := map[string]bool{
-			"Publish": true,
-			"Draft":   true,
-			"Thrash":  true,
-		}
-		if !validStatuses[r.Status] {
-			return ErrInvalidStatus
-		}
-	}
-
-	return nil
+	return validation.StructWithRules(r, articleRules).Err()
 }
@@ -4,25 +4,59 @@ import (
 	"time"
 
 	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	mediaDto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/media"
 )
 
 type ArticleResponse struct {
-	ID        uint      `json:"id"`
-	Title     string    `json:"title"`
-	Content   string    `json:"content"`
-	Category  string    `json:"category"`
-	Status    string    `json:"status"`
-	CreatedAt time.Time `json:"created_date"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID            uint                      `json:"id"`
+	Title         string                    `json:"title"`
+	Slug          string                    `json:"slug"`
+	Locale        string                    `json:"locale"`
+	Content       string                    `json:"content"`
+	ContentFormat string                    `json:"content_format"`
+	ContentHTML   string                    `json:"content_html"`
+	Excerpt       string                    `json:"excerpt"`
+	WordCount     int                       `json:"word_count"`
+	ReadingTime   int                       `json:"reading_time_minutes"`
+	Cover         *mediaDto.MediaResponse   `json:"cover"`
+	Attachments   []mediaDto.MediaResponse  `json:"attachments"`
+	Series        *SeriesNavigationResponse `json:"series,omitempty"`
+	Category      string                    `json:"category"`
+	Status        string                    `json:"status"`
+	ApprovedAt    *time.Time                `json:"approved_at"`
+	CreatedAt     time.Time                 `json:"created_date"`
+	UpdatedAt     time.Time                 `json:"updated_at"`
 }
 
 type ArticleListResponse struct {
-	ID        uint      `json:"id"`
-	Title     string    `json:"title"`
-	Category  string    `json:"category"`
-	Status    string    `json:"status"`
-	CreatedAt time.Time `json:"created_date"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID          uint      `json:"id"`
+	Title       string    `json:"title"`
+	Slug        string    `json:"slug"`
+	Locale      string    `json:"locale"`
+	Excerpt     string    `json:"excerpt"`
+	WordCount   int       `json:"word_count"`
+	ReadingTime int       `json:"reading_time_minutes"`
+	Category    string    `json:"category"`
+	Status      string    `json:"status"`
+	CreatedAt   time.Time `json:"created_date"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// SeriesNavigationResponse hanya ada jika detail di request dengan ?series=
+type SeriesNavigationResponse struct {
+	ID       uint                 `json:"id"`
+	Title    string               `json:"title"`
+	Slug     string               `json:"slug"`
+	Position int                  `json:"position"`
+	Total    int                  `json:"total"`
+	Previous *ArticleLinkResponse `json:"previous"`
+	Next     *ArticleLinkResponse `json:"next"`
+}
+
+type ArticleLinkResponse struct {
+	ID    uint   `json:"id"`
+	Title string `json:"title"`
+	Slug  string `json:"slug"`
 }
 
 // ============ Mapper Functions ============
@@ -32,13 +66,52 @@ func ToArticleResponse(article *domain.Article) *ArticleResponse {
 	}
 
 	return &ArticleResponse{
-		ID:        article.ID,
-		Title:     article.Title,
-		Content:   article.Content,
-		Category:  article.Category,
-		Status:    article.Status,
-		CreatedAt: article.CreatedDate,
-		UpdatedAt: article.UpdatedDate,
+		ID:            article.ID,
+		Title:         article.Title,
+		Slug:          article.Slug,
+		Locale:        article.Locale,
+		Content:       article.Content,
+		ContentFormat: article.ContentFormat,
+		ContentHTML:   article.ContentHTML,
+		Excerpt:       article.Excerpt,
+		WordCount:     article.WordCount,
+		ReadingTime:   article.ReadingTime,
+		Cover:         mediaDto.ToMediaResponse(article.Cover),
+		Attachments:   mediaDto.ToMediaResponseList(article.Attachments),
+		Series:        ToSeriesNavigationResponse(article.Series),
+		Category:      article.Category,
+		Status:        article.Status,
+		ApprovedAt:    article.ApprovedAt,
+		CreatedAt:     article.CreatedDate,
+		UpdatedAt:     article.UpdatedDate,
+	}
+}
+
+func ToSeriesNavigationResponse(navigation *domain.SeriesNavigation) *SeriesNavigationResponse {
+	if navigation == nil {
+		return nil
+	}
+
+	return &SeriesNavigationResponse{
+		ID:       navigation.SeriesID,
+		Title:    navigation.Title,
+		Slug:     navigation.Slug,
+		Position: navigation.Position,
+		Total:    navigation.Total,
+		Previous: toArticleLinkResponse(navigation.Previous),
+		Next:     toArticleLinkResponse(navigation.Next),
+	}
+}
+
+func toArticleLinkResponse(article *domain.Article) *ArticleLinkResponse {
+	if article == nil {
+		return nil
+	}
+
+	return &ArticleLinkResponse{
+		ID:    article.ID,
+		Title: article.Title,
+		Slug:  article.Slug,
 	}
 }
 
@@ -48,12 +121,17 @@ func ToArticleListResponse(article *domain.Article) *ArticleListResponse {
 	}
 
 	return &ArticleListResponse{
-		ID:        article.ID,
-		Title:     article.Title,
-		Category:  article.Category,
-		Status:    article.Status,
-		CreatedAt: article.CreatedDate,
-		UpdatedAt: article.UpdatedDate,
+		ID:          article.ID,
+		Title:       article.Title,
+		Slug:        article.Slug,
+		Locale:      article.Locale,
+		Excerpt:     article.Excerpt,
+		WordCount:   article.WordCount,
+		ReadingTime: article.ReadingTime,
+		Category:    article.Category,
+		Status:      article.Status,
+		CreatedAt:   article.CreatedDate,
+		UpdatedAt:   article.UpdatedDate,
 	}
 }
 
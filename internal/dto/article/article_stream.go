@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"encoding/json"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/events"
+)
+
+// StreamFilter filter event untuk SSE stream, kosong berarti semua
+type StreamFilter struct {
+	Category string
+	Status   string
+	TenantID string // di isi handler dari context, event tenant lain tidak pernah dikirim
+}
+
+func (sf *StreamFilter) Validate() error {
+	if sf.Status != "" && !filterStatuses[sf.Status] {
+		return ErrInvalidFilterStatus
+	}
+
+	return nil
+}
+
+// Matches cek event terhadap filter. Category dan status diambil dari payload, untuk
+// article.deleted payload berisi state terakhir sebelum dihapus
+func (sf *StreamFilter) Matches(event events.Event) bool {
+	if event.TenantID != sf.TenantID || event.AggregateType != events.AggregateArticle {
+		return false
+	}
+
+	if sf.Category == "" && sf.Status == "" {
+		return true
+	}
+
+	var article struct {
+		Category string `json:"category"`
+		Status   string `json:"status"`
+	}
+	if err := json.Unmarshal(event.Payload, &article); err != nil {
+		return false
+	}
+
+	return (sf.Category == "" || sf.Category == article.Category) &&
+		(sf.Status == "" || sf.Status == article.Status)
+}
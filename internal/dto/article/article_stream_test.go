@@ -0,0 +1,74 @@
+package dto
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/events"
+)
+
+func TestStreamFilterMatches(t *testing.T) {
+	event := events.Event{
+		ID:            "evt-1",
+		AggregateType: events.AggregateArticle,
+		TenantID:      "brand-a",
+		Payload:       []byte(`{"category":"Tech","status":"Publish"}`),
+	}
+
+	tests := []struct {
+		name   string
+		filter StreamFilter
+		event  func(events.Event) events.Event
+		want   bool
+	}{
+		{name: "no filter", filter: StreamFilter{TenantID: "brand-a"}, want: true},
+		{name: "other tenant", filter: StreamFilter{TenantID: "brand-b"}},
+		{name: "matching category and status", filter: StreamFilter{TenantID: "brand-a", Category: "Tech", Status: "Publish"}, want: true},
+		{name: "other category", filter: StreamFilter{TenantID: "brand-a", Category: "News"}},
+		{name: "other status", filter: StreamFilter{TenantID: "brand-a", Status: "Draft"}},
+		{
+			name:   "other aggregate",
+			filter: StreamFilter{TenantID: "brand-a"},
+			event:  func(e events.Event) events.Event { e.AggregateType = "comment"; return e },
+		},
+		{
+			name:   "invalid payload with filter",
+			filter: StreamFilter{TenantID: "brand-a", Category: "Tech"},
+			event:  func(e events.Event) events.Event { e.Payload = []byte(`not json`); return e },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := event
+			if tt.event != nil {
+				e = tt.event(e)
+			}
+			if got := tt.filter.Matches(e); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStreamFilterValidate(t *testing.T) {
+	tests := []struct {
+		status  string
+		wantErr error
+	}{
+		{status: ""},
+		{status: "Publish"},
+		{status: "In Review"},
+		{status: "publish", wantErr: ErrInvalidFilterStatus},
+		{status: "Archived", wantErr: ErrInvalidFilterStatus},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			filter := &StreamFilter{Status: tt.status}
+			if err := filter.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
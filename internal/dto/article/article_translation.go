@@ -0,0 +1,66 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/validation"
+)
+
+type CreateTranslationRequest struct {
+	Locale        string `json:"locale" validate:"required"`
+	Title         string `json:"title" validate:"required,min=3,max=200"`
+	Content       string `json:"content" validate:"required,min=10"`
+	ContentFormat string `json:"content_format" validate:"omitempty,oneof=markdown html plain"`
+	Status        string `json:"status" validate:"required,oneof=Publish Draft Thrash"`
+}
+
+func (r *CreateTranslationRequest) Validate() error {
+	return validation.StructWithRules(r, articleRules).Err()
+}
+
+type TranslationResponse struct {
+	ID            uint      `json:"id"`
+	ArticleID     uint      `json:"article_id"`
+	Locale        string    `json:"locale"`
+	Title         string    `json:"title"`
+	Content       string    `json:"content"`
+	ContentFormat string    `json:"content_format"`
+	ContentHTML   string    `json:"content_html"`
+	Excerpt       string    `json:"excerpt"`
+	WordCount     int       `json:"word_count"`
+	ReadingTime   int       `json:"reading_time_minutes"`
+	Status        string    `json:"status"`
+	CreatedAt     time.Time `json:"created_date"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+func ToTranslationResponse(translation *domain.ArticleTranslation) *TranslationResponse {
+	if translation == nil {
+		return nil
+	}
+
+	return &TranslationResponse{
+		ID:            translation.ID,
+		ArticleID:     translation.ArticleID,
+		Locale:        translation.Locale,
+		Title:         translation.Title,
+		Content:       translation.Content,
+		ContentFormat: translation.ContentFormat,
+		ContentHTML:   translation.ContentHTML,
+		Excerpt:       translation.Excerpt,
+		WordCount:     translation.WordCount,
+		ReadingTime:   translation.ReadingTime,
+		Status:        translation.Status,
+		CreatedAt:     translation.CreatedDate,
+		UpdatedAt:     translation.UpdatedDate,
+	}
+}
+
+func ToTranslationResponseList(translations []domain.ArticleTranslation) []TranslationResponse {
+	responses := make([]TranslationResponse, len(translations))
+	for i, translation := range translations {
+		responses[i] = *ToTranslationResponse(&translation)
+	}
+	return responses
+}
@@ -1,25 +1,47 @@
 package dto
 
-import "errors"
+import "github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/apperror"
 
 var (
 	// Validation errors
-	ErrTitleRequired       = errors.New("title is required")
-	ErrTitleLength         = errors.New("title must be between 3 and 200 characters")
-	ErrContentRequired     = errors.New("content is required")
-	ErrContentTooShort     = errors.New("content must be at least 10 characters")
-	ErrCategoryRequired    = errors.New("category is required")
-	ErrInvalidStatus       = errors.New("invalid status, must be one of: Publish, Draft, Thrash")
-	ErrInvalidFilterStatus = errors.New("invalid filter status")
+	ErrTitleRequired        = apperror.Validation(string(ErrCodeTitleRequired), "title is required")
+	ErrTitleLength          = apperror.Validation(string(ErrCodeTitleInvalid), "title must be between 3 and 200 characters")
+	ErrContentRequired      = apperror.Validation(string(ErrCodeContentRequired), "content is required")
+	ErrContentTooShort      = apperror.Validation(string(ErrCodeContentInvalid), "content must be at least 10 characters").WithID("article")
+	ErrInvalidContentFormat = apperror.Validation(string(ErrCodeFormatInvalid), "invalid content format, must be one of: markdown, html, plain")
+	ErrCategoryRequired     = apperror.Validation(string(ErrCodeCategoryRequired), "category is required")
+	ErrCategoryLength       = apperror.Validation(string(ErrCodeCategoryInvalid), "category must be between 3 and 100 characters")
+	ErrInvalidStatus        = apperror.Validation(string(ErrCodeStatusInvalid), "invalid status, must be one of: Publish, Draft, Thrash").WithID("article")
+	ErrInvalidFilterStatus  = apperror.Validation(string(ErrCodeStatusInvalid), "invalid filter status").WithID("article_filter")
+	ErrInvalidCoverMedia    = apperror.Validation(string(ErrCodeMediaInvalid), "cover media must be an existing image").WithID("cover")
+	ErrAttachmentNotFound   = apperror.Validation(string(ErrCodeMediaInvalid), "attachment media not found").WithID("attachment")
+	ErrLocaleRequired       = apperror.Validation(string(ErrCodeLocaleInvalid), "locale is required").WithID("required")
+	ErrUnsupportedLocale    = apperror.Validation(string(ErrCodeLocaleInvalid), "unsupported locale").WithID("unsupported")
 
 	// Database errors
-	ErrArticleNotFound = errors.New("article not found")
-	ErrArticleExists   = errors.New("article already exists")
+	ErrArticleNotFound = apperror.NotFound(string(ErrCodeNotFound), "article not found").WithID("article")
+	ErrArticleExists   = apperror.Conflict(string(ErrCodeConflict), "article already exists").WithID("article")
+	ErrNotInSeries     = apperror.NotFound(string(ErrCodeNotFound), "article is not part of the series").WithID("series_article")
+	ErrFailedGetList   = apperror.Internal(string(ErrCodeDBError), "failed to get articles").WithID("articles")
+
+	// Translation errors
+	ErrTranslationExists        = apperror.Conflict(string(ErrCodeConflict), "translation for this locale already exists").WithID("translation")
+	ErrTranslationDefaultLocale = apperror.Validation(string(ErrCodeLocaleInvalid), "default locale is stored on the article itself").WithID("default_locale")
+	ErrFailedCreateTranslation  = apperror.Internal(string(ErrCodeCreateFailed), "failed to create translation").WithID("translation")
 
 	// Business logic errors
-	ErrFailedCreateArticle = errors.New("failed to create article")
-	ErrFailedUpdateArticle = errors.New("failed to update article")
-	ErrFailedDeleteArticle = errors.New("failed to delete article")
+	ErrFailedCreateArticle = apperror.Internal(string(ErrCodeCreateFailed), "failed to create article").WithID("article")
+	ErrFailedUpdateArticle = apperror.Internal(string(ErrCodeUpdateFailed), "failed to update article").WithID("article")
+	ErrFailedDeleteArticle = apperror.Internal(string(ErrCodeDeleteFailed), "failed to delete article").WithID("article")
+	ErrReviewRequired      = apperror.Conflict(string(ErrCodeReviewNeeded), "article must be approved in review before publishing")
+	ErrArticleInReview     = apperror.Conflict(string(ErrCodeInReview), "article content cannot change while it is in review")
+
+	// Import errors
+	ErrImportUnsupportedFormat = apperror.Validation(string(ErrCodeValidation), "unsupported import format, must be one of: csv, ndjson").WithID("import_format")
+	ErrImportInvalidHeader     = apperror.Validation(string(ErrCodeValidation), "invalid csv header, required columns: title, content, category, status").WithID("import_header")
+	ErrImportEmpty             = apperror.Validation(string(ErrCodeValidation), "import file has no rows").WithID("import_empty")
+	ErrImportJobNotFound       = apperror.NotFound(string(ErrCodeNotFound), "import job not found").WithID("import_job")
+	ErrImportDuplicateRow      = apperror.Conflict(string(ErrCodeConflict), "title or slug already used by another row in this file").WithID("import_duplicate")
 )
 
 type ErrorCode string
@@ -31,8 +53,12 @@ const (
 	ErrCodeTitleInvalid     ErrorCode = "TITLE_INVALID"
 	ErrCodeContentRequired  ErrorCode = "CONTENT_REQUIRED"
 	ErrCodeContentInvalid   ErrorCode = "CONTENT_INVALID"
+	ErrCodeFormatInvalid    ErrorCode = "CONTENT_FORMAT_INVALID"
 	ErrCodeCategoryRequired ErrorCode = "CATEGORY_REQUIRED"
+	ErrCodeCategoryInvalid  ErrorCode = "CATEGORY_INVALID"
 	ErrCodeStatusInvalid    ErrorCode = "STATUS_INVALID"
+	ErrCodeMediaInvalid     ErrorCode = "MEDIA_INVALID"
+	ErrCodeLocaleInvalid    ErrorCode = "LOCALE_INVALID"
 
 	// Database error codes
 	ErrCodeNotFound ErrorCode = "NOT_FOUND"
@@ -43,42 +69,11 @@ const (
 	ErrCodeCreateFailed ErrorCode = "CREATE_FAILED"
 	ErrCodeUpdateFailed ErrorCode = "UPDATE_FAILED"
 	ErrCodeDeleteFailed ErrorCode = "DELETE_FAILED"
+	ErrCodeReviewNeeded ErrorCode = "REVIEW_REQUIRED"
+	ErrCodeInReview     ErrorCode = "ARTICLE_IN_REVIEW"
 
 	// General error codes
 	ErrCodeUnauthorized  ErrorCode = "UNAUTHORIZED"
 	ErrCodeForbidden     ErrorCode = "FORBIDDEN"
 	ErrCodeInternalError ErrorCode = "INTERNAL_SERVER_ERROR"
 )
-
-func MapErrorToCode(err error) ErrorCode {
-	if err == nil {
-		return ""
-	}
-
-	switch err {
-	case ErrTitleRequired:
-		return ErrCodeTitleRequired
-	case ErrTitleLength:
-		return ErrCodeTitleInvalid
-	case ErrContentRequired:
-		return ErrCodeContentRequired
-	case ErrContentTooShort:
-		return ErrCodeContentInvalid
-	case ErrCategoryRequired:
-		return ErrCodeCategoryRequired
-	case ErrInvalidStatus, ErrInvalidFilterStatus:
-		return ErrCodeStatusInvalid
-	case ErrArticleNotFound:
-		return ErrCodeNotFound
-	case ErrArticleExists:
-		return ErrCodeConflict
-	case ErrFailedCreateArticle:
-		return ErrCodeCreateFailed
-	case ErrFailedUpdateArticle:
-		return ErrCodeUpdateFailed
-	case ErrFailedDeleteArticle:
-		return ErrCodeDeleteFailed
-	default:
-		return ErrCodeInternalError
-	}
-}
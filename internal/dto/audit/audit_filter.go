@@ -0,0 +1,98 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/filter"
+)
+
+type AuditFilter struct {
+	filter.BaseFilter[AuditFilterFields]
+}
+
+type AuditFilterFields struct {
+	Actor    string `query:"actor"`
+	Entity   string `query:"entity"`
+	EntityID uint   `query:"entity_id"`
+	Action   string `query:"action"`
+	From     string `query:"from"`
+	To       string `query:"to"`
+
+	// Hasil parse From / To, di isi oleh Validate
+	FromTime *time.Time `query:"-"`
+	ToTime   *time.Time `query:"-"`
+}
+
+func NewAuditFilter() *AuditFilter {
+	return &AuditFilter{
+		BaseFilter: filter.BaseFilter[AuditFilterFields]{
+			Page:      1,
+			Limit:     10,
+			SortBy:    "created_date",
+			SortOrder: "desc",
+		},
+	}
+}
+
+func (af *AuditFilter) Validate() error {
+	// Validasi pagination
+	if err := af.ValidatePagination(); err != nil {
+		return err
+	}
+
+	if af.Filters.Action != "" && !domain.AuditAction(af.Filters.Action).IsValid() {
+		return ErrInvalidFilterAction
+	}
+
+	var err error
+	if af.Filters.FromTime, err = parseTime(af.Filters.From, false); err != nil {
+		return err
+	}
+	if af.Filters.ToTime, err = parseTime(af.Filters.To, true); err != nil {
+		return err
+	}
+
+	if af.Filters.FromTime != nil && af.Filters.ToTime != nil && af.Filters.FromTime.After(*af.Filters.ToTime) {
+		return ErrInvalidTimeRange
+	}
+
+	return nil
+}
+
+// parseTime terima RFC3339 atau tanggal saja, tanggal "to" berarti sampai akhir hari itu
+func parseTime(value string, endOfDay bool) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return &t, nil
+	}
+
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return nil, ErrInvalidTimeRange
+	}
+	if endOfDay {
+		t = t.Add(24*time.Hour - time.Nanosecond)
+	}
+
+	return &t, nil
+}
+
+func (af *AuditFilter) GetActor() string {
+	return af.Filters.Actor
+}
+
+func (af *AuditFilter) GetEntity() string {
+	return af.Filters.Entity
+}
+
+func (af *AuditFilter) GetEntityID() uint {
+	return af.Filters.EntityID
+}
+
+func (af *AuditFilter) GetAction() string {
+	return af.Filters.Action
+}
@@ -0,0 +1,65 @@
+package dto
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestAuditFilterValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		fields   AuditFilterFields
+		wantFrom string
+		wantTo   string
+		wantErr  error
+	}{
+		{name: "no filter"},
+		{name: "valid action", fields: AuditFilterFields{Action: "update"}},
+		{name: "invalid action", fields: AuditFilterFields{Action: "publish"}, wantErr: ErrInvalidFilterAction},
+		{
+			name:     "rfc3339",
+			fields:   AuditFilterFields{From: "2026-01-01T08:00:00+07:00", To: "2026-01-02T10:00:00Z"},
+			wantFrom: "2026-01-01T01:00:00Z",
+			wantTo:   "2026-01-02T10:00:00Z",
+		},
+		{
+			name:     "date only covers the whole to day",
+			fields:   AuditFilterFields{From: "2026-01-01", To: "2026-01-01"},
+			wantFrom: "2026-01-01T00:00:00Z",
+			wantTo:   "2026-01-01T23:59:59.999999999Z",
+		},
+		{name: "only from", fields: AuditFilterFields{From: "2026-01-01"}, wantFrom: "2026-01-01T00:00:00Z"},
+		{name: "invalid date", fields: AuditFilterFields{From: "01/02/2026"}, wantErr: ErrInvalidTimeRange},
+		{name: "from after to", fields: AuditFilterFields{From: "2026-01-02", To: "2026-01-01"}, wantErr: ErrInvalidTimeRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := NewAuditFilter()
+			filter.Filters = tt.fields
+
+			err := filter.Validate()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			if got := formatTime(filter.Filters.FromTime); got != tt.wantFrom {
+				t.Errorf("FromTime = %q, want %q", got, tt.wantFrom)
+			}
+			if got := formatTime(filter.Filters.ToTime); got != tt.wantTo {
+				t.Errorf("ToTime = %q, want %q", got, tt.wantTo)
+			}
+		})
+	}
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
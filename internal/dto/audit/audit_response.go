@@ -0,0 +1,84 @@
+package dto
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+)
+
+type AuditLogResponse struct {
+	ID          uint            `json:"id"`
+	Actor       string          `json:"actor"`
+	RequestID   string          `json:"request_id"`
+	IP          string          `json:"ip"`
+	Action      string          `json:"action"`
+	Entity      string          `json:"entity"`
+	EntityID    uint            `json:"entity_id"`
+	Before      json.RawMessage `json:"before"`
+	After       json.RawMessage `json:"after"`
+	CreatedDate time.Time       `json:"created_date"`
+}
+
+// Header CSV export, urutannya sama dengan ToAuditCSVRecord
+var AuditCSVHeader = []string{"id", "created_date", "actor", "request_id", "ip", "action", "entity", "entity_id", "before", "after"}
+
+func ToAuditLogResponse(log *domain.AuditLog) *AuditLogResponse {
+	if log == nil {
+		return nil
+	}
+
+	return &AuditLogResponse{
+		ID:          log.ID,
+		Actor:       log.Actor,
+		RequestID:   log.RequestID,
+		IP:          log.IP,
+		Action:      log.Action,
+		Entity:      log.Entity,
+		EntityID:    log.EntityID,
+		Before:      nullJSON(log.Before),
+		After:       nullJSON(log.After),
+		CreatedDate: log.CreatedDate,
+	}
+}
+
+func ToAuditLogResponseList(logs []domain.AuditLog) []AuditLogResponse {
+	responses := make([]AuditLogResponse, len(logs))
+	for i, log := range logs {
+		responses[i] = *ToAuditLogResponse(&log)
+	}
+	return responses
+}
+
+func ToAuditCSVRecord(log *domain.AuditLog) []string {
+	return []string{
+		strconv.FormatUint(uint64(log.ID), 10),
+		log.CreatedDate.UTC().Format(time.RFC3339),
+		csvSafe(log.Actor),
+		csvSafe(log.RequestID),
+		log.IP,
+		log.Action,
+		log.Entity,
+		strconv.FormatUint(uint64(log.EntityID), 10),
+		string(log.Before),
+		string(log.After),
+	}
+}
+
+// csvSafe cegah formula injection ketika CSV dibuka di spreadsheet, actor dan request ID berasal dari header
+func csvSafe(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// nullJSON supaya snapshot kosong tampil sebagai null, bukan string kosong yang invalid
+func nullJSON(raw json.RawMessage) json.RawMessage {
+	if len(raw) == 0 {
+		return json.RawMessage("null")
+	}
+	return raw
+}
@@ -0,0 +1,27 @@
+package dto
+
+import "github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/apperror"
+
+var (
+	// Validation errors
+	ErrInvalidFilterAction = apperror.Validation(string(ErrCodeActionInvalid), "invalid action filter, must be one of: create, update, delete")
+	ErrInvalidTimeRange    = apperror.Validation(string(ErrCodeTimeInvalid), "invalid time range, use RFC3339 or YYYY-MM-DD and from must be before to")
+
+	// Business logic errors
+	ErrFailedGetAuditLogs = apperror.Internal(string(ErrCodeDBError), "failed to get audit logs").WithID("audit_logs")
+)
+
+type ErrorCode string
+
+const (
+	// Validation error codes
+	ErrCodeValidation    ErrorCode = "VALIDATION_ERROR"
+	ErrCodeActionInvalid ErrorCode = "ACTION_INVALID"
+	ErrCodeTimeInvalid   ErrorCode = "TIME_RANGE_INVALID"
+
+	// Database error codes
+	ErrCodeDBError ErrorCode = "DATABASE_ERROR"
+
+	// General error codes
+	ErrCodeInternalError ErrorCode = "INTERNAL_SERVER_ERROR"
+)
@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/filter"
+)
+
+type CommentFilter struct {
+	filter.BaseFilter[CommentFilterFields]
+}
+
+type CommentFilterFields struct {
+	ArticleID uint   `query:"article_id"`
+	Status    string `query:"status"`
+}
+
+func NewCommentFilter() *CommentFilter {
+	return &CommentFilter{
+		BaseFilter: filter.BaseFilter[CommentFilterFields]{
+			Page:      1,
+			Limit:     10,
+			SortBy:    "created_date",
+			SortOrder: "desc",
+		},
+	}
+}
+
+func (cf *CommentFilter) Validate() error {
+	// Validasi pagination
+	if err := cf.ValidatePagination(); err != nil {
+		return err
+	}
+
+	// Validasi status jika ada
+	if cf.Filters.Status != "" && !domain.CommentStatus(cf.Filters.Status).IsValid() {
+		return ErrInvalidFilterStatus
+	}
+
+	return nil
+}
+
+func (cf *CommentFilter) GetArticleID() uint {
+	return cf.Filters.ArticleID
+}
+
+func (cf *CommentFilter) GetStatus() string {
+	return cf.Filters.Status
+}
+
+func (cf *CommentFilter) HasArticleID() bool {
+	return cf.GetArticleID() != 0
+}
+
+func (cf *CommentFilter) HasStatus() bool {
+	return cf.GetStatus() != ""
+}
@@ -0,0 +1,29 @@
+package dto
+
+import (
+	"strings"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/validation"
+)
+
+type CreateCommentRequest struct {
+	ParentID    *uint  `json:"parent_id"`
+	AuthorName  string `json:"author_name" validate:"required,max=100"`
+	AuthorEmail string `json:"author_email" validate:"omitempty,email"`
+	Content     string `json:"content" validate:"required,min=2,max=5000"`
+}
+
+// commentRules error_code per field yang sudah dipakai client sebelum validasi lewat tag
+var commentRules = validation.Rules{
+	"author_name":  {"required": ErrAuthorNameRequired, "*": ErrAuthorNameLength},
+	"author_email": {"*": ErrAuthorEmailInvalid},
+	"content":      {"required": ErrContentRequired, "*": ErrContentLength},
+}
+
+func (r *CreateCommentRequest) Validate() error {
+	r.AuthorName = strings.TrimSpace(r.AuthorName)
+	r.AuthorEmail = strings.TrimSpace(r.AuthorEmail)
+	r.Content = strings.TrimSpace(r.Content)
+
+	return validation.StructWithRules(r, commentRules).Err()
+}
@@ -0,0 +1,90 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+)
+
+// CommentResponse untuk endpoint publik, email author tidak di expose
+type CommentResponse struct {
+	ID          uint              `json:"id"`
+	ArticleID   uint              `json:"article_id"`
+	ParentID    *uint             `json:"parent_id"`
+	AuthorName  string            `json:"author_name"`
+	Content     string            `json:"content"`
+	Status      string            `json:"status"`
+	CreatedDate time.Time         `json:"created_date"`
+	Replies     []CommentResponse `json:"replies,omitempty"`
+}
+
+// ModerationCommentResponse untuk antrian moderasi
+type ModerationCommentResponse struct {
+	ID          uint      `json:"id"`
+	ArticleID   uint      `json:"article_id"`
+	ParentID    *uint     `json:"parent_id"`
+	AuthorName  string    `json:"author_name"`
+	AuthorEmail string    `json:"author_email,omitempty"`
+	Content     string    `json:"content"`
+	Status      string    `json:"status"`
+	CreatedDate time.Time `json:"created_date"`
+	UpdatedDate time.Time `json:"updated_date"`
+}
+
+func ToCommentResponse(comment *domain.Comment) *CommentResponse {
+	if comment == nil {
+		return nil
+	}
+
+	resp := &CommentResponse{
+		ID:          comment.ID,
+		ArticleID:   comment.ArticleID,
+		ParentID:    comment.ParentID,
+		AuthorName:  comment.AuthorName,
+		Content:     comment.Content,
+		Status:      comment.Status,
+		CreatedDate: comment.CreatedDate,
+	}
+
+	if len(comment.Replies) > 0 {
+		resp.Replies = ToCommentResponseList(comment.Replies)
+	}
+
+	return resp
+}
+
+func ToCommentResponseList(comments []domain.Comment) []CommentResponse {
+	responses := make([]CommentResponse, len(comments))
+	for i, comment := range comments {
+		responses[i] = *ToCommentResponse(&comment)
+	}
+
+	return responses
+}
+
+func ToModerationCommentResponse(comment *domain.Comment) *ModerationCommentResponse {
+	if comment == nil {
+		return nil
+	}
+
+	return &ModerationCommentResponse{
+		ID:          comment.ID,
+		ArticleID:   comment.ArticleID,
+		ParentID:    comment.ParentID,
+		AuthorName:  comment.AuthorName,
+		AuthorEmail: comment.AuthorEmail,
+		Content:     comment.Content,
+		Status:      comment.Status,
+		CreatedDate: comment.CreatedDate,
+		UpdatedDate: comment.UpdatedDate,
+	}
+}
+
+func ToModerationCommentResponseList(comments []domain.Comment) []ModerationCommentResponse {
+	responses := make([]ModerationCommentResponse, len(comments))
+	for i, comment := range comments {
+		responses[i] = *ToModerationCommentResponse(&comment)
+	}
+
+	return responses
+}
@@ -0,0 +1,48 @@
+package dto
+
+import "github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/apperror"
+
+var (
+	// Validation errors
+	ErrAuthorNameRequired  = apperror.Validation(string(ErrCodeAuthorInvalid), "author_name is required").WithID("name_required")
+	ErrAuthorNameLength    = apperror.Validation(string(ErrCodeAuthorInvalid), "author_name must be at most 100 characters").WithID("name_length")
+	ErrAuthorEmailInvalid  = apperror.Validation(string(ErrCodeAuthorInvalid), "author_email is not a valid email address").WithID("email")
+	ErrContentRequired     = apperror.Validation(string(ErrCodeContentRequired), "content is required")
+	ErrContentLength       = apperror.Validation(string(ErrCodeContentInvalid), "content must be between 2 and 5000 characters").WithID("comment")
+	ErrInvalidFilterStatus = apperror.Validation(string(ErrCodeStatusInvalid), "invalid filter status, must be one of: pending, approved, rejected").WithID("comment_filter")
+
+	// Database errors
+	ErrCommentNotFound = apperror.NotFound(string(ErrCodeNotFound), "comment not found").WithID("comment")
+	ErrArticleNotFound = apperror.NotFound(string(ErrCodeNotFound), "article not found").WithID("article")
+
+	// Business logic errors
+	ErrArticleNotPublished = apperror.Forbidden(string(ErrCodeArticleNotPublished), "comments are only allowed on published articles")
+	ErrInvalidParent       = apperror.Validation(string(ErrCodeParentInvalid), "parent comment must be an approved comment on the same article")
+	ErrFailedCreateComment = apperror.Internal(string(ErrCodeCreateFailed), "failed to create comment").WithID("comment")
+	ErrFailedModerate      = apperror.Internal(string(ErrCodeModerateFailed), "failed to moderate comment")
+)
+
+type ErrorCode string
+
+const (
+	// Validation error codes
+	ErrCodeValidation      ErrorCode = "VALIDATION_ERROR"
+	ErrCodeAuthorInvalid   ErrorCode = "AUTHOR_INVALID"
+	ErrCodeContentRequired ErrorCode = "CONTENT_REQUIRED"
+	ErrCodeContentInvalid  ErrorCode = "CONTENT_INVALID"
+	ErrCodeStatusInvalid   ErrorCode = "STATUS_INVALID"
+
+	// Database error codes
+	ErrCodeNotFound ErrorCode = "NOT_FOUND"
+	ErrCodeDBError  ErrorCode = "DATABASE_ERROR"
+
+	// Business logic error codes
+	ErrCodeArticleNotPublished ErrorCode = "ARTICLE_NOT_PUBLISHED"
+	ErrCodeParentInvalid       ErrorCode = "PARENT_INVALID"
+	ErrCodeCreateFailed        ErrorCode = "CREATE_FAILED"
+	ErrCodeModerateFailed      ErrorCode = "MODERATE_FAILED"
+
+	// General error codes
+	ErrCodeUnauthorized  ErrorCode = "UNAUTHORIZED"
+	ErrCodeInternalError ErrorCode = "INTERNAL_SERVER_ERROR"
+)
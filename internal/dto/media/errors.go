@@ -0,0 +1,39 @@
+package dto
+
+import "github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/apperror"
+
+var (
+	// Validation errors
+	ErrFileRequired         = apperror.Validation(string(ErrCodeFileRequired), "file is required")
+	ErrFileTooLarge         = apperror.TooLarge(string(ErrCodeFileTooLarge), "file exceeds maximum allowed size")
+	ErrUnsupportedMediaType = apperror.Unsupported(string(ErrCodeUnsupportedMedia), "unsupported media type")
+	ErrImageTooLarge        = apperror.TooLarge(string(ErrCodeImageTooLarge), "image dimensions exceed the maximum allowed pixel count")
+
+	// Database errors
+	ErrMediaNotFound = apperror.NotFound(string(ErrCodeNotFound), "media not found").WithID("media")
+
+	// Business logic errors
+	ErrFailedUploadMedia = apperror.Internal(string(ErrCodeUploadFailed), "failed to upload media")
+	ErrFailedDeleteMedia = apperror.Internal(string(ErrCodeDeleteFailed), "failed to delete media").WithID("media")
+)
+
+type ErrorCode string
+
+const (
+	// Validation error codes
+	ErrCodeValidation       ErrorCode = "VALIDATION_ERROR"
+	ErrCodeFileRequired     ErrorCode = "FILE_REQUIRED"
+	ErrCodeFileTooLarge     ErrorCode = "FILE_TOO_LARGE"
+	ErrCodeImageTooLarge    ErrorCode = "IMAGE_TOO_LARGE"
+	ErrCodeUnsupportedMedia ErrorCode = "UNSUPPORTED_MEDIA_TYPE"
+
+	// Database error codes
+	ErrCodeNotFound ErrorCode = "NOT_FOUND"
+
+	// Business logic error codes
+	ErrCodeUploadFailed ErrorCode = "UPLOAD_FAILED"
+	ErrCodeDeleteFailed ErrorCode = "DELETE_FAILED"
+
+	// General error codes
+	ErrCodeInternalError ErrorCode = "INTERNAL_SERVER_ERROR"
+)
@@ -0,0 +1,22 @@
+package dto
+
+import "io"
+
+// UploadMediaRequest file yang sudah dibaca dari multipart form
+type UploadMediaRequest struct {
+	FileName string
+	Size     int64
+	Reader   io.Reader
+}
+
+func (r *UploadMediaRequest) Validate(maxSize int64) error {
+	if r.Reader == nil || r.Size == 0 {
+		return ErrFileRequired
+	}
+
+	if r.Size > maxSize {
+		return ErrFileTooLarge
+	}
+
+	return nil
+}
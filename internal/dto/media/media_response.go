@@ -0,0 +1,46 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+)
+
+type MediaResponse struct {
+	ID           uint      `json:"id"`
+	FileName     string    `json:"file_name"`
+	ContentType  string    `json:"content_type"`
+	Size         int64     `json:"size"`
+	URL          string    `json:"url"`
+	ThumbnailURL string    `json:"thumbnail_url,omitempty"`
+	Width        int       `json:"width,omitempty"`
+	Height       int       `json:"height,omitempty"`
+	CreatedAt    time.Time `json:"created_date"`
+}
+
+// ============ Mapper Functions ============
+func ToMediaResponse(media *domain.Media) *MediaResponse {
+	if media == nil {
+		return nil
+	}
+
+	return &MediaResponse{
+		ID:           media.ID,
+		FileName:     media.FileName,
+		ContentType:  media.ContentType,
+		Size:         media.Size,
+		URL:          media.URL,
+		ThumbnailURL: media.ThumbnailURL,
+		Width:        media.Width,
+		Height:       media.Height,
+		CreatedAt:    media.CreatedDate,
+	}
+}
+
+func ToMediaResponseList(media []domain.Media) []MediaResponse {
+	responses := make([]MediaResponse, len(media))
+	for i, item := range media {
+		responses[i] = *ToMediaResponse(&item)
+	}
+	return responses
+}
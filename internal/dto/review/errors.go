@@ -0,0 +1,51 @@
+package dto
+
+import "github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/apperror"
+
+var (
+	// Validation errors
+	ErrAuthorRequired   = apperror.Validation(string(ErrCodeAuthorRequired), "author is required")
+	ErrReviewerRequired = apperror.Validation(string(ErrCodeReviewerInvalid), "at least one reviewer is required").WithID("required")
+	ErrReviewerIsAuthor = apperror.Validation(string(ErrCodeReviewerInvalid), "author cannot review their own article").WithID("author")
+	ErrCommentRequired  = apperror.Validation(string(ErrCodeCommentRequired), "comment is required when requesting changes")
+	ErrFieldTooLong     = apperror.Validation(string(ErrCodeValidation), "field exceeds maximum length").WithID("field_length")
+	ErrReviewerMismatch = apperror.Forbidden(string(ErrCodeReviewerMismatch), "reviewer is not assigned to this review").WithID("not_assigned")
+	ErrSelfReview       = apperror.Forbidden(string(ErrCodeReviewerMismatch), "author cannot decide a review of their own article").WithID("self_review")
+	ErrActorRequired    = apperror.Unauthorized(string(ErrCodeUnauthorized), "authenticated actor is required").WithID("actor")
+
+	// Database errors
+	ErrReviewNotFound  = apperror.NotFound(string(ErrCodeNotFound), "review not found").WithID("review")
+	ErrArticleNotFound = apperror.NotFound(string(ErrCodeNotFound), "article not found").WithID("article")
+
+	// Business logic errors
+	ErrInvalidSubmit       = apperror.Conflict(string(ErrCodeInvalidTransition), "only draft articles can be submitted for review").WithID("submit")
+	ErrArticleNotInReview  = apperror.Conflict(string(ErrCodeInvalidTransition), "article is not in review").WithID("not_in_review")
+	ErrReviewAlreadyClosed = apperror.Conflict(string(ErrCodeReviewClosed), "review has already been decided")
+	ErrFailedSubmitReview  = apperror.Internal(string(ErrCodeSubmitFailed), "failed to submit review")
+	ErrFailedDecideReview  = apperror.Internal(string(ErrCodeDecideFailed), "failed to save review decision")
+)
+
+type ErrorCode string
+
+const (
+	// Validation error codes
+	ErrCodeValidation       ErrorCode = "VALIDATION_ERROR"
+	ErrCodeReviewerInvalid  ErrorCode = "REVIEWER_INVALID"
+	ErrCodeCommentRequired  ErrorCode = "COMMENT_REQUIRED"
+	ErrCodeAuthorRequired   ErrorCode = "AUTHOR_REQUIRED"
+	ErrCodeReviewerMismatch ErrorCode = "REVIEWER_MISMATCH"
+
+	// Database error codes
+	ErrCodeNotFound ErrorCode = "NOT_FOUND"
+	ErrCodeDBError  ErrorCode = "DATABASE_ERROR"
+
+	// Business logic error codes
+	ErrCodeInvalidTransition ErrorCode = "INVALID_STATUS_TRANSITION"
+	ErrCodeReviewClosed      ErrorCode = "REVIEW_CLOSED"
+	ErrCodeSubmitFailed      ErrorCode = "SUBMIT_FAILED"
+	ErrCodeDecideFailed      ErrorCode = "DECIDE_FAILED"
+
+	// General error codes
+	ErrCodeUnauthorized  ErrorCode = "UNAUTHORIZED"
+	ErrCodeInternalError ErrorCode = "INTERNAL_SERVER_ERROR"
+)
@@ -0,0 +1,67 @@
+package dto
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/validation"
+)
+
+// Author dan Reviewer di isi handler dari actor yang terautentikasi, tidak pernah dari body
+type SubmitReviewRequest struct {
+	Author    string   `json:"-" validate:"required,max=100"`
+	Reviewers []string `json:"reviewers" validate:"required,min=1,dive,required,max=100"`
+	Note      string   `json:"note" validate:"omitempty,max=2000"`
+}
+
+type DecisionRequest struct {
+	Reviewer string `json:"-" validate:"required,max=100"`
+	Comment  string `json:"comment" validate:"omitempty,max=5000"`
+}
+
+// reviewRules error_code per field yang sudah dipakai client sebelum validasi lewat tag
+var reviewRules = validation.Rules{
+	"author":    {"required": ErrAuthorRequired, "*": ErrFieldTooLong},
+	"reviewers": {"required": ErrReviewerRequired, "min": ErrReviewerRequired, "*": ErrFieldTooLong},
+	"note":      {"*": ErrFieldTooLong},
+	"reviewer":  {"required": ErrReviewerMismatch, "*": ErrFieldTooLong},
+	"comment":   {"*": ErrFieldTooLong},
+}
+
+func (r *SubmitReviewRequest) Validate() error {
+	r.Author = strings.TrimSpace(r.Author)
+
+	// Buang reviewer kosong dan duplikat sebelum di validasi
+	seen := make(map[string]bool)
+	reviewers := make([]string, 0, len(r.Reviewers))
+	for _, reviewer := range r.Reviewers {
+		reviewer = strings.TrimSpace(reviewer)
+		if reviewer == "" || seen[strings.ToLower(reviewer)] {
+			continue
+		}
+		seen[strings.ToLower(reviewer)] = true
+		reviewers = append(reviewers, reviewer)
+	}
+	r.Reviewers = reviewers
+
+	errs := validation.StructWithRules(r, reviewRules)
+	for i, reviewer := range r.Reviewers {
+		if r.Author != "" && strings.EqualFold(reviewer, r.Author) {
+			errs.AddError(fmt.Sprintf("reviewers[%d]", i), ErrReviewerIsAuthor)
+		}
+	}
+
+	return errs.Err()
+}
+
+func (r *DecisionRequest) Validate(requireComment bool) error {
+	r.Reviewer = strings.TrimSpace(r.Reviewer)
+	r.Comment = strings.TrimSpace(r.Comment)
+
+	errs := validation.StructWithRules(r, reviewRules)
+	if requireComment && r.Comment == "" {
+		errs.AddError("comment", ErrCommentRequired)
+	}
+
+	return errs.Err()
+}
@@ -0,0 +1,47 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+)
+
+type ReviewResponse struct {
+	ID          uint       `json:"id"`
+	ArticleID   uint       `json:"article_id"`
+	Round       int        `json:"round"`
+	Author      string     `json:"author"`
+	Reviewer    string     `json:"reviewer"`
+	Status      string     `json:"status"`
+	Note        string     `json:"note,omitempty"`
+	Comment     string     `json:"comment,omitempty"`
+	CreatedDate time.Time  `json:"created_date"`
+	DecidedDate *time.Time `json:"decided_date"`
+}
+
+func ToReviewResponse(review *domain.Review) *ReviewResponse {
+	if review == nil {
+		return nil
+	}
+
+	return &ReviewResponse{
+		ID:          review.ID,
+		ArticleID:   review.ArticleID,
+		Round:       review.Round,
+		Author:      review.Author,
+		Reviewer:    review.Reviewer,
+		Status:      review.Status,
+		Note:        review.Note,
+		Comment:     review.Comment,
+		CreatedDate: review.CreatedDate,
+		DecidedDate: review.DecidedDate,
+	}
+}
+
+func ToReviewResponseList(reviews []domain.Review) []ReviewResponse {
+	responses := make([]ReviewResponse, len(reviews))
+	for i, review := range reviews {
+		responses[i] = *ToReviewResponse(&review)
+	}
+	return responses
+}
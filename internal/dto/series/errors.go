@@ -0,0 +1,49 @@
+package dto
+
+import "github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/apperror"
+
+var (
+	// Validation errors
+	ErrTitleRequired     = apperror.Validation(string(ErrCodeTitleRequired), "title is required")
+	ErrTitleLength       = apperror.Validation(string(ErrCodeTitleInvalid), "title must be between 3 and 200 characters")
+	ErrDescriptionLength = apperror.Validation(string(ErrCodeValidation), "description must be at most 2000 characters").WithID("description_length")
+	ErrArticleIDRequired = apperror.Validation(string(ErrCodeArticleInvalid), "article_id is required").WithID("required")
+	ErrInvalidPosition   = apperror.Validation(string(ErrCodeValidation), "position must be greater than 0").WithID("position")
+	ErrInvalidOrder      = apperror.Validation(string(ErrCodeOrderInvalid), "article_ids must contain every article of the series exactly once")
+
+	// Database errors
+	ErrSeriesNotFound  = apperror.NotFound(string(ErrCodeNotFound), "series not found").WithID("series")
+	ErrSeriesExists    = apperror.Conflict(string(ErrCodeConflict), "series already exists").WithID("series")
+	ErrArticleNotFound = apperror.NotFound(string(ErrCodeNotFound), "article not found").WithID("article")
+
+	// Business logic errors
+	ErrArticleInSeries    = apperror.Conflict(string(ErrCodeArticleInvalid), "article is already part of the series").WithID("already_in_series")
+	ErrArticleNotInSeries = apperror.Validation(string(ErrCodeArticleInvalid), "article is not part of the series").WithID("not_in_series")
+	ErrFailedCreateSeries = apperror.Internal(string(ErrCodeCreateFailed), "failed to create series").WithID("series")
+	ErrFailedUpdateSeries = apperror.Internal(string(ErrCodeUpdateFailed), "failed to update series").WithID("series")
+	ErrFailedDeleteSeries = apperror.Internal(string(ErrCodeDeleteFailed), "failed to delete series").WithID("series")
+)
+
+type ErrorCode string
+
+const (
+	// Validation error codes
+	ErrCodeValidation     ErrorCode = "VALIDATION_ERROR"
+	ErrCodeTitleRequired  ErrorCode = "TITLE_REQUIRED"
+	ErrCodeTitleInvalid   ErrorCode = "TITLE_INVALID"
+	ErrCodeOrderInvalid   ErrorCode = "ORDER_INVALID"
+	ErrCodeArticleInvalid ErrorCode = "ARTICLE_INVALID"
+
+	// Database error codes
+	ErrCodeNotFound ErrorCode = "NOT_FOUND"
+	ErrCodeConflict ErrorCode = "CONFLICT"
+	ErrCodeDBError  ErrorCode = "DATABASE_ERROR"
+
+	// Business logic error codes
+	ErrCodeCreateFailed ErrorCode = "CREATE_FAILED"
+	ErrCodeUpdateFailed ErrorCode = "UPDATE_FAILED"
+	ErrCodeDeleteFailed ErrorCode = "DELETE_FAILED"
+
+	// General error codes
+	ErrCodeInternalError ErrorCode = "INTERNAL_SERVER_ERROR"
+)
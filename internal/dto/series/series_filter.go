@@ -0,0 +1,22 @@
+package dto
+
+import "github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/filter"
+
+type SeriesFilter struct {
+	filter.BaseFilter[struct{}]
+}
+
+func NewSeriesFilter() *SeriesFilter {
+	return &SeriesFilter{
+		BaseFilter: filter.BaseFilter[struct{}]{
+			Page:      1,
+			Limit:     10,
+			SortBy:    "created_date",
+			SortOrder: "desc",
+		},
+	}
+}
+
+func (sf *SeriesFilter) Validate() error {
+	return sf.ValidatePagination()
+}
@@ -0,0 +1,47 @@
+package dto
+
+import "github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/validation"
+
+type CreateSeriesRequest struct {
+	Title       string `json:"title" validate:"required,min=3,max=200"`
+	Description string `json:"description" validate:"omitempty,max=2000"`
+}
+
+type UpdateSeriesRequest struct {
+	Title       string  `json:"title" validate:"omitempty,min=3,max=200"`
+	Description *string `json:"description" validate:"omitempty,max=2000"` // nil berarti tidak diubah
+}
+
+type AddSeriesArticleRequest struct {
+	ArticleID uint `json:"article_id" validate:"required"`
+	Position  int  `json:"position" validate:"omitempty,min=1"` // kosong berarti di akhir series
+}
+
+type ReorderSeriesRequest struct {
+	ArticleIDs []uint `json:"article_ids" validate:"required,min=1"`
+}
+
+// seriesRules error_code per field yang sudah dipakai client sebelum validasi lewat tag
+var seriesRules = validation.Rules{
+	"title":       {"required": ErrTitleRequired, "*": ErrTitleLength},
+	"description": {"*": ErrDescriptionLength},
+	"article_id":  {"*": ErrArticleIDRequired},
+	"position":    {"*": ErrInvalidPosition},
+	"article_ids": {"*": ErrInvalidOrder},
+}
+
+func (r *CreateSeriesRequest) Validate() error {
+	return validation.StructWithRules(r, seriesRules).Err()
+}
+
+func (r *UpdateSeriesRequest) Validate() error {
+	return validation.StructWithRules(r, seriesRules).Err()
+}
+
+func (r *AddSeriesArticleRequest) Validate() error {
+	return validation.StructWithRules(r, seriesRules).Err()
+}
+
+func (r *ReorderSeriesRequest) Validate() error {
+	return validation.StructWithRules(r, seriesRules).Err()
+}
@@ -0,0 +1,64 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+)
+
+type SeriesResponse struct {
+	ID          uint                     `json:"id"`
+	Title       string                   `json:"title"`
+	Slug        string                   `json:"slug"`
+	Description string                   `json:"description"`
+	Articles    *[]SeriesArticleResponse `json:"articles,omitempty"` // hanya di isi pada detail
+	CreatedAt   time.Time                `json:"created_date"`
+	UpdatedAt   time.Time                `json:"updated_at"`
+}
+
+type SeriesArticleResponse struct {
+	Position int    `json:"position"`
+	ID       uint   `json:"id"`
+	Title    string `json:"title"`
+	Slug     string `json:"slug"`
+	Status   string `json:"status"`
+}
+
+func ToSeriesResponse(series *domain.Series) *SeriesResponse {
+	if series == nil {
+		return nil
+	}
+
+	resp := &SeriesResponse{
+		ID:          series.ID,
+		Title:       series.Title,
+		Slug:        series.Slug,
+		Description: series.Description,
+		CreatedAt:   series.CreatedDate,
+		UpdatedAt:   series.UpdatedDate,
+	}
+
+	if series.Articles != nil {
+		articles := make([]SeriesArticleResponse, len(series.Articles))
+		for i, article := range series.Articles {
+			articles[i] = SeriesArticleResponse{
+				Position: i + 1,
+				ID:       article.ID,
+				Title:    article.Title,
+				Slug:     article.Slug,
+				Status:   article.Status,
+			}
+		}
+		resp.Articles = &articles
+	}
+
+	return resp
+}
+
+func ToSeriesResponseList(series []domain.Series) []SeriesResponse {
+	responses := make([]SeriesResponse, len(series))
+	for i, s := range series {
+		responses[i] = *ToSeriesResponse(&s)
+	}
+	return responses
+}
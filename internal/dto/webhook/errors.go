@@ -0,0 +1,49 @@
+package dto
+
+import "github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/apperror"
+
+var (
+	// Validation errors
+	ErrURLRequired         = apperror.Validation(string(ErrCodeURLInvalid), "url is required").WithID("required")
+	ErrInvalidURL          = apperror.Validation(string(ErrCodeURLInvalid), "url must be an absolute http or https URL of at most 2048 characters").WithID("format")
+	ErrEventTypesRequired  = apperror.Validation(string(ErrCodeEventTypeInvalid), "event_types is required").WithID("required")
+	ErrInvalidEventType    = apperror.Validation(string(ErrCodeEventTypeInvalid), "event_types contains an unknown event type").WithID("unknown")
+	ErrURLNotPublic        = apperror.Validation(string(ErrCodeURLInvalid), "url must resolve to a public address").WithID("not_public")
+	ErrSecretLength        = apperror.Validation(string(ErrCodeSecretInvalid), "secret must be between 16 and 255 characters")
+	ErrInvalidFilterStatus = apperror.Validation(string(ErrCodeValidation), "status must be one of: pending, delivered, dead").WithID("delivery_status")
+
+	// Database errors
+	ErrWebhookNotFound  = apperror.NotFound(string(ErrCodeNotFound), "webhook not found").WithID("webhook")
+	ErrDeliveryNotFound = apperror.NotFound(string(ErrCodeNotFound), "delivery not found").WithID("delivery")
+
+	// Business logic errors
+	ErrDeliveryPending     = apperror.Conflict(string(ErrCodeDeliveryPending), "delivery is still pending")
+	ErrFailedCreateWebhook = apperror.Internal(string(ErrCodeCreateFailed), "failed to create webhook").WithID("webhook")
+	ErrFailedUpdateWebhook = apperror.Internal(string(ErrCodeUpdateFailed), "failed to update webhook").WithID("webhook")
+	ErrFailedDeleteWebhook = apperror.Internal(string(ErrCodeDeleteFailed), "failed to delete webhook").WithID("webhook")
+	ErrFailedRedeliver     = apperror.Internal(string(ErrCodeRedeliverFailed), "failed to redeliver webhook")
+)
+
+type ErrorCode string
+
+const (
+	// Validation error codes
+	ErrCodeValidation       ErrorCode = "VALIDATION_ERROR"
+	ErrCodeURLInvalid       ErrorCode = "URL_INVALID"
+	ErrCodeEventTypeInvalid ErrorCode = "EVENT_TYPE_INVALID"
+	ErrCodeSecretInvalid    ErrorCode = "SECRET_INVALID"
+
+	// Database error codes
+	ErrCodeNotFound ErrorCode = "NOT_FOUND"
+	ErrCodeDBError  ErrorCode = "DATABASE_ERROR"
+
+	// Business logic error codes
+	ErrCodeDeliveryPending ErrorCode = "DELIVERY_PENDING"
+	ErrCodeCreateFailed    ErrorCode = "CREATE_FAILED"
+	ErrCodeUpdateFailed    ErrorCode = "UPDATE_FAILED"
+	ErrCodeDeleteFailed    ErrorCode = "DELETE_FAILED"
+	ErrCodeRedeliverFailed ErrorCode = "REDELIVER_FAILED"
+
+	// General error codes
+	ErrCodeInternalError ErrorCode = "INTERNAL_SERVER_ERROR"
+)
@@ -0,0 +1,57 @@
+package dto
+
+import (
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/filter"
+)
+
+type WebhookFilter struct {
+	filter.BaseFilter[struct{}]
+}
+
+func NewWebhookFilter() *WebhookFilter {
+	return &WebhookFilter{
+		BaseFilter: filter.BaseFilter[struct{}]{
+			Page:      1,
+			Limit:     10,
+			SortBy:    "created_date",
+			SortOrder: "desc",
+		},
+	}
+}
+
+func (wf *WebhookFilter) Validate() error {
+	return wf.ValidatePagination()
+}
+
+type DeliveryFilter struct {
+	filter.BaseFilter[DeliveryFilterFields]
+}
+
+type DeliveryFilterFields struct {
+	Status    string `query:"status"`
+	EventType string `query:"event_type"`
+}
+
+func NewDeliveryFilter() *DeliveryFilter {
+	return &DeliveryFilter{
+		BaseFilter: filter.BaseFilter[DeliveryFilterFields]{
+			Page:      1,
+			Limit:     10,
+			SortBy:    "created_date",
+			SortOrder: "desc",
+		},
+	}
+}
+
+func (df *DeliveryFilter) Validate() error {
+	if err := df.ValidatePagination(); err != nil {
+		return err
+	}
+
+	if df.Filters.Status != "" && !domain.DeliveryStatus(df.Filters.Status).IsValid() {
+		return ErrInvalidFilterStatus
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package dto
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/events"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/validation"
+)
+
+type CreateWebhookRequest struct {
+	URL        string   `json:"url" validate:"required,url,max=2048"`
+	EventTypes []string `json:"event_types" validate:"required,min=1"`
+	Secret     string   `json:"secret" validate:"omitempty,min=16,max=255"` // kosong berarti di generate
+	Active     *bool    `json:"active"`                                     // default true
+}
+
+type UpdateWebhookRequest struct {
+	URL        string   `json:"url" validate:"omitempty,url,max=2048"`
+	EventTypes []string `json:"event_types"`
+	Secret     string   `json:"secret" validate:"omitempty,min=16,max=255"` // di isi untuk rotate secret
+	Active     *bool    `json:"active"`
+}
+
+// webhookRules error_code per field yang sudah dipakai client sebelum validasi lewat tag
+var webhookRules = validation.Rules{
+	"url":         {"required": ErrURLRequired, "*": ErrInvalidURL},
+	"event_types": {"*": ErrEventTypesRequired},
+	"secret":      {"*": ErrSecretLength},
+}
+
+func (r *CreateWebhookRequest) Validate() error {
+	errs := validation.StructWithRules(r, webhookRules)
+	validateURL(&errs, r.URL)
+	validateEventTypes(&errs, r.EventTypes)
+
+	return errs.Err()
+}
+
+func (r *UpdateWebhookRequest) Validate() error {
+	errs := validation.StructWithRules(r, webhookRules)
+	validateURL(&errs, r.URL)
+
+	// nil berarti tidak diubah, list kosong tidak boleh karena subscription jadi tidak menerima apa-apa
+	if r.EventTypes != nil && len(r.EventTypes) == 0 {
+		errs.AddError("event_types", ErrEventTypesRequired)
+	}
+	validateEventTypes(&errs, r.EventTypes)
+
+	return errs.Err()
+}
+
+// validateURL tag url menerima scheme apa saja, webhook hanya boleh http / https
+func validateURL(errs *validation.Errors, raw string) {
+	if raw == "" || errs.Has("url") {
+		return
+	}
+
+	parsed, err := url.Parse(raw)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		errs.AddError("url", ErrInvalidURL)
+	}
+}
+
+func validateEventTypes(errs *validation.Errors, eventTypes []string) {
+	for i, eventType := range eventTypes {
+		if eventType != domain.WildcardEvent && !slices.Contains(events.Types, eventType) {
+			errs.AddError(fmt.Sprintf("event_types[%d]", i), ErrInvalidEventType)
+		}
+	}
+}
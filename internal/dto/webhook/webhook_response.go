@@ -0,0 +1,93 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+)
+
+type WebhookResponse struct {
+	ID         uint      `json:"id"`
+	URL        string    `json:"url"`
+	EventTypes []string  `json:"event_types"`
+	Secret     string    `json:"secret,omitempty"` // hanya di isi saat create dan rotate secret
+	Active     bool      `json:"active"`
+	CreatedAt  time.Time `json:"created_date"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type DeliveryResponse struct {
+	ID             uint            `json:"id"`
+	WebhookID      uint            `json:"webhook_id"`
+	EventID        string          `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  *time.Time      `json:"next_attempt_at"` // nil kalau sudah tidak akan dikirim lagi
+	LastStatusCode int             `json:"last_status_code"`
+	LastError      string          `json:"last_error"`
+	DeliveredAt    *time.Time      `json:"delivered_at"`
+	CreatedAt      time.Time       `json:"created_date"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+func ToWebhookResponse(subscription *domain.WebhookSubscription) *WebhookResponse {
+	if subscription == nil {
+		return nil
+	}
+
+	return &WebhookResponse{
+		ID:         subscription.ID,
+		URL:        subscription.URL,
+		EventTypes: subscription.EventTypes,
+		Active:     subscription.Active,
+		CreatedAt:  subscription.CreatedDate,
+		UpdatedAt:  subscription.UpdatedDate,
+	}
+}
+
+func ToWebhookResponseList(subscriptions []domain.WebhookSubscription) []WebhookResponse {
+	responses := make([]WebhookResponse, len(subscriptions))
+	for i, subscription := range subscriptions {
+		responses[i] = *ToWebhookResponse(&subscription)
+	}
+	return responses
+}
+
+func ToDeliveryResponse(delivery *domain.WebhookDelivery) *DeliveryResponse {
+	if delivery == nil {
+		return nil
+	}
+
+	resp := &DeliveryResponse{
+		ID:             delivery.ID,
+		WebhookID:      delivery.SubscriptionID,
+		EventID:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Payload:        delivery.Payload,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		DeliveredAt:    delivery.DeliveredAt,
+		CreatedAt:      delivery.CreatedDate,
+		UpdatedAt:      delivery.UpdatedDate,
+	}
+
+	if delivery.Status == string(domain.DeliveryPending) {
+		next := delivery.NextAttemptAt
+		resp.NextAttemptAt = &next
+	}
+
+	return resp
+}
+
+func ToDeliveryResponseList(deliveries []domain.WebhookDelivery) []DeliveryResponse {
+	responses := make([]DeliveryResponse, len(deliveries))
+	for i, delivery := range deliveries {
+		responses[i] = *ToDeliveryResponse(&delivery)
+	}
+	return responses
+}
@@ -7,53 +7,56 @@ import (
 	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/article"
 	usecase "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/usecase/article"
 
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/apperror"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/locale"
 	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/response"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/i18n"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
 	"github.com/gofiber/fiber/v2"
+	"github.com/spf13/viper"
 	"go.uber.org/zap"
 )
 
 type ArticleHandler struct {
 	articleUsecase usecase.ArticleUsecase
+	config         *viper.Viper
+	locales        locale.Settings
 	log            *zap.Logger
 }
 
 func NewArticleHandler(
 	articleUsecase usecase.ArticleUsecase,
+	config *viper.Viper,
 	log *zap.Logger,
 ) *ArticleHandler {
 	return &ArticleHandler{
 		articleUsecase: articleUsecase,
+		config:         config,
+		locales:        locale.FromConfig(config),
 		log:            log,
 	}
 }
 
+// negotiateLocale pilih locale dari ?lang= atau Accept-Language, response di tandai Vary: Accept-Language
+func (h *ArticleHandler) negotiateLocale(ctx *fiber.Ctx) string {
+	lang := h.locales.Negotiate(ctx.Query("lang"), ctx.Get(fiber.HeaderAcceptLanguage))
+	ctx.Vary(fiber.HeaderAcceptLanguage)
+	return lang
+}
+
 func (h *ArticleHandler) Create(ctx *fiber.Ctx) error {
 	var req dto.CreateArticleRequest
 
 	// Parse Request Body
 	if err := ctx.BodyParser(&req); err != nil {
-		h.log.Error("failed to parse create article request", zap.Error(err))
-		errResponse := response.NewErrorResponseWithPath(
-			"Invalid request body",
-			string(dto.ErrCodeValidation),
-			ctx.Path(),
-		)
-
-		return ctx.Status(fiber.StatusBadRequest).JSON(errResponse)
+		logger.FromContext(ctx.Context()).Error("failed to parse create article request", zap.Error(err))
+		return response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), "Invalid request body").WithMessageID("body")
 	}
 
 	// Validate Request
 	if err := req.Validate(); err != nil {
-		h.log.Warn("validation error on create article", zap.Error(err))
-		errResponse := response.NewErrorResponseWithDetails(
-			err.Error(),
-			string(dto.MapErrorToCode(err)),
-			map[string]any{
-				"field": "create_article",
-			},
-		)
-		errResponse.Path = ctx.Path()
-		return ctx.Status(fiber.StatusBadRequest).JSON(errResponse)
+		logger.FromContext(ctx.Context()).Warn("validation error on create article", zap.Error(err))
+		return err
 	}
 
 	// Convert to entity / domain
@@ -62,13 +65,8 @@ func (h *ArticleHandler) Create(ctx *fiber.Ctx) error {
 	// Create article
 	createdArticle, err := h.articleUsecase.Create(ctx.Context(), article)
 	if err != nil {
-		h.log.Error("failed to create article", zap.Error(err))
-		errResp := response.NewErrorResponseWithPath(
-			"Failed to create article",
-			string(dto.MapErrorToCode(err)),
-			ctx.Path(),
-		)
-		return ctx.Status(fiber.StatusInternalServerError).JSON(errResp)
+		logger.FromContext(ctx.Context()).Error("failed to create article", zap.Error(err))
+		return apperror.Wrap(err, string(dto.ErrCodeCreateFailed), "Failed to create article")
 	}
 
 	// Convert response
@@ -76,7 +74,7 @@ func (h *ArticleHandler) Create(ctx *fiber.Ctx) error {
 
 	resp := response.NewSuccessResponseWithPath(
 		articleResponse,
-		"Article created successfully",
+		i18n.Message(ctx.Context(), "article.created"),
 		ctx.Path(),
 	)
 	return ctx.Status(fiber.StatusCreated).JSON(resp)
@@ -86,13 +84,8 @@ func (h *ArticleHandler) GetList(ctx *fiber.Ctx) error {
 	// Parse filter from query param
 	articleFilter := dto.NewArticleFilter()
 	if err := ctx.QueryParser(articleFilter); err != nil {
-		h.log.Error("faield to parse query param", zap.Error(err))
-		errResponse := response.NewErrorResponseWithPath(
-			"Invalid query parameters",
-			string(dto.ErrCodeValidation),
-			ctx.Path(),
-		)
-		return ctx.Status(fiber.StatusBadRequest).JSON(errResponse)
+		logger.FromContext(ctx.Context()).Error("faield to parse query param", zap.Error(err))
+		return response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), "Invalid query parameters").WithMessageID("query")
 	}
 
 	category := ctx.Query("category")
@@ -106,32 +99,24 @@ func (h *ArticleHandler) GetList(ctx *fiber.Ctx) error {
 		articleFilter.Filters.Status = status
 	}
 
-	h.log.Info("Parsed filter values",
+	articleFilter.Filters.Locale = h.negotiateLocale(ctx)
+
+	logger.FromContext(ctx.Context()).Info("Parsed filter values",
 		zap.String("category", articleFilter.GetCategory()),
 		zap.String("status", articleFilter.GetStatus()),
 	)
 
 	// Validate filter
 	if err := articleFilter.Validate(); err != nil {
-		h.log.Warn("validation error on filter", zap.Error(err))
-		errResponse := response.NewErrorResponseWithPath(
-			err.Error(),
-			string(dto.MapErrorToCode(err)),
-			ctx.Path(),
-		)
-		return ctx.Status(fiber.StatusBadRequest).JSON(errResponse)
+		logger.FromContext(ctx.Context()).Warn("validation error on filter", zap.Error(err))
+		return err
 	}
 
 	// Get article data
 	articles, total, err := h.articleUsecase.GetList(ctx.Context(), articleFilter)
 	if err != nil {
-		h.log.Error("faield to get articles", zap.Error(err))
-		errResponse := response.NewErrorResponseWithPath(
-			"failed to get articles",
-			string(dto.ErrCodeDBError),
-			ctx.Path(),
-		)
-		return ctx.Status(fiber.StatusInternalServerError).JSON(errResponse)
+		logger.FromContext(ctx.Context()).Error("faield to get articles", zap.Error(err))
+		return apperror.Wrap(err, string(dto.ErrCodeDBError), "failed to get articles")
 	}
 
 	// Convert response
@@ -147,7 +132,7 @@ func (h *ArticleHandler) GetList(ctx *fiber.Ctx) error {
 	// Return data passing
 	responseHandler := response.NewPaginatedResponseWithPath(
 		articleResponses,
-		"Articles retrieved successfully",
+		i18n.Message(ctx.Context(), "article.list"),
 		ctx.Path(),
 		paginationMeta,
 	)
@@ -160,33 +145,59 @@ func (h *ArticleHandler) GetDetailByID(ctx *fiber.Ctx) error {
 	articleIDStr := ctx.Params("article_id")
 	articleID, err := strconv.ParseUint(articleIDStr, 10, 32)
 	if err != nil {
-		h.log.Warn("invalid article id format", zap.Error(err), zap.String("id", articleIDStr))
-		errResp := response.NewErrorResponseWithPath(
-			"Invalid article ID format",
-			string(dto.ErrCodeValidation),
-			ctx.Path(),
-		)
-		return ctx.Status(fiber.StatusBadRequest).JSON(errResp)
+		logger.FromContext(ctx.Context()).Warn("invalid article id format", zap.Error(err), zap.String("id", articleIDStr))
+		return response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), "Invalid article ID format").WithMessageID("article_id")
 	}
 
 	// Get by id
-	article, err := h.articleUsecase.GetDetailByID(ctx.Context(), uint(articleID))
+	article, err := h.articleUsecase.GetDetailByID(ctx.Context(), uint(articleID), h.negotiateLocale(ctx))
 	if err != nil {
-		h.log.Error("failed to get article detail", zap.Error(err), zap.Uint("id", uint(articleID)))
-		errResp := response.NewErrorResponseWithPath(
-			"Article not found",
-			string(dto.ErrCodeNotFound),
-			ctx.Path(),
-		)
-		return ctx.Status(fiber.StatusNotFound).JSON(errResp)
+		logger.FromContext(ctx.Context()).Error("failed to get article detail", zap.Error(err), zap.Uint("id", uint(articleID)))
+		return apperror.Wrap(err, string(dto.ErrCodeDBError), "failed to get article")
+	}
+
+	// Konteks series opsional untuk navigasi previous / next
+	if seriesIDStr := ctx.Query("series"); seriesIDStr != "" {
+		seriesID, err := strconv.ParseUint(seriesIDStr, 10, 32)
+		if err != nil {
+			return response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), "Invalid series ID format").WithMessageID("series_id")
+		}
+
+		if err := h.articleUsecase.LoadSeriesNavigation(ctx.Context(), article, uint(seriesID)); err != nil {
+			return apperror.Wrap(err, string(dto.ErrCodeDBError), "failed to load series navigation")
+		}
 	}
 
 	// Convert response
 	articleResponse := dto.ToArticleResponse(article)
+	ctx.Set(fiber.HeaderContentLanguage, article.Locale)
 
 	resp := response.NewSuccessResponseWithPath(
 		articleResponse,
-		"Article retrieved successfully",
+		i18n.Message(ctx.Context(), "article.detail"),
+		ctx.Path(),
+	)
+
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+func (h *ArticleHandler) GetRelated(ctx *fiber.Ctx) error {
+	// Parse string article_id into uint id db
+	articleIDStr := ctx.Params("article_id")
+	articleID, err := strconv.ParseUint(articleIDStr, 10, 32)
+	if err != nil {
+		logger.FromContext(ctx.Context()).Warn("invalid article id format", zap.Error(err), zap.String("id", articleIDStr))
+		return response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), "Invalid article ID format").WithMessageID("article_id")
+	}
+
+	articles, err := h.articleUsecase.GetRelated(ctx.Context(), uint(articleID), ctx.QueryInt("limit"))
+	if err != nil {
+		return apperror.Wrap(err, string(dto.ErrCodeInternalError), "Failed to get related articles")
+	}
+
+	resp := response.NewSuccessResponseWithPath(
+		dto.ToArticleResponseList(articles),
+		i18n.Message(ctx.Context(), "article.related"),
 		ctx.Path(),
 	)
 
@@ -198,55 +209,28 @@ func (h *ArticleHandler) UpdateByID(ctx *fiber.Ctx) error {
 	articleIDStr := ctx.Params("article_id")
 	articleID, err := strconv.ParseUint(articleIDStr, 10, 32)
 	if err != nil {
-		h.log.Warn("invalid article id format", zap.Error(err), zap.String("id", articleIDStr))
-		errResp := response.NewErrorResponseWithPath(
-			"Invalid article ID format",
-			string(dto.ErrCodeValidation),
-			ctx.Path(),
-		)
-		return ctx.Status(fiber.StatusBadRequest).JSON(errResp)
+		logger.FromContext(ctx.Context()).Warn("invalid article id format", zap.Error(err), zap.String("id", articleIDStr))
+		return response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), "Invalid article ID format").WithMessageID("article_id")
 	}
 
 	// Parse request body
 	var req dto.UpdateArticleRequest
 	if err := ctx.BodyParser(&req); err != nil {
-		h.log.Error("failed to parse update article request", zap.Error(err))
-		errResp := response.NewErrorResponseWithPath(
-			"Invalid request body",
-			string(dto.ErrCodeValidation),
-			ctx.Path(),
-		)
-		return ctx.Status(fiber.StatusBadRequest).JSON(errResp)
+		logger.FromContext(ctx.Context()).Error("failed to parse update article request", zap.Error(err))
+		return response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), "Invalid request body").WithMessageID("body")
 	}
 
 	// Validate request
 	if err := req.Validate(); err != nil {
-		h.log.Warn("validation error on update article", zap.Error(err))
-		errResp := response.NewErrorResponseWithDetails(
-			err.Error(),
-			string(dto.MapErrorToCode(err)),
-			map[string]any{
-				"field": "update_article",
-			},
-		)
-		errResp.Path = ctx.Path()
-		return ctx.Status(fiber.StatusBadRequest).JSON(errResp)
+		logger.FromContext(ctx.Context()).Warn("validation error on update article", zap.Error(err))
+		return err
 	}
 
 	// Update article
 	updatedArticle, err := h.articleUsecase.UpdateByID(ctx.Context(), uint(articleID), &req)
 	if err != nil {
-		h.log.Error("failed to update article", zap.Error(err), zap.Uint("id", uint(articleID)))
-		errResp := response.NewErrorResponseWithPath(
-			"Failed to update article",
-			string(dto.MapErrorToCode(err)),
-			ctx.Path(),
-		)
-		statusCode := fiber.StatusInternalServerError
-		if err == dto.ErrArticleNotFound {
-			statusCode = fiber.StatusNotFound
-		}
-		return ctx.Status(statusCode).JSON(errResp)
+		logger.FromContext(ctx.Context()).Error("failed to update article", zap.Error(err), zap.Uint("id", uint(articleID)))
+		return apperror.Wrap(err, string(dto.ErrCodeUpdateFailed), "Failed to update article")
 	}
 
 	// Convert to response
@@ -254,7 +238,7 @@ func (h *ArticleHandler) UpdateByID(ctx *fiber.Ctx) error {
 
 	resp := response.NewSuccessResponseWithPath(
 		articleResponse,
-		"Article updated successfully",
+		i18n.Message(ctx.Context(), "article.updated"),
 		ctx.Path(),
 	)
 	return ctx.Status(fiber.StatusOK).JSON(resp)
@@ -265,33 +249,19 @@ func (h *ArticleHandler) DeleteByID(ctx *fiber.Ctx) error {
 	articleIDStr := ctx.Params("article_id")
 	articleID, err := strconv.ParseUint(articleIDStr, 10, 32)
 	if err != nil {
-		h.log.Warn("invalid article id format", zap.Error(err), zap.String("id", articleIDStr))
-		errResp := response.NewErrorResponseWithPath(
-			"Invalid article ID format",
-			string(dto.ErrCodeValidation),
-			ctx.Path(),
-		)
-		return ctx.Status(fiber.StatusBadRequest).JSON(errResp)
+		logger.FromContext(ctx.Context()).Warn("invalid article id format", zap.Error(err), zap.String("id", articleIDStr))
+		return response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), "Invalid article ID format").WithMessageID("article_id")
 	}
 
 	// Delete by id
 	if err := h.articleUsecase.DeleteByID(ctx.Context(), uint(articleID)); err != nil {
-		h.log.Error("failed to delete article", zap.Error(err), zap.Uint("id", uint(articleID)))
-		errResp := response.NewErrorResponseWithPath(
-			"Failed to delete article",
-			string(dto.MapErrorToCode(err)),
-			ctx.Path(),
-		)
-		statusCode := fiber.StatusInternalServerError
-		if err == dto.ErrArticleNotFound {
-			statusCode = fiber.StatusNotFound
-		}
-		return ctx.Status(statusCode).JSON(errResp)
+		logger.FromContext(ctx.Context()).Error("failed to delete article", zap.Error(err), zap.Uint("id", uint(articleID)))
+		return apperror.Wrap(err, string(dto.ErrCodeDeleteFailed), "Failed to delete article")
 	}
 
 	resp := response.NewSuccessResponseWithPath(
 		"",
-		"Article deleted successfully",
+		i18n.Message(ctx.Context(), "article.deleted"),
 		ctx.Path(),
 	)
 
@@ -301,9 +271,25 @@ func (h *ArticleHandler) DeleteByID(ctx *fiber.Ctx) error {
 // === Helper Handler ===
 func toDomainArticle(req *dto.CreateArticleRequest) *domain.Article {
 	return &domain.Article{
-		Title:    req.Title,
-		Content:  req.Content,
-		Category: req.Category,
-		Status:   req.Status,
+		Title:         req.Title,
+		Content:       req.Content,
+		ContentFormat: req.ContentFormat,
+		Category:      req.Category,
+		Status:        req.Status,
+		CoverMediaID:  req.CoverMediaID,
+		Attachments:   toDomainAttachments(req.AttachmentIDs),
+	}
+}
+
+// toDomainAttachments hanya berisi ID, detail media di load oleh usecase
+func toDomainAttachments(ids []uint) []domain.Media {
+	if ids == nil {
+		return nil
+	}
+
+	attachments := make([]domain.Media, len(ids))
+	for i, id := range ids {
+		attachments[i] = domain.Media{ID: id}
 	}
+	return attachments
 }
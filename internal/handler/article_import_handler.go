@@ -0,0 +1,102 @@
+package handler
+
+import (
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/article"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/apperror"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/response"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/i18n"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// Default jumlah baris sebelum import dijalankan sebagai async job
+const defaultImportAsyncThreshold = 500
+
+func (h *ArticleHandler) Import(ctx *fiber.Ctx) error {
+	// Get uploaded file
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		logger.FromContext(ctx.Context()).Warn("import file is missing", zap.Error(err))
+		return response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), "Import file is required").WithMessageID("import_file")
+	}
+
+	format, err := dto.DetectImportFormat(ctx.FormValue("format", ctx.Query("format")), fileHeader.Filename)
+	if err != nil {
+		logger.FromContext(ctx.Context()).Warn("unsupported import format", zap.Error(err), zap.String("filename", fileHeader.Filename))
+		return err
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to open import file", zap.Error(err))
+		return apperror.Wrap(err, string(dto.ErrCodeInternalError), "Failed to read import file")
+	}
+	defer file.Close()
+
+	// Parse file
+	payload, err := dto.ParseImportFile(file, format)
+	if err != nil {
+		logger.FromContext(ctx.Context()).Warn("failed to parse import file", zap.Error(err))
+		// Error domain (format, header, file kosong) di render error handler supaya id pesannya ikut
+		if _, ok := apperror.As(err); ok {
+			return err
+		}
+		return response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), err.Error())
+	}
+
+	dryRun := ctx.QueryBool("dry_run", false) || ctx.FormValue("dry_run") == "true"
+
+	// File besar diproses sebagai async job
+	threshold := h.config.GetInt("IMPORT_ASYNC_THRESHOLD")
+	if threshold <= 0 {
+		threshold = defaultImportAsyncThreshold
+	}
+
+	if ctx.QueryBool("async", false) || payload.Len() > threshold {
+		job := h.articleUsecase.StartImportJob(ctx.Context(), payload, dryRun)
+
+		ctx.Location(ctx.Path() + "/" + job.ID)
+		resp := response.NewSuccessResponseWithPath(
+			job,
+			i18n.Message(ctx.Context(), "article.import_accepted"),
+			ctx.Path(),
+		)
+		return ctx.Status(fiber.StatusAccepted).JSON(resp)
+	}
+
+	report, err := h.articleUsecase.Import(ctx.Context(), payload, dryRun)
+	if err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to import articles", zap.Error(err))
+		return apperror.Wrap(err, string(dto.ErrCodeInternalError), "Failed to import articles")
+	}
+
+	message := i18n.Message(ctx.Context(), "article.imported")
+	if dryRun {
+		message = i18n.Message(ctx.Context(), "article.import_dry_run")
+	}
+
+	resp := response.NewSuccessResponseWithPath(
+		report,
+		message,
+		ctx.Path(),
+	)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+func (h *ArticleHandler) GetImportJob(ctx *fiber.Ctx) error {
+	jobID := ctx.Params("job_id")
+
+	job, err := h.articleUsecase.GetImportJob(ctx.Context(), jobID)
+	if err != nil {
+		logger.FromContext(ctx.Context()).Warn("import job not found", zap.String("job_id", jobID))
+		return err
+	}
+
+	resp := response.NewSuccessResponseWithPath(
+		job,
+		i18n.Message(ctx.Context(), "article.import_job"),
+		ctx.Path(),
+	)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
@@ -0,0 +1,206 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/article"
+	usecase "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/usecase/article"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/apperror"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/audit"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/response"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/presence"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/tenant"
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+const (
+	// Batas waktu satu kali tulis ke client
+	presenceWriteTimeout = 10 * time.Second
+
+	// Koneksi tanpa pong / message selama ini dianggap putus, ping dikirim lebih sering dari itu
+	presencePongWait     = 60 * time.Second
+	presencePingInterval = presencePongWait * 9 / 10
+
+	// Ukuran maksimal message dari client, client hanya mengirim perintah kecil
+	presenceMaxMessageSize = 1024
+
+	// Key Locals untuk session presence, websocket.Conn hanya membawa Locals dengan key string
+	presenceSessionKey = "presence_session"
+)
+
+// presenceSession hasil resolve sebelum upgrade, context request tidak ikut ke koneksi websocket
+type presenceSession struct {
+	tenantID  string
+	articleID uint
+	user      string
+}
+
+// presenceCommand message dari client, contoh {"type":"edit"}
+type presenceCommand struct {
+	Type string `json:"type"`
+}
+
+type ArticlePresenceHandler struct {
+	articleUsecase usecase.ArticleUsecase
+	hub            *presence.Hub
+	origins        []string
+	log            *zap.Logger
+}
+
+func NewArticlePresenceHandler(
+	articleUsecase usecase.ArticleUsecase,
+	hub *presence.Hub,
+	config *viper.Viper,
+	log *zap.Logger,
+) *ArticlePresenceHandler {
+	var origins []string
+	for _, origin := range strings.Split(config.GetString("PRESENCE_ALLOWED_ORIGINS"), ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+
+	return &ArticlePresenceHandler{
+		articleUsecase: articleUsecase,
+		hub:            hub,
+		origins:        origins,
+		log:            log,
+	}
+}
+
+// Upgrade validasi request sebelum di upgrade ke websocket, artikel harus ada di tenant request
+func (h *ArticlePresenceHandler) Upgrade(ctx *fiber.Ctx) error {
+	if !websocket.IsWebSocketUpgrade(ctx) {
+		return response.NewProblem(fiber.StatusUpgradeRequired, string(dto.ErrCodeValidation), "WebSocket upgrade required").WithMessageID("websocket")
+	}
+
+	articleIDStr := ctx.Params("article_id")
+	articleID, err := strconv.ParseUint(articleIDStr, 10, 32)
+	if err != nil {
+		logger.FromContext(ctx.Context()).Warn("invalid article id format", zap.Error(err), zap.String("id", articleIDStr))
+		return response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), "Invalid article ID format").WithMessageID("article_id")
+	}
+
+	if _, err := h.articleUsecase.GetDetailByID(ctx.Context(), uint(articleID), ""); err != nil {
+		return apperror.Wrap(err, string(dto.ErrCodeDBError), "failed to get article")
+	}
+
+	// Nama user dari actor audit, ?user= hanya dipakai kalau request tidak membawa actor
+	user := audit.FromContext(ctx.Context()).Actor
+	if user == audit.AnonymousActor && ctx.Query("user") != "" {
+		user = ctx.Query("user")
+	}
+
+	tenantID, _ := tenant.FromContext(ctx.Context())
+	ctx.Locals(presenceSessionKey, presenceSession{
+		tenantID:  tenantID,
+		articleID: uint(articleID),
+		user:      user,
+	})
+
+	return ctx.Next()
+}
+
+// Connect handler websocket, dibuat lewat websocket.New supaya origin yang diizinkan ikut di cek
+func (h *ArticlePresenceHandler) Connect() fiber.Handler {
+	return websocket.New(h.serve, websocket.Config{Origins: h.origins})
+}
+
+func (h *ArticlePresenceHandler) serve(conn *websocket.Conn) {
+	session, ok := conn.Locals(presenceSessionKey).(presenceSession)
+	if !ok {
+		conn.Close()
+		return
+	}
+
+	client, err := h.hub.Join(context.Background(), session.tenantID, session.articleID, session.user)
+	if err != nil {
+		h.log.Error("failed to join presence room", zap.Uint("article_id", session.articleID), zap.Error(err))
+		conn.Close()
+		return
+	}
+
+	h.log.Debug("presence connected",
+		zap.Uint("article_id", session.articleID),
+		zap.String("user", session.user),
+		zap.String("session_id", client.SessionID()),
+	)
+
+	writerDone := make(chan struct{})
+	go h.writeLoop(conn, client, writerDone)
+
+	h.readLoop(conn, client)
+
+	h.hub.Leave(context.Background(), client)
+	<-writerDone
+	conn.Close()
+}
+
+func (h *ArticlePresenceHandler) readLoop(conn *websocket.Conn, client *presence.Client) {
+	conn.SetReadLimit(presenceMaxMessageSize)
+	conn.SetReadDeadline(time.Now().Add(presencePongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(presencePongWait))
+	})
+
+	for {
+		var command presenceCommand
+		if err := conn.ReadJSON(&command); err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				h.log.Debug("presence connection closed", zap.String("session_id", client.SessionID()), zap.Error(err))
+			}
+			return
+		}
+
+		conn.SetReadDeadline(time.Now().Add(presencePongWait))
+		h.hub.Handle(context.Background(), client, command.Type)
+	}
+}
+
+// writeLoop satu-satunya goroutine yang menulis ke koneksi, berhenti saat hub memutus client
+func (h *ArticlePresenceHandler) writeLoop(conn *websocket.Conn, client *presence.Client, done chan struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(presencePingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case message, ok := <-client.Messages():
+			conn.SetWriteDeadline(time.Now().Add(presenceWriteTimeout))
+			if !ok {
+				// Client di putus hub (shutdown atau terlalu lambat), read loop ikut berhenti
+				conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseGoingAway, ""))
+				conn.Close()
+				return
+			}
+
+			if err := conn.WriteJSON(message); err != nil {
+				h.log.Debug("failed to write presence message", zap.String("session_id", client.SessionID()), zap.Error(err))
+				conn.Close()
+				h.drain(client)
+				return
+			}
+		case <-ticker.C:
+			conn.SetWriteDeadline(time.Now().Add(presenceWriteTimeout))
+			if err := conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				conn.Close()
+				h.drain(client)
+				return
+			}
+		}
+	}
+}
+
+// drain buang message sampai channel ditutup oleh Leave supaya hub tidak menganggap client lambat
+func (h *ArticlePresenceHandler) drain(client *presence.Client) {
+	for range client.Messages() {
+	}
+}
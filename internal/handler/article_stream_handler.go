@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/article"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/response"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/events"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/tenant"
+	"github.com/gofiber/fiber/v2"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+const (
+	// Batas waktu satu kali tulis ke client, client yang macet lebih lama dari ini diputus
+	streamWriteTimeout = 10 * time.Second
+
+	// Jeda reconnect yang disarankan ke EventSource, dalam milidetik
+	streamRetryMillis = 3000
+)
+
+type ArticleStreamHandler struct {
+	stream    *events.Stream // nil kalau relay instance ini tidak publish ke bus
+	heartbeat time.Duration
+	log       *zap.Logger
+}
+
+func NewArticleStreamHandler(stream *events.Stream, config *viper.Viper, log *zap.Logger) *ArticleStreamHandler {
+	heartbeat := config.GetDuration("SSE_HEARTBEAT_INTERVAL")
+	if heartbeat <= 0 {
+		heartbeat = 15 * time.Second
+	}
+
+	return &ArticleStreamHandler{
+		stream:    stream,
+		heartbeat: heartbeat,
+		log:       log,
+	}
+}
+
+// Stream kirim perubahan artikel sebagai Server-Sent Events. Client yang reconnect dengan
+// Last-Event-ID menerima event yang terlewat dari replay buffer, atau event "reset" kalau
+// event tersebut sudah tidak ada di buffer sehingga client perlu memuat ulang list
+func (h *ArticleStreamHandler) Stream(ctx *fiber.Ctx) error {
+	if h.stream == nil {
+		return response.NewProblem(fiber.StatusServiceUnavailable, "SERVICE_UNAVAILABLE",
+			"Article stream is disabled because the outbox relay does not publish to the bus on this instance").
+			WithMessageID("article_stream")
+	}
+
+	tenantID, _ := tenant.FromContext(ctx.Context())
+	streamFilter := &dto.StreamFilter{
+		Category: ctx.Query("category"),
+		Status:   ctx.Query("status"),
+		TenantID: tenantID,
+	}
+
+	if err := streamFilter.Validate(); err != nil {
+		return err
+	}
+
+	// Browser mengirim header Last-Event-ID saat reconnect, query param untuk client yang tidak bisa set header
+	lastEventID := ctx.Get("Last-Event-ID", ctx.Query("last_event_id"))
+	backlog, found, stream, cancel := h.stream.Subscribe(lastEventID)
+
+	// Logger di ambil sekarang, context request tidak boleh dipakai lagi di dalam stream writer
+	log := logger.FromContext(ctx.Context())
+	log.Info("article stream connected",
+		zap.String("tenant_id", tenantID),
+		zap.String("category", streamFilter.Category),
+		zap.String("status", streamFilter.Status),
+		zap.String("last_event_id", lastEventID),
+		zap.Int("backlog", len(backlog)),
+	)
+
+	ctx.Set(fiber.HeaderContentType, "text/event-stream")
+	ctx.Set(fiber.HeaderCacheControl, "no-cache")
+	ctx.Set(fiber.HeaderConnection, "keep-alive")
+	ctx.Set("X-Accel-Buffering", "no")
+
+	conn := ctx.Context().Conn()
+	ctx.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer cancel()
+		defer log.Info("article stream disconnected", zap.String("tenant_id", tenantID))
+
+		// Write deadline dari fasthttp hanya di set sekali per response, di perpanjang setiap kali tulis
+		flush := func() bool {
+			conn.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+			return w.Flush() == nil
+		}
+
+		fmt.Fprintf(w, "retry: %d\n\n", streamRetryMillis)
+		if !found {
+			fmt.Fprint(w, "event: reset\ndata: {}\n\n")
+		}
+		for _, event := range backlog {
+			if streamFilter.Matches(event) {
+				writeStreamEvent(w, event)
+			}
+		}
+		if !flush() {
+			return
+		}
+
+		heartbeat := time.NewTicker(h.heartbeat)
+		defer heartbeat.Stop()
+
+		for {
+			select {
+			case event, ok := <-stream:
+				// Channel ditutup saat server shutdown atau client terlalu lambat
+				if !ok {
+					return
+				}
+				if !streamFilter.Matches(event) {
+					continue
+				}
+				writeStreamEvent(w, event)
+			case <-heartbeat.C:
+				fmt.Fprint(w, ": heartbeat\n\n")
+			}
+
+			if !flush() {
+				return
+			}
+		}
+	})
+
+	return nil
+}
+
+func writeStreamEvent(w *bufio.Writer, event events.Event) {
+	data, _ := json.Marshal(event)
+	fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+}
@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/article"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/response"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/i18n"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+func (h *ArticleHandler) CreateTranslation(ctx *fiber.Ctx) error {
+	// Parse string article_id into uint id db
+	articleIDStr := ctx.Params("article_id")
+	articleID, err := strconv.ParseUint(articleIDStr, 10, 32)
+	if err != nil {
+		logger.FromContext(ctx.Context()).Warn("invalid article id format", zap.Error(err), zap.String("id", articleIDStr))
+		return response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), "Invalid article ID format").WithMessageID("article_id")
+	}
+
+	var req dto.CreateTranslationRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to parse create translation request", zap.Error(err))
+		return response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), "Invalid request body").WithMessageID("body")
+	}
+
+	if err := req.Validate(); err != nil {
+		logger.FromContext(ctx.Context()).Warn("validation failed on create translation", zap.Error(err))
+		return err
+	}
+
+	translation, err := h.articleUsecase.CreateTranslation(ctx.Context(), uint(articleID), &domain.ArticleTranslation{
+		Locale:        req.Locale,
+		Title:         req.Title,
+		Content:       req.Content,
+		ContentFormat: req.ContentFormat,
+		Status:        req.Status,
+	})
+	if err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to create translation", zap.Error(err))
+		return err
+	}
+
+	resp := response.NewSuccessResponseWithPath(
+		dto.ToTranslationResponse(translation),
+		i18n.Message(ctx.Context(), "translation.created"),
+		ctx.Path(),
+	)
+	return ctx.Status(fiber.StatusCreated).JSON(resp)
+}
+
+func (h *ArticleHandler) GetTranslations(ctx *fiber.Ctx) error {
+	// Parse string article_id into uint id db
+	articleIDStr := ctx.Params("article_id")
+	articleID, err := strconv.ParseUint(articleIDStr, 10, 32)
+	if err != nil {
+		logger.FromContext(ctx.Context()).Warn("invalid article id format", zap.Error(err), zap.String("id", articleIDStr))
+		return response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), "Invalid article ID format").WithMessageID("article_id")
+	}
+
+	translations, err := h.articleUsecase.GetTranslations(ctx.Context(), uint(articleID))
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewSuccessResponseWithPath(
+		dto.ToTranslationResponseList(translations),
+		i18n.Message(ctx.Context(), "translation.list"),
+		ctx.Path(),
+	)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
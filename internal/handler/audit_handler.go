@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/audit"
+	usecase "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/usecase/audit"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/response"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/i18n"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/tenant"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+type AuditHandler struct {
+	auditUsecase usecase.AuditUsecase
+	log          *zap.Logger
+}
+
+func NewAuditHandler(
+	auditUsecase usecase.AuditUsecase,
+	log *zap.Logger,
+) *AuditHandler {
+	return &AuditHandler{
+		auditUsecase: auditUsecase,
+		log:          log,
+	}
+}
+
+func (h *AuditHandler) GetList(ctx *fiber.Ctx) error {
+	auditFilter, err := h.parseFilter(ctx)
+	if err != nil {
+		return err
+	}
+
+	logs, total, err := h.auditUsecase.GetList(ctx.Context(), auditFilter)
+	if err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to get audit logs", zap.Error(err))
+		return err
+	}
+
+	paginationMeta := response.CalculatePaginationMeta(
+		auditFilter.GetDefaultPage(),
+		auditFilter.GetDefaultLimit(),
+		total,
+	)
+
+	responseHandler := response.NewPaginatedResponseWithPath(
+		dto.ToAuditLogResponseList(logs),
+		i18n.Message(ctx.Context(), "audit.list"),
+		ctx.Path(),
+		paginationMeta,
+	)
+	return ctx.Status(fiber.StatusOK).JSON(responseHandler)
+}
+
+// Export kirim semua audit log yang cocok dengan filter sebagai CSV, pagination diabaikan.
+// CSV di stream per batch langsung ke client supaya memory tidak ikut membesar dengan jumlah audit log
+func (h *AuditHandler) Export(ctx *fiber.Ctx) error {
+	auditFilter, err := h.parseFilter(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Context request tidak boleh dipakai lagi di dalam stream writer, tenant dan logger di bawa ke context baru
+	log := logger.FromContext(ctx.Context())
+	exportCtx := logger.WithLogger(context.Background(), log)
+	if tenantID, ok := tenant.FromContext(ctx.Context()); ok {
+		exportCtx = tenant.WithTenant(exportCtx, tenantID)
+	}
+
+	fileName := fmt.Sprintf("audit-%s.csv", time.Now().UTC().Format("20060102-150405"))
+	ctx.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+	ctx.Set(fiber.HeaderContentDisposition, `attachment; filename="`+fileName+`"`)
+	ctx.Status(fiber.StatusOK)
+
+	ctx.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		writer := csv.NewWriter(w)
+		if err := writer.Write(dto.AuditCSVHeader); err != nil {
+			return
+		}
+
+		// Status sudah terkirim, error di tengah stream hanya bisa di log dan CSV berhenti di batch terakhir
+		err := h.auditUsecase.Export(exportCtx, auditFilter, func(logs []domain.AuditLog) error {
+			for _, log := range logs {
+				if err := writer.Write(dto.ToAuditCSVRecord(&log)); err != nil {
+					return err
+				}
+			}
+
+			// Flush per batch, error berarti client sudah disconnect dan export di hentikan
+			writer.Flush()
+			if err := writer.Error(); err != nil {
+				return err
+			}
+			return w.Flush()
+		})
+		if err == nil {
+			writer.Flush()
+			err = writer.Error()
+		}
+		if err != nil {
+			log.Error("failed to export audit logs", zap.Error(err))
+		}
+	})
+
+	return nil
+}
+
+func (h *AuditHandler) parseFilter(ctx *fiber.Ctx) (*dto.AuditFilter, error) {
+	auditFilter := dto.NewAuditFilter()
+	if err := ctx.QueryParser(auditFilter); err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to parse query param", zap.Error(err))
+		return nil, response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), "Invalid query parameters").WithMessageID("query")
+	}
+
+	auditFilter.Filters.Actor = ctx.Query("actor")
+	auditFilter.Filters.Entity = ctx.Query("entity")
+	auditFilter.Filters.Action = ctx.Query("action")
+	auditFilter.Filters.From = ctx.Query("from")
+	auditFilter.Filters.To = ctx.Query("to")
+
+	if entityID, err := strconv.ParseUint(ctx.Query("entity_id"), 10, 32); err == nil {
+		auditFilter.Filters.EntityID = uint(entityID)
+	}
+
+	if err := auditFilter.Validate(); err != nil {
+		logger.FromContext(ctx.Context()).Warn("validation error on audit filter", zap.Error(err))
+		return nil, err
+	}
+
+	return auditFilter, nil
+}
@@ -0,0 +1,202 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/comment"
+	usecase "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/usecase/comment"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/apperror"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/response"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/i18n"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+type CommentHandler struct {
+	commentUsecase usecase.CommentUsecase
+	log            *zap.Logger
+}
+
+func NewCommentHandler(
+	commentUsecase usecase.CommentUsecase,
+	log *zap.Logger,
+) *CommentHandler {
+	return &CommentHandler{
+		commentUsecase: commentUsecase,
+		log:            log,
+	}
+}
+
+func (h *CommentHandler) Create(ctx *fiber.Ctx) error {
+	articleID, err := h.parseID(ctx, "article_id", "Invalid article ID format")
+	if err != nil {
+		return err
+	}
+
+	// Parse request body
+	var req dto.CreateCommentRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to parse comment body", zap.Error(err))
+		return response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), "Invalid request body").WithMessageID("body")
+	}
+
+	// Validate request
+	if err := req.Validate(); err != nil {
+		logger.FromContext(ctx.Context()).Warn("comment validation failed", zap.Error(err))
+		return err
+	}
+
+	comment, err := h.commentUsecase.Create(ctx.Context(), articleID, &domain.Comment{
+		ParentID:    req.ParentID,
+		AuthorName:  req.AuthorName,
+		AuthorEmail: req.AuthorEmail,
+		Content:     req.Content,
+	})
+	if err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to create comment", zap.Error(err))
+		return err
+	}
+
+	resp := response.NewSuccessResponseWithPath(
+		dto.ToCommentResponse(comment),
+		i18n.Message(ctx.Context(), "comment.submitted"),
+		ctx.Path(),
+	)
+	return ctx.Status(fiber.StatusCreated).JSON(resp)
+}
+
+func (h *CommentHandler) GetThreads(ctx *fiber.Ctx) error {
+	articleID, err := h.parseID(ctx, "article_id", "Invalid article ID format")
+	if err != nil {
+		return err
+	}
+
+	commentFilter, err := h.parseFilter(ctx)
+	if err != nil {
+		return err
+	}
+
+	comments, total, err := h.commentUsecase.GetThreads(ctx.Context(), articleID, commentFilter)
+	if err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to get comments", zap.Error(err))
+		return err
+	}
+
+	paginationMeta := response.CalculatePaginationMeta(
+		commentFilter.GetDefaultPage(),
+		commentFilter.GetDefaultLimit(),
+		total,
+	)
+
+	responseHandler := response.NewPaginatedResponseWithPath(
+		dto.ToCommentResponseList(comments),
+		i18n.Message(ctx.Context(), "comment.list"),
+		ctx.Path(),
+		paginationMeta,
+	)
+	return ctx.Status(fiber.StatusOK).JSON(responseHandler)
+}
+
+func (h *CommentHandler) GetModerationQueue(ctx *fiber.Ctx) error {
+	commentFilter, err := h.parseFilter(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Default antrian moderasi adalah komentar pending, yang terlama duluan
+	if commentFilter.Filters.Status == "" {
+		commentFilter.Filters.Status = string(domain.CommentPending)
+	}
+	if ctx.Query("sort_order") == "" {
+		commentFilter.SortOrder = "asc"
+	}
+
+	if err := commentFilter.Validate(); err != nil {
+		return err
+	}
+
+	comments, total, err := h.commentUsecase.GetModerationQueue(ctx.Context(), commentFilter)
+	if err != nil {
+		return apperror.Wrap(err, string(dto.ErrCodeDBError), "failed to get comments")
+	}
+
+	paginationMeta := response.CalculatePaginationMeta(
+		commentFilter.GetDefaultPage(),
+		commentFilter.GetDefaultLimit(),
+		total,
+	)
+
+	responseHandler := response.NewPaginatedResponseWithPath(
+		dto.ToModerationCommentResponseList(comments),
+		i18n.Message(ctx.Context(), "comment.list"),
+		ctx.Path(),
+		paginationMeta,
+	)
+	return ctx.Status(fiber.StatusOK).JSON(responseHandler)
+}
+
+func (h *CommentHandler) Approve(ctx *fiber.Ctx) error {
+	return h.moderate(ctx, h.commentUsecase.Approve, i18n.Message(ctx.Context(), "comment.approved"))
+}
+
+func (h *CommentHandler) Reject(ctx *fiber.Ctx) error {
+	return h.moderate(ctx, h.commentUsecase.Reject, i18n.Message(ctx.Context(), "comment.rejected"))
+}
+
+func (h *CommentHandler) moderate(
+	ctx *fiber.Ctx,
+	action func(ctx context.Context, id uint) (*domain.Comment, error),
+	message string,
+) error {
+	commentID, err := h.parseID(ctx, "comment_id", "Invalid comment ID format")
+	if err != nil {
+		return err
+	}
+
+	comment, err := action(ctx.Context(), commentID)
+	if err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to moderate comment", zap.Error(err), zap.Uint("id", commentID))
+		return err
+	}
+
+	resp := response.NewSuccessResponseWithPath(
+		dto.ToModerationCommentResponse(comment),
+		message,
+		ctx.Path(),
+	)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+// parseID balikin problem 400 jika param bukan angka
+func (h *CommentHandler) parseID(ctx *fiber.Ctx, param, message string) (uint, error) {
+	idStr := ctx.Params(param)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		logger.FromContext(ctx.Context()).Warn("invalid id format", zap.Error(err), zap.String(param, idStr))
+		return 0, response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), message).WithMessageID(param)
+	}
+
+	return uint(id), nil
+}
+
+func (h *CommentHandler) parseFilter(ctx *fiber.Ctx) (*dto.CommentFilter, error) {
+	commentFilter := dto.NewCommentFilter()
+	if err := ctx.QueryParser(commentFilter); err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to parse query param", zap.Error(err))
+		return nil, response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), "Invalid query parameters").WithMessageID("query")
+	}
+
+	if status := ctx.Query("status"); status != "" {
+		commentFilter.Filters.Status = status
+	}
+
+	if articleID, err := strconv.ParseUint(ctx.Query("article_id"), 10, 32); err == nil {
+		commentFilter.Filters.ArticleID = uint(articleID)
+	}
+
+	commentFilter.ValidatePagination()
+	return commentFilter, nil
+}
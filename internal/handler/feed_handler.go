@@ -0,0 +1,245 @@
+package handler
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/article"
+	usecase "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/usecase/article"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/apperror"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/feed"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+const defaultFeedLimit = 20
+
+type feedFormat string
+
+const (
+	feedFormatRSS  feedFormat = "rss"
+	feedFormatAtom feedFormat = "atom"
+	feedFormatJSON feedFormat = "json"
+)
+
+type FeedHandler struct {
+	articleUsecase usecase.ArticleUsecase
+	config         *viper.Viper
+	log            *zap.Logger
+}
+
+func NewFeedHandler(
+	articleUsecase usecase.ArticleUsecase,
+	config *viper.Viper,
+	log *zap.Logger,
+) *FeedHandler {
+	return &FeedHandler{
+		articleUsecase: articleUsecase,
+		config:         config,
+		log:            log,
+	}
+}
+
+func (h *FeedHandler) RSS(ctx *fiber.Ctx) error {
+	return h.render(ctx, "", feedFormatRSS)
+}
+
+func (h *FeedHandler) Atom(ctx *fiber.Ctx) error {
+	return h.render(ctx, "", feedFormatAtom)
+}
+
+func (h *FeedHandler) JSON(ctx *fiber.Ctx) error {
+	return h.render(ctx, "", feedFormatJSON)
+}
+
+func (h *FeedHandler) CategoryRSS(ctx *fiber.Ctx) error {
+	return h.render(ctx, categoryParam(ctx), feedFormatRSS)
+}
+
+func (h *FeedHandler) CategoryAtom(ctx *fiber.Ctx) error {
+	return h.render(ctx, categoryParam(ctx), feedFormatAtom)
+}
+
+func (h *FeedHandler) CategoryJSON(ctx *fiber.Ctx) error {
+	return h.render(ctx, categoryParam(ctx), feedFormatJSON)
+}
+
+func (h *FeedHandler) render(ctx *fiber.Ctx, category string, format feedFormat) error {
+	limit := h.config.GetInt("FEED_LIMIT")
+	if limit <= 0 {
+		limit = defaultFeedLimit
+	}
+
+	// Hanya article dengan status publish yang masuk feed
+	articleFilter := dto.NewArticleFilter()
+	articleFilter.Limit = limit
+	articleFilter.Filters.Status = string(domain.StatusPublish)
+	articleFilter.Filters.Category = category
+
+	articles, _, err := h.articleUsecase.GetList(ctx.Context(), articleFilter)
+	if err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to get articles for feed", zap.Error(err), zap.String("category", category))
+		return apperror.Wrap(err, string(dto.ErrCodeInternalError), "Failed to build feed")
+	}
+
+	// Conditional GET berdasarkan ETag dan Last-Modified
+	lastModified := latestUpdate(articles)
+	etag := feedETag(format, category, articles)
+
+	ctx.Set(fiber.HeaderETag, etag)
+	ctx.Set(fiber.HeaderCacheControl, "public, max-age=300")
+	if !lastModified.IsZero() {
+		ctx.Set(fiber.HeaderLastModified, lastModified.UTC().Format(http.TimeFormat))
+	}
+
+	if isNotModified(ctx, etag, lastModified) {
+		return ctx.SendStatus(fiber.StatusNotModified)
+	}
+
+	articleFeed := h.buildFeed(ctx, category, articles, lastModified)
+
+	var body []byte
+	var contentType string
+	switch format {
+	case feedFormatAtom:
+		body, err = articleFeed.Atom()
+		contentType = feed.ContentTypeAtom
+	case feedFormatJSON:
+		body, err = articleFeed.JSON()
+		contentType = feed.ContentTypeJSON
+	default:
+		body, err = articleFeed.RSS()
+		contentType = feed.ContentTypeRSS
+	}
+
+	if err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to render feed", zap.Error(err), zap.String("format", string(format)))
+		return apperror.Wrap(err, string(dto.ErrCodeInternalError), "Failed to build feed")
+	}
+
+	ctx.Set(fiber.HeaderContentType, contentType)
+	return ctx.Status(fiber.StatusOK).Send(body)
+}
+
+func (h *FeedHandler) buildFeed(
+	ctx *fiber.Ctx,
+	category string,
+	articles []domain.Article,
+	updated time.Time,
+) *feed.Feed {
+	baseURL := publicBaseURL(ctx, h.config)
+
+	title := h.config.GetString("APP_NAME")
+	if category != "" {
+		title = fmt.Sprintf("%s - %s", title, category)
+	}
+
+	if updated.IsZero() {
+		updated = time.Now()
+	}
+
+	articleFeed := &feed.Feed{
+		Title:       title,
+		Description: "Latest published articles",
+		Link:        baseURL,
+		FeedURL:     baseURL + ctx.Path(),
+		Language:    h.config.GetString("FEED_LANGUAGE"),
+		Updated:     updated,
+	}
+
+	for _, article := range articles {
+		articleFeed.Items = append(articleFeed.Items, feed.Item{
+			ID:        articleGUID(baseURL, &article),
+			Title:     article.Title,
+			Link:      articlePublicURL(baseURL, &article),
+			Content:   article.Content,
+			HTML:      article.ContentHTML,
+			Category:  article.Category,
+			Published: article.CreatedDate,
+			Updated:   article.UpdatedDate,
+		})
+	}
+
+	return articleFeed
+}
+
+// === Helper Feed ===
+func categoryParam(ctx *fiber.Ctx) string {
+	name := ctx.Params("name")
+	if decoded, err := url.PathUnescape(name); err == nil {
+		return decoded
+	}
+	return name
+}
+
+// publicBaseURL ambil base url publik dari config, fallback ke host request
+func publicBaseURL(ctx *fiber.Ctx, config *viper.Viper) string {
+	baseURL := strings.TrimRight(config.GetString("PUBLIC_BASE_URL"), "/")
+	if baseURL == "" {
+		baseURL = ctx.BaseURL()
+	}
+	return baseURL
+}
+
+func articlePublicURL(baseURL string, article *domain.Article) string {
+	if article.Slug != "" {
+		return fmt.Sprintf("%s/article/%s", baseURL, url.PathEscape(article.Slug))
+	}
+	return fmt.Sprintf("%s/article/%d", baseURL, article.ID)
+}
+
+// articleGUID tag URI (RFC 4151) yang tetap sama walaupun title / slug berubah
+func articleGUID(baseURL string, article *domain.Article) string {
+	host := baseURL
+	if parsed, err := url.Parse(baseURL); err == nil && parsed.Hostname() != "" {
+		host = parsed.Hostname()
+	}
+	return fmt.Sprintf("tag:%s,%s:article/%d", host, article.CreatedDate.UTC().Format("2006-01-02"), article.ID)
+}
+
+func latestUpdate(articles []domain.Article) time.Time {
+	var latest time.Time
+	for _, article := range articles {
+		if article.UpdatedDate.After(latest) {
+			latest = article.UpdatedDate
+		}
+	}
+	return latest
+}
+
+func feedETag(format feedFormat, category string, articles []domain.Article) string {
+	hash := sha1.New()
+	fmt.Fprintf(hash, "%s|%s", format, category)
+	for _, article := range articles {
+		fmt.Fprintf(hash, "|%d:%d", article.ID, article.UpdatedDate.UnixNano())
+	}
+	return `W/"` + hex.EncodeToString(hash.Sum(nil)) + `"`
+}
+
+func isNotModified(ctx *fiber.Ctx, etag string, lastModified time.Time) bool {
+	if match := ctx.Get(fiber.HeaderIfNoneMatch); match != "" {
+		for _, candidate := range strings.Split(match, ",") {
+			candidate = strings.TrimSpace(candidate)
+			if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+				return true
+			}
+		}
+		return false
+	}
+
+	if since := ctx.Get(fiber.HeaderIfModifiedSince); since != "" && !lastModified.IsZero() {
+		if sinceTime, err := http.ParseTime(since); err == nil {
+			return !lastModified.Truncate(time.Second).After(sinceTime)
+		}
+	}
+
+	return false
+}
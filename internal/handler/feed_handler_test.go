@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestArticlePublicURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		article domain.Article
+		want    string
+	}{
+		{name: "slug", article: domain.Article{ID: 7, Slug: "hello-world"}, want: "https://blog.example/article/hello-world"},
+		{name: "slug is escaped", article: domain.Article{ID: 7, Slug: "a b/c"}, want: "https://blog.example/article/a%20b%2Fc"},
+		{name: "without slug", article: domain.Article{ID: 7}, want: "https://blog.example/article/7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := articlePublicURL("https://blog.example", &tt.article); got != tt.want {
+				t.Errorf("articlePublicURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestArticleGUID(t *testing.T) {
+	created := time.Date(2026, 3, 1, 1, 0, 0, 0, time.FixedZone("WIB", 7*3600))
+	article := domain.Article{ID: 7, Slug: "old", CreatedDate: created}
+
+	got := articleGUID("https://blog.example:8443", &article)
+	if want := "tag:blog.example,2026-02-28:article/7"; got != want {
+		t.Fatalf("articleGUID() = %q, want %q", got, want)
+	}
+
+	// GUID tidak boleh berubah saat slug / title diganti
+	article.Slug = "new"
+	article.Title = "Renamed"
+	if again := articleGUID("https://blog.example:8443", &article); again != got {
+		t.Fatalf("articleGUID() = %q after rename, want %q", again, got)
+	}
+}
+
+func TestFeedETag(t *testing.T) {
+	updated := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	articles := []domain.Article{{ID: 1, UpdatedDate: updated}, {ID: 2, UpdatedDate: updated}}
+	base := feedETag(feedFormatRSS, "", articles)
+
+	tests := []struct {
+		name     string
+		format   feedFormat
+		category string
+		articles []domain.Article
+		wantSame bool
+	}{
+		{name: "same input", format: feedFormatRSS, articles: articles, wantSame: true},
+		{name: "other format", format: feedFormatAtom, articles: articles},
+		{name: "other category", format: feedFormatRSS, category: "tech", articles: articles},
+		{name: "article updated", format: feedFormatRSS, articles: []domain.Article{{ID: 1, UpdatedDate: updated.Add(time.Second)}, {ID: 2, UpdatedDate: updated}}},
+		{name: "article removed", format: feedFormatRSS, articles: articles[:1]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := feedETag(tt.format, tt.category, tt.articles)
+			if (got == base) != tt.wantSame {
+				t.Errorf("feedETag() = %s, base %s, want same %v", got, base, tt.wantSame)
+			}
+		})
+	}
+}
+
+func TestIsNotModified(t *testing.T) {
+	etag := `W/"abc"`
+	lastModified := time.Date(2026, 1, 1, 10, 0, 0, 500, time.UTC)
+
+	tests := []struct {
+		name         string
+		headers      map[string]string
+		lastModified time.Time
+		want         bool
+	}{
+		{name: "no conditional headers", lastModified: lastModified},
+		{name: "matching etag", headers: map[string]string{fiber.HeaderIfNoneMatch: `W/"abc"`}, want: true},
+		{name: "strong form of weak etag", headers: map[string]string{fiber.HeaderIfNoneMatch: `"abc"`}, want: true},
+		{name: "etag in list", headers: map[string]string{fiber.HeaderIfNoneMatch: `"x", W/"abc"`}, want: true},
+		{name: "wildcard", headers: map[string]string{fiber.HeaderIfNoneMatch: "*"}, want: true},
+		{name: "other etag", headers: map[string]string{fiber.HeaderIfNoneMatch: `W/"other"`}},
+		{
+			name:         "etag wins over if-modified-since",
+			headers:      map[string]string{fiber.HeaderIfNoneMatch: `W/"other"`, fiber.HeaderIfModifiedSince: "Thu, 01 Jan 2026 10:00:00 GMT"},
+			lastModified: lastModified,
+		},
+		{name: "not modified since", headers: map[string]string{fiber.HeaderIfModifiedSince: "Thu, 01 Jan 2026 10:00:00 GMT"}, lastModified: lastModified, want: true},
+		{name: "modified since", headers: map[string]string{fiber.HeaderIfModifiedSince: "Thu, 01 Jan 2026 09:59:59 GMT"}, lastModified: lastModified},
+		{name: "invalid date", headers: map[string]string{fiber.HeaderIfModifiedSince: "yesterday"}, lastModified: lastModified},
+		{name: "empty feed", headers: map[string]string{fiber.HeaderIfModifiedSince: "Thu, 01 Jan 2026 10:00:00 GMT"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got bool
+			app := fiber.New()
+			app.Get("/", func(ctx *fiber.Ctx) error {
+				got = isNotModified(ctx, etag, tt.lastModified)
+				return nil
+			})
+
+			req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+			for key, value := range tt.headers {
+				req.Header.Set(key, value)
+			}
+			if _, err := app.Test(req); err != nil {
+				t.Fatalf("app.Test() error = %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("isNotModified() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/response"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/health"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/i18n"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+type HealthHandler struct {
+	checker *health.Checker
+	log     *zap.Logger
+}
+
+func NewHealthHandler(checker *health.Checker, log *zap.Logger) *HealthHandler {
+	return &HealthHandler{
+		checker: checker,
+		log:     log,
+	}
+}
+
+// Liveness hanya memastikan proses masih melayani request, dependency tidak di cek
+// supaya database down tidak membuat pod di restart
+func (h *HealthHandler) Liveness(ctx *fiber.Ctx) error {
+	resp := response.NewSuccessResponseWithPath(
+		fiber.Map{"status": health.StatusOK},
+		i18n.Message(ctx.Context(), "health.alive"),
+		ctx.Path(),
+	)
+
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+// Readiness cek semua dependency, 503 kalau salah satu gagal atau server sedang shutdown
+func (h *HealthHandler) Readiness(ctx *fiber.Ctx) error {
+	report := h.checker.Ready(ctx.Context())
+
+	if report.Status != health.StatusOK {
+		logger.FromContext(ctx.Context()).Warn("readiness check failed", zap.String("status", report.Status), zap.Any("checks", report.Checks))
+
+		return response.NewProblem(fiber.StatusServiceUnavailable, "NOT_READY", "Service is not ready").
+			WithDetails(map[string]any{
+				"status": report.Status,
+				"checks": report.Checks,
+			})
+	}
+
+	resp := response.NewSuccessResponseWithPath(report, i18n.Message(ctx.Context(), "health.ready"), ctx.Path())
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
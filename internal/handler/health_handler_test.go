@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/health"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/middlewares"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+func TestHealthHandler(t *testing.T) {
+	down := func(ctx context.Context) error { return errors.New("connection refused") }
+	up := func(ctx context.Context) error { return nil }
+
+	tests := []struct {
+		name         string
+		path         string
+		mysql        health.CheckFunc
+		shuttingDown bool
+		wantStatus   int
+		wantReport   string
+	}{
+		{name: "liveness ignores dependency", path: "/healthz", mysql: down, wantStatus: fiber.StatusOK},
+		{name: "liveness during shutdown", path: "/healthz", mysql: up, shuttingDown: true, wantStatus: fiber.StatusOK},
+		{name: "ready", path: "/readyz", mysql: up, wantStatus: fiber.StatusOK, wantReport: health.StatusOK},
+		{name: "dependency down", path: "/readyz", mysql: down, wantStatus: fiber.StatusServiceUnavailable, wantReport: health.StatusFailed},
+		{name: "shutting down", path: "/readyz", mysql: up, shuttingDown: true, wantStatus: fiber.StatusServiceUnavailable, wantReport: health.StatusShuttingDown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := health.NewChecker()
+			checker.Register("mysql", 0, tt.mysql)
+			if tt.shuttingDown {
+				checker.MarkShuttingDown()
+			}
+
+			healthHandler := NewHealthHandler(checker, zap.NewNop())
+			app := fiber.New(fiber.Config{ErrorHandler: middlewares.HandleError})
+			app.Get("/healthz", healthHandler.Liveness)
+			app.Get("/readyz", healthHandler.Readiness)
+
+			resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, tt.path, nil))
+			if err != nil {
+				t.Fatalf("app.Test() error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if tt.wantReport == "" {
+				return
+			}
+
+			// Report ada di data saat ready dan di details saat gagal
+			var body struct {
+				Data    health.Report `json:"data"`
+				Details health.Report `json:"details"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode body: %v", err)
+			}
+			report := body.Data
+			if resp.StatusCode != fiber.StatusOK {
+				report = body.Details
+			}
+			if report.Status != tt.wantReport {
+				t.Errorf("report status = %q, want %q", report.Status, tt.wantReport)
+			}
+			if _, ok := report.Checks["mysql"]; !ok {
+				t.Errorf("report checks = %+v, want mysql", report.Checks)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/response"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/i18n"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// logLevelRequest body PUT, contoh {"level":"debug"}
+type logLevelRequest struct {
+	Level string `json:"level"`
+}
+
+type LogLevelHandler struct {
+	level zap.AtomicLevel
+	log   *zap.Logger
+}
+
+func NewLogLevelHandler(level zap.AtomicLevel, log *zap.Logger) *LogLevelHandler {
+	return &LogLevelHandler{
+		level: level,
+		log:   log,
+	}
+}
+
+func (h *LogLevelHandler) Get(ctx *fiber.Ctx) error {
+	resp := response.NewSuccessResponseWithPath(
+		fiber.Map{"level": h.level.String()},
+		i18n.Message(ctx.Context(), "log_level.detail"),
+		ctx.Path(),
+	)
+
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+// Update ubah level semua logger tanpa restart, hanya berlaku untuk instance yang menerima request
+func (h *LogLevelHandler) Update(ctx *fiber.Ctx) error {
+	var req logLevelRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		return response.NewProblem(fiber.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body").WithMessageID("body")
+	}
+
+	level, ok := logger.LookupLevel(req.Level)
+	if !ok {
+		return response.NewProblem(fiber.StatusBadRequest, "VALIDATION_ERROR", "Level must be one of debug, info, warn, error").WithMessageID("log_level")
+	}
+
+	previous := h.level.Level()
+	h.level.SetLevel(level)
+
+	// Di log sebagai Warn supaya tetap tercatat walaupun level baru lebih tinggi dari info
+	h.log.Warn("log level changed",
+		zap.String("from", previous.String()),
+		zap.String("to", level.String()),
+		zap.String("remote_ip", ctx.IP()),
+	)
+
+	resp := response.NewSuccessResponseWithPath(
+		fiber.Map{"level": level.String()},
+		i18n.Message(ctx.Context(), "log_level.updated"),
+		ctx.Path(),
+	)
+
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
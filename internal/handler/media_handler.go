@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"strconv"
+
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/media"
+	usecase "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/usecase/media"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/apperror"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/response"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/i18n"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+type MediaHandler struct {
+	mediaUsecase usecase.MediaUsecase
+	log          *zap.Logger
+}
+
+func NewMediaHandler(
+	mediaUsecase usecase.MediaUsecase,
+	log *zap.Logger,
+) *MediaHandler {
+	return &MediaHandler{
+		mediaUsecase: mediaUsecase,
+		log:          log,
+	}
+}
+
+func (h *MediaHandler) Upload(ctx *fiber.Ctx) error {
+	// Get uploaded file
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		logger.FromContext(ctx.Context()).Warn("media file is missing", zap.Error(err))
+		return response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeFileRequired), dto.ErrFileRequired.Error())
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to open uploaded media", zap.Error(err))
+		return apperror.Wrap(err, string(dto.ErrCodeInternalError), "Failed to read uploaded file")
+	}
+	defer file.Close()
+
+	// Upload media
+	media, err := h.mediaUsecase.Upload(ctx.Context(), &dto.UploadMediaRequest{
+		FileName: fileHeader.Filename,
+		Size:     fileHeader.Size,
+		Reader:   file,
+	})
+	if err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to upload media", zap.Error(err))
+		return apperror.Wrap(err, string(dto.ErrCodeUploadFailed), "Failed to upload media")
+	}
+
+	resp := response.NewSuccessResponseWithPath(
+		dto.ToMediaResponse(media),
+		i18n.Message(ctx.Context(), "media.uploaded"),
+		ctx.Path(),
+	)
+	return ctx.Status(fiber.StatusCreated).JSON(resp)
+}
+
+func (h *MediaHandler) GetDetailByID(ctx *fiber.Ctx) error {
+	// Parse string media_id into uint id db
+	mediaIDStr := ctx.Params("media_id")
+	mediaID, err := strconv.ParseUint(mediaIDStr, 10, 32)
+	if err != nil {
+		logger.FromContext(ctx.Context()).Warn("invalid media id format", zap.Error(err), zap.String("id", mediaIDStr))
+		return response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), "Invalid media ID format").WithMessageID("media_id")
+	}
+
+	media, err := h.mediaUsecase.GetDetailByID(ctx.Context(), uint(mediaID))
+	if err != nil {
+		return apperror.Wrap(err, string(dto.ErrCodeInternalError), "failed to get media")
+	}
+
+	resp := response.NewSuccessResponseWithPath(
+		dto.ToMediaResponse(media),
+		i18n.Message(ctx.Context(), "media.detail"),
+		ctx.Path(),
+	)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+func (h *MediaHandler) DeleteByID(ctx *fiber.Ctx) error {
+	// Parse string media_id into uint id db
+	mediaIDStr := ctx.Params("media_id")
+	mediaID, err := strconv.ParseUint(mediaIDStr, 10, 32)
+	if err != nil {
+		logger.FromContext(ctx.Context()).Warn("invalid media id format", zap.Error(err), zap.String("id", mediaIDStr))
+		return response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), "Invalid media ID format").WithMessageID("media_id")
+	}
+
+	if err := h.mediaUsecase.DeleteByID(ctx.Context(), uint(mediaID)); err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to delete media", zap.Error(err), zap.Uint("id", uint(mediaID)))
+		return apperror.Wrap(err, string(dto.ErrCodeDeleteFailed), "Failed to delete media")
+	}
+
+	resp := response.NewSuccessResponseWithPath(
+		"",
+		i18n.Message(ctx.Context(), "media.deleted"),
+		ctx.Path(),
+	)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
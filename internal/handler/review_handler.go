@@ -0,0 +1,168 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/review"
+	usecase "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/usecase/review"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/audit"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/response"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/i18n"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+type ReviewHandler struct {
+	reviewUsecase usecase.ReviewUsecase
+	log           *zap.Logger
+}
+
+func NewReviewHandler(
+	reviewUsecase usecase.ReviewUsecase,
+	log *zap.Logger,
+) *ReviewHandler {
+	return &ReviewHandler{
+		reviewUsecase: reviewUsecase,
+		log:           log,
+	}
+}
+
+func (h *ReviewHandler) Submit(ctx *fiber.Ctx) error {
+	articleID, err := h.parseID(ctx, "article_id", "Invalid article ID format")
+	if err != nil {
+		return err
+	}
+
+	// Parse request body
+	var req dto.SubmitReviewRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to parse review body", zap.Error(err))
+		return response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), "Invalid request body").WithMessageID("body")
+	}
+
+	// Author dari actor yang terautentikasi, bukan dari body
+	if req.Author, err = h.actor(ctx); err != nil {
+		return err
+	}
+
+	// Validate request
+	if err := req.Validate(); err != nil {
+		logger.FromContext(ctx.Context()).Warn("review validation failed", zap.Error(err))
+		return err
+	}
+
+	reviews, err := h.reviewUsecase.Submit(ctx.Context(), articleID, &req)
+	if err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to submit review", zap.Error(err), zap.Uint("article_id", articleID))
+		return err
+	}
+
+	resp := response.NewSuccessResponseWithPath(
+		dto.ToReviewResponseList(reviews),
+		i18n.Message(ctx.Context(), "review.submitted"),
+		ctx.Path(),
+	)
+	return ctx.Status(fiber.StatusCreated).JSON(resp)
+}
+
+func (h *ReviewHandler) GetByArticle(ctx *fiber.Ctx) error {
+	articleID, err := h.parseID(ctx, "article_id", "Invalid article ID format")
+	if err != nil {
+		return err
+	}
+
+	reviews, err := h.reviewUsecase.GetByArticle(ctx.Context(), articleID)
+	if err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to get reviews", zap.Error(err), zap.Uint("article_id", articleID))
+		return err
+	}
+
+	resp := response.NewSuccessResponseWithPath(
+		dto.ToReviewResponseList(reviews),
+		i18n.Message(ctx.Context(), "review.list"),
+		ctx.Path(),
+	)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+func (h *ReviewHandler) Approve(ctx *fiber.Ctx) error {
+	return h.decide(ctx, h.reviewUsecase.Approve, false, i18n.Message(ctx.Context(), "review.approved"))
+}
+
+func (h *ReviewHandler) RequestChanges(ctx *fiber.Ctx) error {
+	return h.decide(ctx, h.reviewUsecase.RequestChanges, true, i18n.Message(ctx.Context(), "review.changes_requested"))
+}
+
+func (h *ReviewHandler) decide(
+	ctx *fiber.Ctx,
+	action func(ctx context.Context, articleID, reviewID uint, request *dto.DecisionRequest) (*domain.Review, error),
+	requireComment bool,
+	message string,
+) error {
+	articleID, err := h.parseID(ctx, "article_id", "Invalid article ID format")
+	if err != nil {
+		return err
+	}
+
+	reviewID, err := h.parseID(ctx, "review_id", "Invalid review ID format")
+	if err != nil {
+		return err
+	}
+
+	// Parse request body
+	var req dto.DecisionRequest
+	if err := ctx.BodyParser(&req); err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to parse decision body", zap.Error(err))
+		return response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), "Invalid request body").WithMessageID("body")
+	}
+
+	// Reviewer dari actor yang terautentikasi, dicocokkan dengan assignment di usecase
+	if req.Reviewer, err = h.actor(ctx); err != nil {
+		return err
+	}
+
+	// Validate request
+	if err := req.Validate(requireComment); err != nil {
+		logger.FromContext(ctx.Context()).Warn("decision validation failed", zap.Error(err))
+		return err
+	}
+
+	review, err := action(ctx.Context(), articleID, reviewID, &req)
+	if err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to decide review", zap.Error(err), zap.Uint("review_id", reviewID))
+		return err
+	}
+
+	resp := response.NewSuccessResponseWithPath(
+		dto.ToReviewResponse(review),
+		message,
+		ctx.Path(),
+	)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+// actor identitas dari JWT editor, actor dari header saja (belum terautentikasi) ditolak
+func (h *ReviewHandler) actor(ctx *fiber.Ctx) (string, error) {
+	metadata := audit.FromContext(ctx.Context())
+	if !metadata.Authenticated {
+		logger.FromContext(ctx.Context()).Warn("review request without authenticated actor", zap.String("path", ctx.Path()))
+		return "", dto.ErrActorRequired
+	}
+
+	return metadata.Actor, nil
+}
+
+// parseID balikin problem 400 jika param bukan angka
+func (h *ReviewHandler) parseID(ctx *fiber.Ctx, param, message string) (uint, error) {
+	idStr := ctx.Params(param)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		logger.FromContext(ctx.Context()).Warn("invalid id format", zap.Error(err), zap.String(param, idStr))
+		return 0, response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), message).WithMessageID(param)
+	}
+
+	return uint(id), nil
+}
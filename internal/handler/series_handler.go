@@ -0,0 +1,235 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/series"
+	usecase "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/usecase/series"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/apperror"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/response"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/i18n"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+type SeriesHandler struct {
+	seriesUsecase usecase.SeriesUsecase
+	log           *zap.Logger
+}
+
+func NewSeriesHandler(
+	seriesUsecase usecase.SeriesUsecase,
+	log *zap.Logger,
+) *SeriesHandler {
+	return &SeriesHandler{
+		seriesUsecase: seriesUsecase,
+		log:           log,
+	}
+}
+
+func (h *SeriesHandler) Create(ctx *fiber.Ctx) error {
+	var req dto.CreateSeriesRequest
+	if err := h.parseBody(ctx, &req); err != nil {
+		return err
+	}
+
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	series, err := h.seriesUsecase.Create(ctx.Context(), &domain.Series{
+		Title:       req.Title,
+		Description: req.Description,
+	})
+	if err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to create series", zap.Error(err))
+		return err
+	}
+
+	resp := response.NewSuccessResponseWithPath(
+		dto.ToSeriesResponse(series),
+		i18n.Message(ctx.Context(), "series.created"),
+		ctx.Path(),
+	)
+	return ctx.Status(fiber.StatusCreated).JSON(resp)
+}
+
+func (h *SeriesHandler) GetList(ctx *fiber.Ctx) error {
+	seriesFilter := dto.NewSeriesFilter()
+	if err := ctx.QueryParser(seriesFilter); err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to parse query param", zap.Error(err))
+		return response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), "Invalid query parameters").WithMessageID("query")
+	}
+
+	series, total, err := h.seriesUsecase.GetList(ctx.Context(), seriesFilter)
+	if err != nil {
+		return apperror.Wrap(err, string(dto.ErrCodeDBError), "failed to get series")
+	}
+
+	paginationMeta := response.CalculatePaginationMeta(
+		seriesFilter.GetDefaultPage(),
+		seriesFilter.GetDefaultLimit(),
+		total,
+	)
+
+	responseHandler := response.NewPaginatedResponseWithPath(
+		dto.ToSeriesResponseList(series),
+		i18n.Message(ctx.Context(), "series.detail"),
+		ctx.Path(),
+		paginationMeta,
+	)
+	return ctx.Status(fiber.StatusOK).JSON(responseHandler)
+}
+
+func (h *SeriesHandler) GetDetailByID(ctx *fiber.Ctx) error {
+	seriesID, err := h.parseID(ctx, "series_id")
+	if err != nil {
+		return err
+	}
+
+	series, err := h.seriesUsecase.GetDetailByID(ctx.Context(), seriesID)
+	if err != nil {
+		return err
+	}
+
+	return h.respondSeries(ctx, series, i18n.Message(ctx.Context(), "series.detail"))
+}
+
+func (h *SeriesHandler) UpdateByID(ctx *fiber.Ctx) error {
+	seriesID, err := h.parseID(ctx, "series_id")
+	if err != nil {
+		return err
+	}
+
+	var req dto.UpdateSeriesRequest
+	if err := h.parseBody(ctx, &req); err != nil {
+		return err
+	}
+
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	series, err := h.seriesUsecase.UpdateByID(ctx.Context(), seriesID, &req)
+	if err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to update series", zap.Error(err), zap.Uint("id", seriesID))
+		return err
+	}
+
+	return h.respondSeries(ctx, series, i18n.Message(ctx.Context(), "series.updated"))
+}
+
+func (h *SeriesHandler) DeleteByID(ctx *fiber.Ctx) error {
+	seriesID, err := h.parseID(ctx, "series_id")
+	if err != nil {
+		return err
+	}
+
+	if err := h.seriesUsecase.DeleteByID(ctx.Context(), seriesID); err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to delete series", zap.Error(err), zap.Uint("id", seriesID))
+		return err
+	}
+
+	resp := response.NewSuccessResponseWithPath(
+		"",
+		i18n.Message(ctx.Context(), "series.deleted"),
+		ctx.Path(),
+	)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+func (h *SeriesHandler) AddArticle(ctx *fiber.Ctx) error {
+	seriesID, err := h.parseID(ctx, "series_id")
+	if err != nil {
+		return err
+	}
+
+	var req dto.AddSeriesArticleRequest
+	if err := h.parseBody(ctx, &req); err != nil {
+		return err
+	}
+
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	series, err := h.seriesUsecase.AddArticle(ctx.Context(), seriesID, req.ArticleID, req.Position)
+	if err != nil {
+		return err
+	}
+
+	return h.respondSeries(ctx, series, i18n.Message(ctx.Context(), "series.article_added"))
+}
+
+func (h *SeriesHandler) RemoveArticle(ctx *fiber.Ctx) error {
+	seriesID, err := h.parseID(ctx, "series_id")
+	if err != nil {
+		return err
+	}
+
+	articleID, err := h.parseID(ctx, "article_id")
+	if err != nil {
+		return err
+	}
+
+	series, err := h.seriesUsecase.RemoveArticle(ctx.Context(), seriesID, articleID)
+	if err != nil {
+		return err
+	}
+
+	return h.respondSeries(ctx, series, i18n.Message(ctx.Context(), "series.article_removed"))
+}
+
+func (h *SeriesHandler) Reorder(ctx *fiber.Ctx) error {
+	seriesID, err := h.parseID(ctx, "series_id")
+	if err != nil {
+		return err
+	}
+
+	var req dto.ReorderSeriesRequest
+	if err := h.parseBody(ctx, &req); err != nil {
+		return err
+	}
+
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	series, err := h.seriesUsecase.Reorder(ctx.Context(), seriesID, req.ArticleIDs)
+	if err != nil {
+		return err
+	}
+
+	return h.respondSeries(ctx, series, i18n.Message(ctx.Context(), "series.reordered"))
+}
+
+func (h *SeriesHandler) parseID(ctx *fiber.Ctx, param string) (uint, error) {
+	idStr := ctx.Params(param)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		logger.FromContext(ctx.Context()).Warn("invalid id format", zap.Error(err), zap.String(param, idStr))
+		return 0, response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), "Invalid "+param+" format").WithMessageID(param)
+	}
+
+	return uint(id), nil
+}
+
+func (h *SeriesHandler) parseBody(ctx *fiber.Ctx, req any) error {
+	if err := ctx.BodyParser(req); err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to parse series request", zap.Error(err))
+		return response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), "Invalid request body").WithMessageID("body")
+	}
+
+	return nil
+}
+
+func (h *SeriesHandler) respondSeries(ctx *fiber.Ctx, series *domain.Series, message string) error {
+	resp := response.NewSuccessResponseWithPath(
+		dto.ToSeriesResponse(series),
+		message,
+		ctx.Path(),
+	)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
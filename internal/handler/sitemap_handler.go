@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/article"
+	usecase "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/usecase/article"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/apperror"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/response"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/sitemap"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+type SitemapHandler struct {
+	articleUsecase usecase.ArticleUsecase
+	config         *viper.Viper
+	log            *zap.Logger
+}
+
+func NewSitemapHandler(
+	articleUsecase usecase.ArticleUsecase,
+	config *viper.Viper,
+	log *zap.Logger,
+) *SitemapHandler {
+	return &SitemapHandler{
+		articleUsecase: articleUsecase,
+		config:         config,
+		log:            log,
+	}
+}
+
+// Sitemap return urlset kalau jumlah article masih muat satu file, selain itu sitemap index
+func (h *SitemapHandler) Sitemap(ctx *fiber.Ctx) error {
+	total, err := h.articleUsecase.CountPublished(ctx.Context())
+	if err != nil {
+		return h.errorResponse(ctx, err)
+	}
+
+	perFile := h.urlsPerFile()
+	baseURL := publicBaseURL(ctx, h.config)
+
+	if total <= int64(perFile) {
+		return h.writeURLSet(ctx, baseURL, 0, perFile)
+	}
+
+	pages := int((total + int64(perFile) - 1) / int64(perFile))
+	entries := make([]sitemap.IndexEntry, pages)
+	for i := range entries {
+		entries[i] = sitemap.IndexEntry{
+			Loc: fmt.Sprintf("%s/sitemaps/sitemap-%d.xml", baseURL, i+1),
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := sitemap.WriteIndex(&buf, entries); err != nil {
+		return h.errorResponse(ctx, err)
+	}
+
+	ctx.Set(fiber.HeaderContentType, sitemap.ContentType)
+	return ctx.Status(fiber.StatusOK).Send(buf.Bytes())
+}
+
+// Page return satu bagian sitemap yang direferensikan oleh sitemap index
+func (h *SitemapHandler) Page(ctx *fiber.Ctx) error {
+	page, err := strconv.Atoi(ctx.Params("page"))
+	if err != nil || page < 1 {
+		return response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), "Invalid sitemap page").WithMessageID("sitemap_page")
+	}
+
+	perFile := h.urlsPerFile()
+	return h.writeURLSet(ctx, publicBaseURL(ctx, h.config), (page-1)*perFile, perFile)
+}
+
+func (h *SitemapHandler) writeURLSet(ctx *fiber.Ctx, baseURL string, offset, limit int) error {
+	var buf bytes.Buffer
+	writer := sitemap.NewWriter(&buf)
+
+	err := h.articleUsecase.StreamPublished(ctx.Context(), offset, limit, func(articles []domain.Article) error {
+		for _, article := range articles {
+			if err := writer.Add(articlePublicURL(baseURL, &article), article.UpdatedDate); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return h.errorResponse(ctx, err)
+	}
+
+	if err := writer.Close(); err != nil {
+		return h.errorResponse(ctx, err)
+	}
+
+	// Page di luar range dianggap tidak ada
+	if writer.Count() == 0 && offset > 0 {
+		return response.NewProblem(fiber.StatusNotFound, string(dto.ErrCodeNotFound), "Sitemap page not found").WithMessageID("sitemap_page")
+	}
+
+	logger.FromContext(ctx.Context()).Debug("sitemap generated", zap.Int("urls", writer.Count()), zap.Int("offset", offset))
+
+	ctx.Set(fiber.HeaderContentType, sitemap.ContentType)
+	ctx.Set(fiber.HeaderCacheControl, "public, max-age=3600")
+	return ctx.Status(fiber.StatusOK).Send(buf.Bytes())
+}
+
+func (h *SitemapHandler) urlsPerFile() int {
+	perFile := h.config.GetInt("SITEMAP_MAX_URLS")
+	if perFile <= 0 || perFile > sitemap.MaxURLsPerSitemap {
+		perFile = sitemap.MaxURLsPerSitemap
+	}
+	return perFile
+}
+
+func (h *SitemapHandler) errorResponse(ctx *fiber.Ctx, err error) error {
+	logger.FromContext(ctx.Context()).Error("failed to generate sitemap", zap.Error(err))
+	return apperror.Wrap(err, string(dto.ErrCodeInternalError), "Failed to generate sitemap")
+}
@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	usecase "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/usecase/article"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/middlewares"
+	"github.com/gofiber/fiber/v2"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+type fakeSitemapUsecase struct {
+	usecase.ArticleUsecase
+	articles []domain.Article
+}
+
+func (f *fakeSitemapUsecase) CountPublished(context.Context) (int64, error) {
+	return int64(len(f.articles)), nil
+}
+
+func (f *fakeSitemapUsecase) StreamPublished(_ context.Context, offset, limit int, fn func([]domain.Article) error) error {
+	if offset >= len(f.articles) {
+		return nil
+	}
+	end := min(offset+limit, len(f.articles))
+	return fn(f.articles[offset:end])
+}
+
+func TestSitemapHandler(t *testing.T) {
+	updated := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	articles := []domain.Article{
+		{ID: 1, Slug: "first", UpdatedDate: updated},
+		{ID: 2, Slug: "second", UpdatedDate: updated},
+		{ID: 3, UpdatedDate: updated},
+	}
+
+	tests := []struct {
+		name       string
+		maxURLs    int
+		path       string
+		wantStatus int
+		want       []string
+		notWant    []string
+	}{
+		{
+			name:       "single urlset",
+			path:       "/sitemap.xml",
+			wantStatus: fiber.StatusOK,
+			want:       []string{"<urlset", "https://blog.example/article/first", "https://blog.example/article/3", "<lastmod>2026-01-01T00:00:00Z</lastmod>"},
+			notWant:    []string{"<sitemapindex"},
+		},
+		{
+			name:       "index when articles exceed one file",
+			maxURLs:    2,
+			path:       "/sitemap.xml",
+			wantStatus: fiber.StatusOK,
+			want:       []string{"<sitemapindex", "https://blog.example/sitemaps/sitemap-1.xml", "https://blog.example/sitemaps/sitemap-2.xml"},
+			notWant:    []string{"sitemap-3.xml", "<urlset"},
+		},
+		{
+			name:       "second page",
+			maxURLs:    2,
+			path:       "/sitemaps/sitemap-2.xml",
+			wantStatus: fiber.StatusOK,
+			want:       []string{"<urlset", "https://blog.example/article/3"},
+			notWant:    []string{"article/first", "article/second"},
+		},
+		{name: "page out of range", maxURLs: 2, path: "/sitemaps/sitemap-3.xml", wantStatus: fiber.StatusNotFound},
+		{name: "invalid page", path: "/sitemaps/sitemap-0.xml", wantStatus: fiber.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := viper.New()
+			config.Set("PUBLIC_BASE_URL", "https://blog.example/")
+			config.Set("SITEMAP_MAX_URLS", tt.maxURLs)
+
+			handler := NewSitemapHandler(&fakeSitemapUsecase{articles: articles}, config, zap.NewNop())
+			app := fiber.New(fiber.Config{ErrorHandler: middlewares.HandleError})
+			app.Get("/sitemap.xml", handler.Sitemap)
+			app.Get("/sitemaps/sitemap-:page.xml", handler.Page)
+
+			resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, tt.path, nil))
+			if err != nil {
+				t.Fatalf("app.Test() error = %v", err)
+			}
+			defer resp.Body.Close()
+
+			body, _ := io.ReadAll(resp.Body)
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", resp.StatusCode, tt.wantStatus, body)
+			}
+			for _, s := range tt.want {
+				if !strings.Contains(string(body), s) {
+					t.Errorf("body does not contain %q: %s", s, body)
+				}
+			}
+			for _, s := range tt.notWant {
+				if strings.Contains(string(body), s) {
+					t.Errorf("body contains %q: %s", s, body)
+				}
+			}
+		})
+	}
+}
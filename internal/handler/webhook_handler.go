@@ -0,0 +1,229 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/webhook"
+	usecase "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/usecase/webhook"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/apperror"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/response"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/i18n"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+type WebhookHandler struct {
+	webhookUsecase usecase.WebhookUsecase
+	log            *zap.Logger
+}
+
+func NewWebhookHandler(
+	webhookUsecase usecase.WebhookUsecase,
+	log *zap.Logger,
+) *WebhookHandler {
+	return &WebhookHandler{
+		webhookUsecase: webhookUsecase,
+		log:            log,
+	}
+}
+
+func (h *WebhookHandler) Create(ctx *fiber.Ctx) error {
+	var req dto.CreateWebhookRequest
+	if err := h.parseBody(ctx, &req); err != nil {
+		return err
+	}
+
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	subscription, err := h.webhookUsecase.Create(ctx.Context(), &req)
+	if err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to create webhook", zap.Error(err))
+		return err
+	}
+
+	// Secret hanya ditampilkan sekali saat dibuat
+	data := dto.ToWebhookResponse(subscription)
+	data.Secret = subscription.Secret
+
+	resp := response.NewSuccessResponseWithPath(
+		data,
+		i18n.Message(ctx.Context(), "webhook.created"),
+		ctx.Path(),
+	)
+	return ctx.Status(fiber.StatusCreated).JSON(resp)
+}
+
+func (h *WebhookHandler) GetList(ctx *fiber.Ctx) error {
+	webhookFilter := dto.NewWebhookFilter()
+	if err := ctx.QueryParser(webhookFilter); err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to parse query param", zap.Error(err))
+		return response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), "Invalid query parameters").WithMessageID("query")
+	}
+
+	subscriptions, total, err := h.webhookUsecase.GetList(ctx.Context(), webhookFilter)
+	if err != nil {
+		return apperror.Wrap(err, string(dto.ErrCodeDBError), "failed to get webhooks")
+	}
+
+	paginationMeta := response.CalculatePaginationMeta(
+		webhookFilter.GetDefaultPage(),
+		webhookFilter.GetDefaultLimit(),
+		total,
+	)
+
+	responseHandler := response.NewPaginatedResponseWithPath(
+		dto.ToWebhookResponseList(subscriptions),
+		i18n.Message(ctx.Context(), "webhook.list"),
+		ctx.Path(),
+		paginationMeta,
+	)
+	return ctx.Status(fiber.StatusOK).JSON(responseHandler)
+}
+
+func (h *WebhookHandler) GetDetailByID(ctx *fiber.Ctx) error {
+	webhookID, err := h.parseID(ctx, "webhook_id")
+	if err != nil {
+		return err
+	}
+
+	subscription, err := h.webhookUsecase.GetDetailByID(ctx.Context(), webhookID)
+	if err != nil {
+		return err
+	}
+
+	return h.respondWebhook(ctx, subscription, i18n.Message(ctx.Context(), "webhook.detail"))
+}
+
+func (h *WebhookHandler) UpdateByID(ctx *fiber.Ctx) error {
+	webhookID, err := h.parseID(ctx, "webhook_id")
+	if err != nil {
+		return err
+	}
+
+	var req dto.UpdateWebhookRequest
+	if err := h.parseBody(ctx, &req); err != nil {
+		return err
+	}
+
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	subscription, err := h.webhookUsecase.UpdateByID(ctx.Context(), webhookID, &req)
+	if err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to update webhook", zap.Error(err), zap.Uint("id", webhookID))
+		return err
+	}
+
+	return h.respondWebhook(ctx, subscription, i18n.Message(ctx.Context(), "webhook.updated"))
+}
+
+func (h *WebhookHandler) DeleteByID(ctx *fiber.Ctx) error {
+	webhookID, err := h.parseID(ctx, "webhook_id")
+	if err != nil {
+		return err
+	}
+
+	if err := h.webhookUsecase.DeleteByID(ctx.Context(), webhookID); err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to delete webhook", zap.Error(err), zap.Uint("id", webhookID))
+		return err
+	}
+
+	resp := response.NewSuccessResponseWithPath(
+		"",
+		i18n.Message(ctx.Context(), "webhook.deleted"),
+		ctx.Path(),
+	)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
+
+func (h *WebhookHandler) GetDeliveries(ctx *fiber.Ctx) error {
+	webhookID, err := h.parseID(ctx, "webhook_id")
+	if err != nil {
+		return err
+	}
+
+	deliveryFilter := dto.NewDeliveryFilter()
+	if err := ctx.QueryParser(deliveryFilter); err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to parse query param", zap.Error(err))
+		return response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), "Invalid query parameters").WithMessageID("query")
+	}
+	deliveryFilter.Filters.Status = ctx.Query("status")
+	deliveryFilter.Filters.EventType = ctx.Query("event_type")
+
+	deliveries, total, err := h.webhookUsecase.GetDeliveries(ctx.Context(), webhookID, deliveryFilter)
+	if err != nil {
+		return err
+	}
+
+	paginationMeta := response.CalculatePaginationMeta(
+		deliveryFilter.GetDefaultPage(),
+		deliveryFilter.GetDefaultLimit(),
+		total,
+	)
+
+	responseHandler := response.NewPaginatedResponseWithPath(
+		dto.ToDeliveryResponseList(deliveries),
+		i18n.Message(ctx.Context(), "webhook.deliveries"),
+		ctx.Path(),
+		paginationMeta,
+	)
+	return ctx.Status(fiber.StatusOK).JSON(responseHandler)
+}
+
+func (h *WebhookHandler) Redeliver(ctx *fiber.Ctx) error {
+	webhookID, err := h.parseID(ctx, "webhook_id")
+	if err != nil {
+		return err
+	}
+
+	deliveryID, err := h.parseID(ctx, "delivery_id")
+	if err != nil {
+		return err
+	}
+
+	delivery, err := h.webhookUsecase.Redeliver(ctx.Context(), webhookID, deliveryID)
+	if err != nil {
+		return err
+	}
+
+	resp := response.NewSuccessResponseWithPath(
+		dto.ToDeliveryResponse(delivery),
+		i18n.Message(ctx.Context(), "webhook.redelivered"),
+		ctx.Path(),
+	)
+	return ctx.Status(fiber.StatusAccepted).JSON(resp)
+}
+
+func (h *WebhookHandler) parseID(ctx *fiber.Ctx, param string) (uint, error) {
+	idStr := ctx.Params(param)
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		logger.FromContext(ctx.Context()).Warn("invalid id format", zap.Error(err), zap.String(param, idStr))
+		return 0, response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), "Invalid "+param+" format").WithMessageID(param)
+	}
+
+	return uint(id), nil
+}
+
+func (h *WebhookHandler) parseBody(ctx *fiber.Ctx, req any) error {
+	if err := ctx.BodyParser(req); err != nil {
+		logger.FromContext(ctx.Context()).Error("failed to parse webhook request", zap.Error(err))
+		return response.NewProblem(fiber.StatusBadRequest, string(dto.ErrCodeValidation), "Invalid request body").WithMessageID("body")
+	}
+
+	return nil
+}
+
+func (h *WebhookHandler) respondWebhook(ctx *fiber.Ctx, subscription *domain.WebhookSubscription, message string) error {
+	resp := response.NewSuccessResponseWithPath(
+		dto.ToWebhookResponse(subscription),
+		message,
+		ctx.Path(),
+	)
+	return ctx.Status(fiber.StatusOK).JSON(resp)
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
 	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/article"
@@ -11,7 +12,20 @@ type ArticleRepository interface {
 	Create(ctx context.Context, article *domain.Article) error
 	GetList(ctx context.Context, articleFilter *dto.ArticleFilter) ([]domain.Article, int64, error)
 	GetByTitle(ctx context.Context, title string) (*domain.Article, error)
+	GetBySlug(ctx context.Context, slug string) (*domain.Article, error)
 	GetDetailByID(ctx context.Context, id uint) (*domain.Article, error)
 	UpdateByID(ctx context.Context, id uint, article *domain.Article) error
+	UpdateReviewState(ctx context.Context, id uint, status string, approvedAt *time.Time) error
 	DeleteByID(ctx context.Context, id uint) error
+	CountByStatus(ctx context.Context, status string) (int64, error)
+	VersionByStatus(ctx context.Context, status string) (domain.ArticleSetVersion, error)
+	StreamByStatus(ctx context.Context, status string, offset, limit, batchSize int, fn func([]domain.Article) error) error
+	StreamContentByStatus(ctx context.Context, status string, batchSize int, fn func([]domain.Article) error) error
+
+	// Translations
+	CreateTranslation(ctx context.Context, translation *domain.ArticleTranslation) error
+	GetTranslations(ctx context.Context, articleID uint) ([]domain.ArticleTranslation, error)
+	GetTranslation(ctx context.Context, articleID uint, locale string) (*domain.ArticleTranslation, error)
+	GetTranslationsByLocale(ctx context.Context, articleIDs []uint, locale string) ([]domain.ArticleTranslation, error)
+	GetTranslationByTitle(ctx context.Context, locale, title string) (*domain.ArticleTranslation, error)
 }
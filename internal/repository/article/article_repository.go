@@ -2,46 +2,55 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"time"
 
 	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
 	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/article"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/transaction"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 )
 
+// Kolom ringan untuk listing (feed, sitemap) tanpa content
+var articleListColumns = []string{"id", "title", "slug", "category", "created_date", "updated_date", "status"}
+
 type articleRepository struct {
 	DB  *gorm.DB
 	log *zap.Logger
 }
 
 func NewArticleRepository(DB *gorm.DB, log *zap.Logger) ArticleRepository {
-	return &articleRepository{
+	return withTracing(&articleRepository{
 		DB:  DB,
 		log: log,
-	}
+	})
 }
 
 func (r *articleRepository) Create(ctx context.Context, article *domain.Article) error {
-	r.log.Debug("repository: creating article", zap.String("title", article.Title))
+	logger.FromContext(ctx).Debug("repository: creating article", zap.String("title", article.Title))
 
-	if err := r.DB.WithContext(ctx).Table("posts").Create(article).Error; err != nil {
-		r.log.Error("repository: faield to create article", zap.Error(err))
+	if err := transaction.DB(ctx, r.DB).Table("posts").Create(article).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: faield to create article", zap.Error(err))
 		return err
 	}
 
-	r.log.Debug("repository: article created successfully", zap.Uint("id", article.ID))
+	logger.FromContext(ctx).Debug("repository: article created successfully", zap.Uint("id", article.ID))
 
 	return nil
 }
 
 func (r *articleRepository) GetList(ctx context.Context, articleFilter *dto.ArticleFilter) ([]domain.Article, int64, error) {
-	r.log.Debug("repository: getting article list", zap.Int("page", articleFilter.Page), zap.Int("limit", articleFilter.Limit))
+	logger.FromContext(ctx).Debug("repository: getting article list", zap.Int("page", articleFilter.Page), zap.Int("limit", articleFilter.Limit))
 
 	var articles []domain.Article
 	var total int64
 
 	// Build query
-	query := r.DB.WithContext(ctx).Table("posts")
+	query := transaction.DB(ctx, r.DB).Table("posts")
 
 	// Apply filters into query
 	if articleFilter.HasCategory() {
@@ -58,7 +67,7 @@ func (r *articleRepository) GetList(ctx context.Context, articleFilter *dto.Arti
 
 	// Get total count data
 	if err := query.Model(&domain.Article{}).Count(&total).Error; err != nil {
-		r.log.Error("repository: failed to count articles", zap.Error(err))
+		logger.FromContext(ctx).Error("repository: failed to count articles", zap.Error(err))
 		return nil, 0, err
 	}
 
@@ -78,68 +87,211 @@ func (r *articleRepository) GetList(ctx context.Context, articleFilter *dto.Arti
 
 	// Execute query
 	if err := query.Find(&articles).Error; err != nil {
-		r.log.Error("repository: failed to get articles", zap.Error(err))
+		logger.FromContext(ctx).Error("repository: failed to get articles", zap.Error(err))
 		return nil, 0, nil
 	}
 
-	r.log.Debug("repository: articles retrieved successfully", zap.Int("count", len(articles)))
+	logger.FromContext(ctx).Debug("repository: articles retrieved successfully", zap.Int("count", len(articles)))
 
 	return articles, total, nil
 }
 
 func (r *articleRepository) GetByTitle(ctx context.Context, title string) (*domain.Article, error) {
-	r.log.Debug("repository: getting article by title", zap.String("title", title))
+	logger.FromContext(ctx).Debug("repository: getting article by title", zap.String("title", title))
 
 	var article domain.Article
-	if err := r.DB.WithContext(ctx).Table("posts").Where("title = ?", title).First(&article).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			r.log.Debug("repository: article not found by title", zap.String("title", title))
+	if err := transaction.DB(ctx, r.DB).Table("posts").Where("title = ?", title).First(&article).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.FromContext(ctx).Debug("repository: article not found by title", zap.String("title", title))
 			return nil, dto.ErrArticleNotFound
 		}
-		r.log.Error("repository: failed to get article by title", zap.String("title", title), zap.Error(err))
+		logger.FromContext(ctx).Error("repository: failed to get article by title", zap.String("title", title), zap.Error(err))
 		return nil, err
 	}
 
-	r.log.Debug("repository: article found by title", zap.String("title", title), zap.Uint("id", article.ID))
+	logger.FromContext(ctx).Debug("repository: article found by title", zap.String("title", title), zap.Uint("id", article.ID))
+	return &article, nil
+}
+
+func (r *articleRepository) GetBySlug(ctx context.Context, slug string) (*domain.Article, error) {
+	logger.FromContext(ctx).Debug("repository: getting article by slug", zap.String("slug", slug))
+
+	var article domain.Article
+	if err := transaction.DB(ctx, r.DB).Table("posts").Where("slug = ?", slug).First(&article).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.FromContext(ctx).Debug("repository: article not found by slug", zap.String("slug", slug))
+			return nil, dto.ErrArticleNotFound
+		}
+		logger.FromContext(ctx).Error("repository: failed to get article by slug", zap.String("slug", slug), zap.Error(err))
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Debug("repository: article found by slug", zap.String("slug", slug), zap.Uint("id", article.ID))
 	return &article, nil
 }
 
 func (r *articleRepository) GetDetailByID(ctx context.Context, id uint) (*domain.Article, error) {
 	var article domain.Article
-	if err := r.DB.WithContext(ctx).Table("posts").First(&article, id).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			r.log.Warn("repository: article not found", zap.Uint("id", id))
+	if err := transaction.DB(ctx, r.DB).Table("posts").First(&article, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.FromContext(ctx).Warn("repository: article not found", zap.Uint("id", id))
 			return nil, dto.ErrArticleNotFound
 		}
-		r.log.Error("repository: failed to get article detail", zap.Uint("id", id), zap.Error(err))
+		logger.FromContext(ctx).Error("repository: failed to get article detail", zap.Uint("id", id), zap.Error(err))
 		return nil, err
 	}
 
-	r.log.Debug("repository: article detail retrieved successfully", zap.Uint("id", id))
+	logger.FromContext(ctx).Debug("repository: article detail retrieved successfully", zap.Uint("id", id))
 	return &article, nil
 }
 
 func (r *articleRepository) UpdateByID(ctx context.Context, id uint, article *domain.Article) error {
-	r.log.Debug("repository: updating article", zap.Uint("id", id))
+	logger.FromContext(ctx).Debug("repository: updating article", zap.Uint("id", id))
 
 	// Update hanya field-field yang berubah
-	if err := r.DB.WithContext(ctx).Table("posts").Where("id = ?", id).Model(&domain.Article{}).Updates(article).Error; err != nil {
-		r.log.Error("repository: failed to update article", zap.Uint("id", id), zap.Error(err))
+	if err := transaction.DB(ctx, r.DB).Table("posts").Where("id = ?", id).Model(&domain.Article{}).Updates(article).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to update article", zap.Uint("id", id), zap.Error(err))
+		return err
+	}
+
+	logger.FromContext(ctx).Debug("repository: article updated successfully", zap.Uint("id", id))
+	return nil
+}
+
+// UpdateReviewState set status dan approved_at secara eksplisit, termasuk ketika approved_at nil
+func (r *articleRepository) UpdateReviewState(ctx context.Context, id uint, status string, approvedAt *time.Time) error {
+	logger.FromContext(ctx).Debug("repository: updating article review state", zap.Uint("id", id), zap.String("status", status))
+
+	if err := transaction.DB(ctx, r.DB).Table("posts").Where("id = ?", id).Updates(map[string]any{
+		"status":       status,
+		"approved_at":  approvedAt,
+		"updated_date": time.Now(),
+	}).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to update article review state", zap.Uint("id", id), zap.Error(err))
 		return err
 	}
 
-	r.log.Debug("repository: article updated successfully", zap.Uint("id", id))
 	return nil
 }
 
 func (r *articleRepository) DeleteByID(ctx context.Context, id uint) error {
-	r.log.Debug("repository: deleting article", zap.Uint("id", id))
+	logger.FromContext(ctx).Debug("repository: deleting article", zap.Uint("id", id))
 
-	if err := r.DB.WithContext(ctx).Table("posts").Where("id = ?", id).Delete(&domain.Article{}, id).Error; err != nil {
-		r.log.Error("repository: failed to delete article", zap.Uint("id", id), zap.Error(err))
+	if err := transaction.DB(ctx, r.DB).Table("posts").Where("id = ?", id).Delete(&domain.Article{}, id).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to delete article", zap.Uint("id", id), zap.Error(err))
 		return err
 	}
 
-	r.log.Debug("repository: article deleted successfully", zap.Uint("id", id))
+	logger.FromContext(ctx).Debug("repository: article deleted successfully", zap.Uint("id", id))
+	return nil
+}
+
+func (r *articleRepository) CountByStatus(ctx context.Context, status string) (int64, error) {
+	var total int64
+	if err := transaction.DB(ctx, r.DB).Table("posts").Where("status = ?", status).Count(&total).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to count articles by status", zap.String("status", status), zap.Error(err))
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// VersionByStatus jumlah artikel dan updated_date terbaru untuk satu status, berubah setiap kali
+// artikel dengan status tersebut dibuat, di ubah, di hapus atau pindah status dari replica manapun
+func (r *articleRepository) VersionByStatus(ctx context.Context, status string) (domain.ArticleSetVersion, error) {
+	var row struct {
+		Total       int64
+		LastUpdated sql.NullTime
+	}
+
+	err := transaction.DB(ctx, r.DB).Table("posts").
+		Select("COUNT(*) AS total, MAX(updated_date) AS last_updated").
+		Where("status = ?", status).
+		Scan(&row).Error
+	if err != nil {
+		logger.FromContext(ctx).Error("repository: failed to get article version by status", zap.String("status", status), zap.Error(err))
+		return domain.ArticleSetVersion{}, err
+	}
+
+	return domain.ArticleSetVersion{Count: row.Total, LastUpdated: row.LastUpdated.Time}, nil
+}
+
+// StreamByStatus baca article per batch (keyset by id) dan panggil fn untuk setiap batch,
+// offset dan limit dipakai untuk membatasi range total yang dibaca
+func (r *articleRepository) StreamByStatus(
+	ctx context.Context,
+	status string,
+	offset, limit, batchSize int,
+	fn func([]domain.Article) error,
+) error {
+	logger.FromContext(ctx).Debug("repository: streaming articles", zap.String("status", status), zap.Int("offset", offset), zap.Int("limit", limit))
+
+	return r.streamByStatus(ctx, articleListColumns, status, offset, limit, batchSize, fn)
+}
+
+// StreamContentByStatus sama seperti StreamByStatus tapi ikut membawa content
+func (r *articleRepository) StreamContentByStatus(
+	ctx context.Context,
+	status string,
+	batchSize int,
+	fn func([]domain.Article) error,
+) error {
+	logger.FromContext(ctx).Debug("repository: streaming article content", zap.String("status", status))
+
+	columns := append([]string{"content", "content_format", "excerpt", "word_count", "reading_time"}, articleListColumns...)
+	return r.streamByStatus(ctx, columns, status, 0, math.MaxInt, batchSize, fn)
+}
+
+func (r *articleRepository) streamByStatus(
+	ctx context.Context,
+	columns []string,
+	status string,
+	offset, limit, batchSize int,
+	fn func([]domain.Article) error,
+) error {
+	var lastID uint
+	remaining := limit
+
+	for remaining > 0 {
+		size := batchSize
+		if remaining < size {
+			size = remaining
+		}
+
+		query := transaction.DB(ctx, r.DB).Table("posts").
+			Select(columns).
+			Where("status = ?", status).
+			Order("id ASC").
+			Limit(size)
+
+		// Offset hanya untuk batch pertama, batch selanjutnya pakai id terakhir
+		if lastID == 0 {
+			query = query.Offset(offset)
+		} else {
+			query = query.Where("id > ?", lastID)
+		}
+
+		var articles []domain.Article
+		if err := query.Find(&articles).Error; err != nil {
+			logger.FromContext(ctx).Error("repository: failed to stream articles", zap.Error(err))
+			return err
+		}
+
+		if len(articles) == 0 {
+			break
+		}
+
+		if err := fn(articles); err != nil {
+			return err
+		}
+
+		lastID = articles[len(articles)-1].ID
+		remaining -= len(articles)
+
+		if len(articles) < size {
+			break
+		}
+	}
+
 	return nil
 }
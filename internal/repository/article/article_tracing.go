@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/article"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/tracing"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracedArticleRepository buat span ArticleRepository.<method> untuk setiap call, span SQL
+// dari plugin gorm menjadi child span ini
+type tracedArticleRepository struct {
+	next ArticleRepository
+}
+
+func withTracing(next ArticleRepository) ArticleRepository {
+	return &tracedArticleRepository{next: next}
+}
+
+func startSpan(ctx context.Context, method string) (context.Context, trace.Span) {
+	return tracing.Start(ctx, "ArticleRepository."+method)
+}
+
+// endSpan not found adalah hasil normal query, bukan error
+func endSpan(span trace.Span, err error) {
+	if errors.Is(err, dto.ErrArticleNotFound) {
+		err = nil
+	}
+	tracing.End(span, err)
+}
+
+func (r *tracedArticleRepository) Create(ctx context.Context, article *domain.Article) error {
+	ctx, span := startSpan(ctx, "Create")
+	err := r.next.Create(ctx, article)
+	endSpan(span, err)
+	return err
+}
+
+func (r *tracedArticleRepository) GetList(ctx context.Context, articleFilter *dto.ArticleFilter) ([]domain.Article, int64, error) {
+	ctx, span := startSpan(ctx, "GetList")
+	articles, total, err := r.next.GetList(ctx, articleFilter)
+	endSpan(span, err)
+	return articles, total, err
+}
+
+func (r *tracedArticleRepository) GetByTitle(ctx context.Context, title string) (*domain.Article, error) {
+	ctx, span := startSpan(ctx, "GetByTitle")
+	article, err := r.next.GetByTitle(ctx, title)
+	endSpan(span, err)
+	return article, err
+}
+
+func (r *tracedArticleRepository) GetBySlug(ctx context.Context, slug string) (*domain.Article, error) {
+	ctx, span := startSpan(ctx, "GetBySlug")
+	article, err := r.next.GetBySlug(ctx, slug)
+	endSpan(span, err)
+	return article, err
+}
+
+func (r *tracedArticleRepository) GetDetailByID(ctx context.Context, id uint) (*domain.Article, error) {
+	ctx, span := startSpan(ctx, "GetDetailByID")
+	article, err := r.next.GetDetailByID(ctx, id)
+	endSpan(span, err)
+	return article, err
+}
+
+func (r *tracedArticleRepository) UpdateByID(ctx context.Context, id uint, article *domain.Article) error {
+	ctx, span := startSpan(ctx, "UpdateByID")
+	err := r.next.UpdateByID(ctx, id, article)
+	endSpan(span, err)
+	return err
+}
+
+func (r *tracedArticleRepository) UpdateReviewState(ctx context.Context, id uint, status string, approvedAt *time.Time) error {
+	ctx, span := startSpan(ctx, "UpdateReviewState")
+	err := r.next.UpdateReviewState(ctx, id, status, approvedAt)
+	endSpan(span, err)
+	return err
+}
+
+func (r *tracedArticleRepository) DeleteByID(ctx context.Context, id uint) error {
+	ctx, span := startSpan(ctx, "DeleteByID")
+	err := r.next.DeleteByID(ctx, id)
+	endSpan(span, err)
+	return err
+}
+
+func (r *tracedArticleRepository) CountByStatus(ctx context.Context, status string) (int64, error) {
+	ctx, span := startSpan(ctx, "CountByStatus")
+	total, err := r.next.CountByStatus(ctx, status)
+	endSpan(span, err)
+	return total, err
+}
+
+func (r *tracedArticleRepository) VersionByStatus(ctx context.Context, status string) (domain.ArticleSetVersion, error) {
+	ctx, span := startSpan(ctx, "VersionByStatus")
+	version, err := r.next.VersionByStatus(ctx, status)
+	endSpan(span, err)
+	return version, err
+}
+
+func (r *tracedArticleRepository) StreamByStatus(ctx context.Context, status string, offset, limit, batchSize int, fn func([]domain.Article) error) error {
+	ctx, span := startSpan(ctx, "StreamByStatus")
+	err := r.next.StreamByStatus(ctx, status, offset, limit, batchSize, fn)
+	endSpan(span, err)
+	return err
+}
+
+func (r *tracedArticleRepository) StreamContentByStatus(ctx context.Context, status string, batchSize int, fn func([]domain.Article) error) error {
+	ctx, span := startSpan(ctx, "StreamContentByStatus")
+	err := r.next.StreamContentByStatus(ctx, status, batchSize, fn)
+	endSpan(span, err)
+	return err
+}
+
+func (r *tracedArticleRepository) CreateTranslation(ctx context.Context, translation *domain.ArticleTranslation) error {
+	ctx, span := startSpan(ctx, "CreateTranslation")
+	err := r.next.CreateTranslation(ctx, translation)
+	endSpan(span, err)
+	return err
+}
+
+func (r *tracedArticleRepository) GetTranslations(ctx context.Context, articleID uint) ([]domain.ArticleTranslation, error) {
+	ctx, span := startSpan(ctx, "GetTranslations")
+	translations, err := r.next.GetTranslations(ctx, articleID)
+	endSpan(span, err)
+	return translations, err
+}
+
+func (r *tracedArticleRepository) GetTranslation(ctx context.Context, articleID uint, locale string) (*domain.ArticleTranslation, error) {
+	ctx, span := startSpan(ctx, "GetTranslation")
+	translation, err := r.next.GetTranslation(ctx, articleID, locale)
+	endSpan(span, err)
+	return translation, err
+}
+
+func (r *tracedArticleRepository) GetTranslationsByLocale(ctx context.Context, articleIDs []uint, locale string) ([]domain.ArticleTranslation, error) {
+	ctx, span := startSpan(ctx, "GetTranslationsByLocale")
+	translations, err := r.next.GetTranslationsByLocale(ctx, articleIDs, locale)
+	endSpan(span, err)
+	return translations, err
+}
+
+func (r *tracedArticleRepository) GetTranslationByTitle(ctx context.Context, locale, title string) (*domain.ArticleTranslation, error) {
+	ctx, span := startSpan(ctx, "GetTranslationByTitle")
+	translation, err := r.next.GetTranslationByTitle(ctx, locale, title)
+	endSpan(span, err)
+	return translation, err
+}
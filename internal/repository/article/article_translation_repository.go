@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/article"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+func (r *articleRepository) CreateTranslation(ctx context.Context, translation *domain.ArticleTranslation) error {
+	logger.FromContext(ctx).Debug("repository: creating article translation", zap.Uint("article_id", translation.ArticleID), zap.String("locale", translation.Locale))
+
+	if err := r.DB.WithContext(ctx).Table("article_translations").Create(translation).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to create article translation", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *articleRepository) GetTranslations(ctx context.Context, articleID uint) ([]domain.ArticleTranslation, error) {
+	var translations []domain.ArticleTranslation
+	if err := r.DB.WithContext(ctx).Table("article_translations").
+		Where("article_id = ?", articleID).
+		Order("locale ASC").
+		Find(&translations).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to get article translations", zap.Uint("article_id", articleID), zap.Error(err))
+		return nil, err
+	}
+
+	return translations, nil
+}
+
+func (r *articleRepository) GetTranslation(ctx context.Context, articleID uint, locale string) (*domain.ArticleTranslation, error) {
+	var translation domain.ArticleTranslation
+	if err := r.DB.WithContext(ctx).Table("article_translations").
+		Where("article_id = ? AND locale = ?", articleID, locale).
+		First(&translation).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, dto.ErrArticleNotFound
+		}
+		logger.FromContext(ctx).Error("repository: failed to get article translation", zap.Uint("article_id", articleID), zap.Error(err))
+		return nil, err
+	}
+
+	return &translation, nil
+}
+
+// GetTranslationsByLocale ambil translation beberapa article sekaligus untuk listing
+func (r *articleRepository) GetTranslationsByLocale(ctx context.Context, articleIDs []uint, locale string) ([]domain.ArticleTranslation, error) {
+	if len(articleIDs) == 0 {
+		return nil, nil
+	}
+
+	var translations []domain.ArticleTranslation
+	if err := r.DB.WithContext(ctx).Table("article_translations").
+		Where("article_id IN ? AND locale = ?", articleIDs, locale).
+		Find(&translations).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to get translations by locale", zap.String("locale", locale), zap.Error(err))
+		return nil, err
+	}
+
+	return translations, nil
+}
+
+func (r *articleRepository) GetTranslationByTitle(ctx context.Context, locale, title string) (*domain.ArticleTranslation, error) {
+	var translation domain.ArticleTranslation
+	if err := r.DB.WithContext(ctx).Table("article_translations").
+		Where("locale = ? AND title = ?", locale, title).
+		First(&translation).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, dto.ErrArticleNotFound
+		}
+		logger.FromContext(ctx).Error("repository: failed to get translation by title", zap.String("locale", locale), zap.Error(err))
+		return nil, err
+	}
+
+	return &translation, nil
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/audit"
+)
+
+// AuditRepository sengaja tidak punya update / delete, audit log bersifat append-only
+type AuditRepository interface {
+	Create(ctx context.Context, log *domain.AuditLog) error
+	GetList(ctx context.Context, auditFilter *dto.AuditFilter) ([]domain.AuditLog, int64, error)
+	Stream(ctx context.Context, auditFilter *dto.AuditFilter, batchSize int, fn func([]domain.AuditLog) error) error
+}
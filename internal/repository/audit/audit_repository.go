@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/audit"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/transaction"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type auditRepository struct {
+	DB  *gorm.DB
+	log *zap.Logger
+}
+
+func NewAuditRepository(DB *gorm.DB, log *zap.Logger) AuditRepository {
+	return &auditRepository{
+		DB:  DB,
+		log: log,
+	}
+}
+
+func (r *auditRepository) Create(ctx context.Context, log *domain.AuditLog) error {
+	// Ikut transaction yang sedang berjalan supaya audit dan perubahan artikel commit bersama
+	if err := transaction.DB(ctx, r.DB).Table("audit_logs").Create(log).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to create audit log", zap.String("action", log.Action), zap.Uint("entity_id", log.EntityID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *auditRepository) GetList(ctx context.Context, auditFilter *dto.AuditFilter) ([]domain.AuditLog, int64, error) {
+	logger.FromContext(ctx).Debug("repository: getting audit logs", zap.Int("page", auditFilter.Page), zap.Int("limit", auditFilter.Limit))
+
+	var logs []domain.AuditLog
+	var total int64
+
+	query := r.filtered(ctx, auditFilter)
+
+	// Get total count data
+	if err := query.Count(&total).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to count audit logs", zap.Error(err))
+		return nil, 0, err
+	}
+
+	// Urutan selalu berdasarkan waktu, id sebagai tie breaker
+	order := auditFilter.GetSortOrder()
+	query = query.Order("created_date " + order + ", id " + order).
+		Offset(auditFilter.GetOffset()).
+		Limit(auditFilter.GetDefaultLimit())
+
+	if err := query.Find(&logs).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to get audit logs", zap.Error(err))
+		return nil, 0, err
+	}
+
+	return logs, total, nil
+}
+
+// Stream baca semua audit log yang cocok dengan filter per batch, dipakai untuk export
+func (r *auditRepository) Stream(ctx context.Context, auditFilter *dto.AuditFilter, batchSize int, fn func([]domain.AuditLog) error) error {
+	var batch []domain.AuditLog
+
+	result := r.filtered(ctx, auditFilter).
+		FindInBatches(&batch, batchSize, func(tx *gorm.DB, _ int) error {
+			return fn(batch)
+		})
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("repository: failed to stream audit logs", zap.Error(result.Error))
+		return result.Error
+	}
+
+	return nil
+}
+
+func (r *auditRepository) filtered(ctx context.Context, auditFilter *dto.AuditFilter) *gorm.DB {
+	query := r.DB.WithContext(ctx).Table("audit_logs")
+
+	if actor := auditFilter.GetActor(); actor != "" {
+		query = query.Where("actor = ?", actor)
+	}
+
+	if entity := auditFilter.GetEntity(); entity != "" {
+		query = query.Where("entity = ?", entity)
+	}
+
+	if entityID := auditFilter.GetEntityID(); entityID != 0 {
+		query = query.Where("entity_id = ?", entityID)
+	}
+
+	if action := auditFilter.GetAction(); action != "" {
+		query = query.Where("action = ?", action)
+	}
+
+	if from := auditFilter.Filters.FromTime; from != nil {
+		query = query.Where("created_date >= ?", *from)
+	}
+
+	if to := auditFilter.Filters.ToTime; to != nil {
+		query = query.Where("created_date <= ?", *to)
+	}
+
+	return query
+}
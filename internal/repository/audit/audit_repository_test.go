@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/audit"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/tenant"
+	"go.uber.org/zap"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+func TestGetListFilters(t *testing.T) {
+	// DryRun hanya build SQL, tidak ada koneksi ke database
+	db, err := gorm.Open(mysql.New(mysql.Config{DSN: "user:pass@tcp(127.0.0.1:1)/test", SkipInitializeWithVersion: true}), &gorm.Config{
+		DryRun:                 true,
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to open dry run db: %v", err)
+	}
+	if err := tenant.RegisterScope(db, tenant.ScopedTables...); err != nil {
+		t.Fatalf("RegisterScope() error = %v", err)
+	}
+
+	var statements []string
+	if err := db.Callback().Query().After("gorm:query").Register("test:capture", func(tx *gorm.DB) {
+		statements = append(statements, tx.Statement.SQL.String())
+		// Di luar DryRun gorm reset SQL setelah query, tanpa ini Find memakai ulang SQL count
+		tx.Statement.SQL.Reset()
+		tx.Statement.Vars = nil
+	}); err != nil {
+		t.Fatalf("register capture callback: %v", err)
+	}
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+
+	tests := []struct {
+		name    string
+		fields  dto.AuditFilterFields
+		order   string
+		want    []string
+		notWant []string
+	}{
+		{
+			name:    "no filter",
+			order:   "desc",
+			want:    []string{"`audit_logs`.`tenant_id` = ?", "ORDER BY created_date desc, id desc"},
+			notWant: []string{"actor = ?", "action = ?", "created_date >= ?"},
+		},
+		{
+			name:   "all filters",
+			fields: dto.AuditFilterFields{Actor: "alice", Entity: domain.AuditEntityArticle, EntityID: 7, Action: "update", FromTime: &from, ToTime: &to},
+			order:  "asc",
+			want: []string{
+				"actor = ?", "entity = ?", "entity_id = ?", "action = ?", "created_date >= ?", "created_date <= ?",
+				"ORDER BY created_date asc, id asc",
+			},
+		},
+	}
+
+	repo := NewAuditRepository(db, zap.NewNop())
+	ctx := tenant.WithTenant(context.Background(), "brand-a")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statements = nil
+
+			filter := dto.NewAuditFilter()
+			filter.Filters = tt.fields
+			filter.SortOrder = tt.order
+
+			if _, _, err := repo.GetList(ctx, filter); err != nil {
+				t.Fatalf("GetList() error = %v", err)
+			}
+
+			// Query count dan query data harus memakai filter yang sama
+			if len(statements) != 2 {
+				t.Fatalf("got %d queries, want count and find", len(statements))
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(statements[1], want) {
+					t.Errorf("query %q does not contain %q", statements[1], want)
+				}
+				if !strings.HasPrefix(want, "ORDER BY") && !strings.Contains(statements[0], want) {
+					t.Errorf("count query %q does not contain %q", statements[0], want)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(statements[1], notWant) {
+					t.Errorf("query %q contains %q", statements[1], notWant)
+				}
+			}
+		})
+	}
+}
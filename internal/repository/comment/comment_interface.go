@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/comment"
+)
+
+type CommentRepository interface {
+	Create(ctx context.Context, comment *domain.Comment) error
+	GetList(ctx context.Context, commentFilter *dto.CommentFilter) ([]domain.Comment, int64, error)
+	GetThreads(ctx context.Context, articleID uint, status string, offset, limit int) ([]domain.Comment, int64, error)
+	GetReplies(ctx context.Context, articleID uint, status string) ([]domain.Comment, error)
+	GetDetailByID(ctx context.Context, id uint) (*domain.Comment, error)
+	UpdateStatus(ctx context.Context, id uint, status string) error
+}
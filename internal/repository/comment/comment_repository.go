@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/comment"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// Kolom yang boleh dipakai untuk sorting antrian moderasi
+var commentSortColumns = map[string]bool{
+	"created_date": true,
+	"updated_date": true,
+	"id":           true,
+}
+
+type commentRepository struct {
+	DB  *gorm.DB
+	log *zap.Logger
+}
+
+func NewCommentRepository(DB *gorm.DB, log *zap.Logger) CommentRepository {
+	return &commentRepository{
+		DB:  DB,
+		log: log,
+	}
+}
+
+func (r *commentRepository) Create(ctx context.Context, comment *domain.Comment) error {
+	logger.FromContext(ctx).Debug("repository: creating comment", zap.Uint("article_id", comment.ArticleID))
+
+	if err := r.DB.WithContext(ctx).Table("comments").Create(comment).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to create comment", zap.Error(err))
+		return err
+	}
+
+	logger.FromContext(ctx).Debug("repository: comment created successfully", zap.Uint("id", comment.ID))
+
+	return nil
+}
+
+func (r *commentRepository) GetList(ctx context.Context, commentFilter *dto.CommentFilter) ([]domain.Comment, int64, error) {
+	logger.FromContext(ctx).Debug("repository: getting comment list", zap.Int("page", commentFilter.Page), zap.Int("limit", commentFilter.Limit))
+
+	var comments []domain.Comment
+	var total int64
+
+	// Build query
+	query := r.DB.WithContext(ctx).Table("comments")
+
+	// Apply filters into query
+	if commentFilter.HasArticleID() {
+		query = query.Where("article_id = ?", commentFilter.GetArticleID())
+	}
+
+	if commentFilter.HasStatus() {
+		query = query.Where("status = ?", commentFilter.GetStatus())
+	}
+
+	if commentFilter.GetSearch() != "" {
+		query = query.Where("content LIKE ?", "%"+commentFilter.GetSearch()+"%")
+	}
+
+	// Get total count data
+	if err := query.Count(&total).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to count comments", zap.Error(err))
+		return nil, 0, err
+	}
+
+	// Apply sorting
+	sortBy := commentFilter.GetSortBy()
+	if !commentSortColumns[sortBy] {
+		sortBy = "created_date"
+	}
+	query = query.Order(sortBy + " " + commentFilter.GetSortOrder())
+
+	// Apply pagination
+	query = query.Offset(commentFilter.GetOffset()).Limit(commentFilter.GetDefaultLimit())
+
+	if err := query.Find(&comments).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to get comments", zap.Error(err))
+		return nil, 0, err
+	}
+
+	logger.FromContext(ctx).Debug("repository: comments retrieved successfully", zap.Int("count", len(comments)))
+
+	return comments, total, nil
+}
+
+// GetThreads ambil komentar root (tanpa parent) dari satu artikel, diurutkan dari yang terlama
+func (r *commentRepository) GetThreads(ctx context.Context, articleID uint, status string, offset, limit int) ([]domain.Comment, int64, error) {
+	logger.FromContext(ctx).Debug("repository: getting comment threads", zap.Uint("article_id", articleID))
+
+	var comments []domain.Comment
+	var total int64
+
+	query := r.DB.WithContext(ctx).Table("comments").
+		Where("article_id = ? AND parent_id IS NULL AND status = ?", articleID, status)
+
+	if err := query.Count(&total).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to count comment threads", zap.Error(err))
+		return nil, 0, err
+	}
+
+	if err := query.Order("created_date ASC, id ASC").Offset(offset).Limit(limit).Find(&comments).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to get comment threads", zap.Error(err))
+		return nil, 0, err
+	}
+
+	return comments, total, nil
+}
+
+// GetReplies ambil semua balasan pada satu artikel, disusun jadi tree oleh usecase
+func (r *commentRepository) GetReplies(ctx context.Context, articleID uint, status string) ([]domain.Comment, error) {
+	logger.FromContext(ctx).Debug("repository: getting comment replies", zap.Uint("article_id", articleID))
+
+	var comments []domain.Comment
+	if err := r.DB.WithContext(ctx).Table("comments").
+		Where("article_id = ? AND parent_id IS NOT NULL AND status = ?", articleID, status).
+		Order("created_date ASC, id ASC").
+		Find(&comments).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to get comment replies", zap.Error(err))
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+func (r *commentRepository) GetDetailByID(ctx context.Context, id uint) (*domain.Comment, error) {
+	var comment domain.Comment
+	if err := r.DB.WithContext(ctx).Table("comments").First(&comment, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.FromContext(ctx).Warn("repository: comment not found", zap.Uint("id", id))
+			return nil, dto.ErrCommentNotFound
+		}
+		logger.FromContext(ctx).Error("repository: failed to get comment detail", zap.Uint("id", id), zap.Error(err))
+		return nil, err
+	}
+
+	return &comment, nil
+}
+
+func (r *commentRepository) UpdateStatus(ctx context.Context, id uint, status string) error {
+	logger.FromContext(ctx).Debug("repository: updating comment status", zap.Uint("id", id), zap.String("status", status))
+
+	result := r.DB.WithContext(ctx).Table("comments").Where("id = ?", id).Updates(map[string]any{
+		"status":       status,
+		"updated_date": gorm.Expr("CURRENT_TIMESTAMP"),
+	})
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("repository: failed to update comment status", zap.Uint("id", id), zap.Error(result.Error))
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return dto.ErrCommentNotFound
+	}
+
+	return nil
+}
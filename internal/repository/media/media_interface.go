@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+)
+
+type MediaRepository interface {
+	Create(ctx context.Context, media *domain.Media) error
+	GetByID(ctx context.Context, id uint) (*domain.Media, error)
+	GetByIDs(ctx context.Context, ids []uint) ([]domain.Media, error)
+	DeleteByID(ctx context.Context, id uint) error
+	GetAttachments(ctx context.Context, articleID uint) ([]domain.Media, error)
+	SetAttachments(ctx context.Context, articleID uint, mediaIDs []uint) error
+}
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/media"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/transaction"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type mediaRepository struct {
+	DB  *gorm.DB
+	log *zap.Logger
+}
+
+type articleAttachment struct {
+	ArticleID uint
+	MediaID   uint
+	Position  int
+}
+
+func NewMediaRepository(DB *gorm.DB, log *zap.Logger) MediaRepository {
+	return &mediaRepository{
+		DB:  DB,
+		log: log,
+	}
+}
+
+func (r *mediaRepository) Create(ctx context.Context, media *domain.Media) error {
+	logger.FromContext(ctx).Debug("repository: creating media", zap.String("key", media.StorageKey))
+
+	if err := transaction.DB(ctx, r.DB).Table("media").Create(media).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to create media", zap.Error(err))
+		return err
+	}
+
+	logger.FromContext(ctx).Debug("repository: media created successfully", zap.Uint("id", media.ID))
+	return nil
+}
+
+func (r *mediaRepository) GetByID(ctx context.Context, id uint) (*domain.Media, error) {
+	var media domain.Media
+	if err := transaction.DB(ctx, r.DB).Table("media").First(&media, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.FromContext(ctx).Warn("repository: media not found", zap.Uint("id", id))
+			return nil, dto.ErrMediaNotFound
+		}
+		logger.FromContext(ctx).Error("repository: failed to get media", zap.Uint("id", id), zap.Error(err))
+		return nil, err
+	}
+
+	return &media, nil
+}
+
+func (r *mediaRepository) GetByIDs(ctx context.Context, ids []uint) ([]domain.Media, error) {
+	var media []domain.Media
+	if len(ids) == 0 {
+		return media, nil
+	}
+
+	if err := transaction.DB(ctx, r.DB).Table("media").Where("id IN ?", ids).Find(&media).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to get media by ids", zap.Error(err))
+		return nil, err
+	}
+
+	return media, nil
+}
+
+func (r *mediaRepository) DeleteByID(ctx context.Context, id uint) error {
+	logger.FromContext(ctx).Debug("repository: deleting media", zap.Uint("id", id))
+
+	err := transaction.DB(ctx, r.DB).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table("article_attachments").Where("media_id = ?", id).Delete(&articleAttachment{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Table("posts").Where("cover_media_id = ?", id).Update("cover_media_id", nil).Error; err != nil {
+			return err
+		}
+		return tx.Table("media").Delete(&domain.Media{}, id).Error
+	})
+	if err != nil {
+		logger.FromContext(ctx).Error("repository: failed to delete media", zap.Uint("id", id), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *mediaRepository) GetAttachments(ctx context.Context, articleID uint) ([]domain.Media, error) {
+	var media []domain.Media
+	err := transaction.DB(ctx, r.DB).Table("media").
+		Select("media.*").
+		Joins("JOIN article_attachments aa ON aa.media_id = media.id").
+		Where("aa.article_id = ?", articleID).
+		Order("aa.position ASC").
+		Find(&media).Error
+	if err != nil {
+		logger.FromContext(ctx).Error("repository: failed to get article attachments", zap.Uint("article_id", articleID), zap.Error(err))
+		return nil, err
+	}
+
+	return media, nil
+}
+
+// SetAttachments replace semua attachment article sesuai urutan mediaIDs
+func (r *mediaRepository) SetAttachments(ctx context.Context, articleID uint, mediaIDs []uint) error {
+	logger.FromContext(ctx).Debug("repository: setting article attachments", zap.Uint("article_id", articleID), zap.Int("count", len(mediaIDs)))
+
+	err := transaction.DB(ctx, r.DB).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table("article_attachments").Where("article_id = ?", articleID).Delete(&articleAttachment{}).Error; err != nil {
+			return err
+		}
+
+		if len(mediaIDs) == 0 {
+			return nil
+		}
+
+		rows := make([]articleAttachment, len(mediaIDs))
+		for i, mediaID := range mediaIDs {
+			rows[i] = articleAttachment{ArticleID: articleID, MediaID: mediaID, Position: i}
+		}
+		return tx.Table("article_attachments").Create(&rows).Error
+	})
+	if err != nil {
+		logger.FromContext(ctx).Error("repository: failed to set article attachments", zap.Uint("article_id", articleID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
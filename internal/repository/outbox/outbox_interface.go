@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+)
+
+type OutboxRepository interface {
+	// Append ikut transaction di context kalau ada
+	Append(ctx context.Context, event *domain.OutboxEvent) error
+	FetchDue(ctx context.Context, now time.Time, limit int) ([]domain.OutboxEvent, error)
+	Claim(ctx context.Context, event *domain.OutboxEvent, leaseUntil time.Time) (bool, error)
+	MarkPublished(ctx context.Context, id uint, publishedAt time.Time) error
+	Reschedule(ctx context.Context, id uint, nextAttemptAt time.Time, lastError string) error
+	MarkDead(ctx context.Context, id uint, failedAt time.Time, lastError string) error
+}
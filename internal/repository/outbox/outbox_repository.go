@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/transaction"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// Panjang maksimal last_error yang disimpan
+const maxErrorLength = 1000
+
+type outboxRepository struct {
+	DB  *gorm.DB
+	log *zap.Logger
+}
+
+func NewOutboxRepository(DB *gorm.DB, log *zap.Logger) OutboxRepository {
+	return &outboxRepository{
+		DB:  DB,
+		log: log,
+	}
+}
+
+func (r *outboxRepository) Append(ctx context.Context, event *domain.OutboxEvent) error {
+	logger.FromContext(ctx).Debug("repository: appending outbox event", zap.String("event", event.EventType), zap.Uint("aggregate_id", event.AggregateID))
+
+	if err := transaction.DB(ctx, r.DB).Table("outbox_events").Create(event).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to append outbox event", zap.String("event", event.EventType), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// FetchDue ambil event yang siap dikirim. Event hanya diambil kalau tidak ada event lebih lama
+// untuk aggregate yang sama yang belum selesai, supaya urutan per artikel tetap terjaga
+func (r *outboxRepository) FetchDue(ctx context.Context, now time.Time, limit int) ([]domain.OutboxEvent, error) {
+	var events []domain.OutboxEvent
+	err := r.DB.WithContext(ctx).Table("outbox_events AS o").
+		Where("o.published_at IS NULL AND o.failed_at IS NULL AND o.next_attempt_at <= ?", now).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM outbox_events AS p
+			WHERE p.tenant_id = o.tenant_id
+				AND p.aggregate_type = o.aggregate_type
+				AND p.aggregate_id = o.aggregate_id
+				AND p.id < o.id
+				AND p.published_at IS NULL
+				AND p.failed_at IS NULL
+		)`).
+		Order("o.id ASC").
+		Limit(limit).
+		Find(&events).Error
+	if err != nil {
+		logger.FromContext(ctx).Error("repository: failed to fetch due outbox events", zap.Error(err))
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// Claim tandai event sedang dikirim sampai leaseUntil. Attempts dipakai sebagai versi,
+// jadi kalau relay lain sudah claim lebih dulu update tidak kena row dan return false
+func (r *outboxRepository) Claim(ctx context.Context, event *domain.OutboxEvent, leaseUntil time.Time) (bool, error) {
+	result := r.DB.WithContext(ctx).Table("outbox_events").
+		Where("id = ? AND attempts = ? AND published_at IS NULL AND failed_at IS NULL", event.ID, event.Attempts).
+		Updates(map[string]any{
+			"attempts":        event.Attempts + 1,
+			"next_attempt_at": leaseUntil,
+		})
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("repository: failed to claim outbox event", zap.Uint("id", event.ID), zap.Error(result.Error))
+		return false, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	event.Attempts++
+	event.NextAttemptAt = leaseUntil
+	return true, nil
+}
+
+func (r *outboxRepository) MarkPublished(ctx context.Context, id uint, publishedAt time.Time) error {
+	return r.update(ctx, id, map[string]any{
+		"published_at": publishedAt,
+		"last_error":   "",
+	})
+}
+
+func (r *outboxRepository) Reschedule(ctx context.Context, id uint, nextAttemptAt time.Time, lastError string) error {
+	return r.update(ctx, id, map[string]any{
+		"next_attempt_at": nextAttemptAt,
+		"last_error":      truncateError(lastError),
+	})
+}
+
+// MarkDead event tidak di retry lagi, tetap disimpan supaya bisa di investigasi
+func (r *outboxRepository) MarkDead(ctx context.Context, id uint, failedAt time.Time, lastError string) error {
+	return r.update(ctx, id, map[string]any{
+		"failed_at":  failedAt,
+		"last_error": truncateError(lastError),
+	})
+}
+
+func (r *outboxRepository) update(ctx context.Context, id uint, values map[string]any) error {
+	if err := r.DB.WithContext(ctx).Table("outbox_events").Where("id = ?", id).Updates(values).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to update outbox event", zap.Uint("id", id), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func truncateError(message string) string {
+	if len(message) > maxErrorLength {
+		return message[:maxErrorLength]
+	}
+	return message
+}
@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/tenant"
+	"go.uber.org/zap"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type capturedStatement struct {
+	sql  string
+	vars []any
+}
+
+// newDryRunRepository DryRun hanya build SQL, tidak ada koneksi ke database
+func newDryRunRepository(t *testing.T) (OutboxRepository, *[]capturedStatement) {
+	t.Helper()
+
+	db, err := gorm.Open(mysql.New(mysql.Config{DSN: "user:pass@tcp(127.0.0.1:1)/test", SkipInitializeWithVersion: true}), &gorm.Config{
+		DryRun:                 true,
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to open dry run db: %v", err)
+	}
+	if err := tenant.RegisterScope(db, tenant.ScopedTables...); err != nil {
+		t.Fatalf("RegisterScope() error = %v", err)
+	}
+
+	var statements []capturedStatement
+	capture := func(tx *gorm.DB) {
+		statements = append(statements, capturedStatement{sql: tx.Statement.SQL.String(), vars: tx.Statement.Vars})
+	}
+	if err := db.Callback().Query().After("gorm:query").Register("test:capture", capture); err != nil {
+		t.Fatalf("register capture callback: %v", err)
+	}
+	if err := db.Callback().Update().After("gorm:update").Register("test:capture", capture); err != nil {
+		t.Fatalf("register capture callback: %v", err)
+	}
+
+	return NewOutboxRepository(db, zap.NewNop()), &statements
+}
+
+func TestFetchDueKeepsAggregateOrder(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		want    []string
+		notWant []string
+	}{
+		{
+			// Relay membaca antrian semua tenant
+			name: "all tenants",
+			ctx:  tenant.WithAllTenants(context.Background()),
+			want: []string{
+				"o.published_at IS NULL AND o.failed_at IS NULL AND o.next_attempt_at <= ?",
+				"NOT EXISTS",
+				"p.tenant_id = o.tenant_id",
+				"p.aggregate_type = o.aggregate_type",
+				"p.aggregate_id = o.aggregate_id",
+				"p.id < o.id",
+				"ORDER BY o.id ASC LIMIT ?",
+			},
+			notWant: []string{"`o`.`tenant_id` = ?"},
+		},
+		{
+			name: "single tenant uses the table alias",
+			ctx:  tenant.WithTenant(context.Background(), "brand-a"),
+			want: []string{"`o`.`tenant_id` = ?", "NOT EXISTS"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, statements := newDryRunRepository(t)
+
+			if _, err := repo.FetchDue(tt.ctx, time.Now(), 50); err != nil {
+				t.Fatalf("FetchDue() error = %v", err)
+			}
+
+			if len(*statements) != 1 {
+				t.Fatalf("got %d queries, want 1", len(*statements))
+			}
+			query := (*statements)[0].sql
+			for _, want := range tt.want {
+				if !strings.Contains(query, want) {
+					t.Errorf("query %q does not contain %q", query, want)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(query, notWant) {
+					t.Errorf("query %q contains %q", query, notWant)
+				}
+			}
+		})
+	}
+}
+
+func TestClaimUsesAttemptsAsVersion(t *testing.T) {
+	repo, statements := newDryRunRepository(t)
+	leaseUntil := time.Now().Add(30 * time.Second)
+	event := &domain.OutboxEvent{ID: 9, Attempts: 2}
+
+	claimed, err := repo.Claim(tenant.WithAllTenants(context.Background()), event, leaseUntil)
+	if err != nil {
+		t.Fatalf("Claim() error = %v", err)
+	}
+
+	if len(*statements) != 1 {
+		t.Fatalf("got %d statements, want 1", len(*statements))
+	}
+	statement := (*statements)[0]
+	for _, want := range []string{"`attempts`=?", "`next_attempt_at`=?", "id = ? AND attempts = ? AND published_at IS NULL AND failed_at IS NULL"} {
+		if !strings.Contains(statement.sql, want) {
+			t.Errorf("update %q does not contain %q", statement.sql, want)
+		}
+	}
+
+	// Attempts baru di SET, attempts lama di WHERE
+	var setAttempts, whereAttempts bool
+	for _, v := range statement.vars {
+		if v == 3 {
+			setAttempts = true
+		}
+		if v == 2 {
+			whereAttempts = true
+		}
+	}
+	if !setAttempts || !whereAttempts {
+		t.Errorf("update vars = %v, want attempts 3 set where attempts 2", statement.vars)
+	}
+
+	// Update yang tidak kena row berarti relay lain menang, event tidak boleh berubah
+	if claimed || event.Attempts != 2 || !event.NextAttemptAt.IsZero() {
+		t.Fatalf("Claim() = %v, event = %+v, want not claimed and unchanged", claimed, event)
+	}
+}
+
+func TestTruncateError(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		wantLen int
+	}{
+		{name: "short", message: "timeout", wantLen: 7},
+		{name: "exact", message: strings.Repeat("x", maxErrorLength), wantLen: maxErrorLength},
+		{name: "long", message: strings.Repeat("x", maxErrorLength+10), wantLen: maxErrorLength},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := truncateError(tt.message); len(got) != tt.wantLen {
+				t.Errorf("len(truncateError()) = %d, want %d", len(got), tt.wantLen)
+			}
+		})
+	}
+}
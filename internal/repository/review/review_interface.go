@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+)
+
+type ReviewRepository interface {
+	CreateRound(ctx context.Context, reviews []domain.Review) error
+	GetByArticle(ctx context.Context, articleID uint) ([]domain.Review, error)
+	GetByRound(ctx context.Context, articleID uint, round int) ([]domain.Review, error)
+	GetDetailByID(ctx context.Context, articleID, id uint) (*domain.Review, error)
+	LatestRound(ctx context.Context, articleID uint) (int, error)
+	UpdateDecision(ctx context.Context, id uint, status, comment string) error
+	CancelPending(ctx context.Context, articleID uint) error
+}
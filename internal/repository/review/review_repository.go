@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/review"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/transaction"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type reviewRepository struct {
+	DB  *gorm.DB
+	log *zap.Logger
+}
+
+func NewReviewRepository(DB *gorm.DB, log *zap.Logger) ReviewRepository {
+	return &reviewRepository{
+		DB:  DB,
+		log: log,
+	}
+}
+
+// CreateRound batalkan review yang masih pending lalu simpan round baru dalam satu transaksi
+func (r *reviewRepository) CreateRound(ctx context.Context, reviews []domain.Review) error {
+	if len(reviews) == 0 {
+		return nil
+	}
+
+	articleID := reviews[0].ArticleID
+	logger.FromContext(ctx).Debug("repository: creating review round", zap.Uint("article_id", articleID), zap.Int("reviewers", len(reviews)))
+
+	err := transaction.DB(ctx, r.DB).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table("article_reviews").
+			Where("article_id = ? AND status = ?", articleID, string(domain.ReviewPending)).
+			Updates(map[string]any{
+				"status":       string(domain.ReviewCancelled),
+				"decided_date": gorm.Expr("CURRENT_TIMESTAMP"),
+			}).Error; err != nil {
+			return err
+		}
+
+		return tx.Table("article_reviews").Create(&reviews).Error
+	})
+	if err != nil {
+		logger.FromContext(ctx).Error("repository: failed to create review round", zap.Uint("article_id", articleID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// GetByArticle ambil seluruh riwayat review satu artikel, round terbaru lebih dulu
+func (r *reviewRepository) GetByArticle(ctx context.Context, articleID uint) ([]domain.Review, error) {
+	var reviews []domain.Review
+	if err := transaction.DB(ctx, r.DB).Table("article_reviews").
+		Where("article_id = ?", articleID).
+		Order("round DESC, id ASC").
+		Find(&reviews).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to get reviews", zap.Uint("article_id", articleID), zap.Error(err))
+		return nil, err
+	}
+
+	return reviews, nil
+}
+
+func (r *reviewRepository) GetByRound(ctx context.Context, articleID uint, round int) ([]domain.Review, error) {
+	var reviews []domain.Review
+	if err := transaction.DB(ctx, r.DB).Table("article_reviews").
+		Where("article_id = ? AND round = ?", articleID, round).
+		Order("id ASC").
+		Find(&reviews).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to get review round", zap.Uint("article_id", articleID), zap.Int("round", round), zap.Error(err))
+		return nil, err
+	}
+
+	return reviews, nil
+}
+
+// GetDetailByID query dimulai dari posts supaya filter tenant ikut diterapkan,
+// article_reviews tidak punya tenant_id sendiri
+func (r *reviewRepository) GetDetailByID(ctx context.Context, articleID, id uint) (*domain.Review, error) {
+	var review domain.Review
+	if err := transaction.DB(ctx, r.DB).Table("posts").
+		Select("article_reviews.*").
+		Joins("JOIN article_reviews ON article_reviews.article_id = posts.id").
+		Where("posts.id = ? AND article_reviews.id = ?", articleID, id).
+		Take(&review).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			logger.FromContext(ctx).Warn("repository: review not found", zap.Uint("id", id))
+			return nil, dto.ErrReviewNotFound
+		}
+		logger.FromContext(ctx).Error("repository: failed to get review detail", zap.Uint("id", id), zap.Error(err))
+		return nil, err
+	}
+
+	return &review, nil
+}
+
+// LatestRound mengembalikan 0 jika artikel belum pernah di submit
+func (r *reviewRepository) LatestRound(ctx context.Context, articleID uint) (int, error) {
+	var round int
+	if err := transaction.DB(ctx, r.DB).Table("article_reviews").
+		Where("article_id = ?", articleID).
+		Select("COALESCE(MAX(round), 0)").
+		Scan(&round).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to get latest review round", zap.Uint("article_id", articleID), zap.Error(err))
+		return 0, err
+	}
+
+	return round, nil
+}
+
+// UpdateDecision hanya mengubah review yang masih pending, sehingga keputusan ganda ditolak
+func (r *reviewRepository) UpdateDecision(ctx context.Context, id uint, status, comment string) error {
+	logger.FromContext(ctx).Debug("repository: updating review decision", zap.Uint("id", id), zap.String("status", status))
+
+	result := transaction.DB(ctx, r.DB).Table("article_reviews").
+		Where("id = ? AND status = ?", id, string(domain.ReviewPending)).
+		Updates(map[string]any{
+			"status":       status,
+			"comment":      comment,
+			"decided_date": gorm.Expr("CURRENT_TIMESTAMP"),
+		})
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("repository: failed to update review decision", zap.Uint("id", id), zap.Error(result.Error))
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return dto.ErrReviewAlreadyClosed
+	}
+
+	return nil
+}
+
+func (r *reviewRepository) CancelPending(ctx context.Context, articleID uint) error {
+	logger.FromContext(ctx).Debug("repository: cancelling pending reviews", zap.Uint("article_id", articleID))
+
+	if err := transaction.DB(ctx, r.DB).Table("article_reviews").
+		Where("article_id = ? AND status = ?", articleID, string(domain.ReviewPending)).
+		Updates(map[string]any{
+			"status":       string(domain.ReviewCancelled),
+			"decided_date": gorm.Expr("CURRENT_TIMESTAMP"),
+		}).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to cancel pending reviews", zap.Uint("article_id", articleID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/tenant"
+	"go.uber.org/zap"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+func TestGetDetailByIDIsTenantScoped(t *testing.T) {
+	// DryRun hanya build SQL, tidak ada koneksi ke database
+	db, err := gorm.Open(mysql.New(mysql.Config{DSN: "user:pass@tcp(127.0.0.1:1)/test", SkipInitializeWithVersion: true}), &gorm.Config{
+		DryRun:                 true,
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to open dry run db: %v", err)
+	}
+	if err := tenant.RegisterScope(db, tenant.ScopedTables...); err != nil {
+		t.Fatalf("RegisterScope() error = %v", err)
+	}
+
+	var statements []string
+	if err := db.Callback().Query().After("gorm:query").Register("test:capture", func(tx *gorm.DB) {
+		statements = append(statements, tx.Statement.SQL.String())
+	}); err != nil {
+		t.Fatalf("register capture callback: %v", err)
+	}
+
+	repo := NewReviewRepository(db, zap.NewNop())
+	if _, err := repo.GetDetailByID(tenant.WithTenant(context.Background(), "brand-a"), 10, 1); err != nil {
+		t.Fatalf("GetDetailByID() error = %v", err)
+	}
+
+	if len(statements) != 1 {
+		t.Fatalf("got %d queries, want 1", len(statements))
+	}
+	for _, want := range []string{"`posts`.`tenant_id` = ?", "JOIN article_reviews ON article_reviews.article_id = posts.id", "posts.id = ? AND article_reviews.id = ?"} {
+		if !strings.Contains(statements[0], want) {
+			t.Errorf("query %q does not contain %q", statements[0], want)
+		}
+	}
+
+	// Tanpa tenant di context query ditolak, bukan membaca review tenant lain
+	if _, err := repo.GetDetailByID(context.Background(), 10, 1); err == nil {
+		t.Fatal("GetDetailByID() without tenant must fail")
+	}
+}
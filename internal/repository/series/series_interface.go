@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/series"
+)
+
+type SeriesRepository interface {
+	Create(ctx context.Context, series *domain.Series) error
+	GetList(ctx context.Context, seriesFilter *dto.SeriesFilter) ([]domain.Series, int64, error)
+	GetDetailByID(ctx context.Context, id uint) (*domain.Series, error)
+	GetBySlug(ctx context.Context, slug string) (*domain.Series, error)
+	UpdateByID(ctx context.Context, id uint, series *domain.Series) error
+	DeleteByID(ctx context.Context, id uint) error
+
+	// Membership
+	GetArticles(ctx context.Context, seriesID uint) ([]domain.Article, error)
+	SetArticles(ctx context.Context, seriesID uint, articleIDs []uint) error
+}
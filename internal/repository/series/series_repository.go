@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/series"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// Kolom yang boleh dipakai untuk sorting list series
+var seriesSortColumns = map[string]bool{
+	"created_date": true,
+	"updated_date": true,
+	"title":        true,
+}
+
+type seriesArticle struct {
+	SeriesID  uint
+	ArticleID uint
+	Position  int
+}
+
+type seriesRepository struct {
+	DB  *gorm.DB
+	log *zap.Logger
+}
+
+func NewSeriesRepository(DB *gorm.DB, log *zap.Logger) SeriesRepository {
+	return &seriesRepository{
+		DB:  DB,
+		log: log,
+	}
+}
+
+func (r *seriesRepository) Create(ctx context.Context, series *domain.Series) error {
+	logger.FromContext(ctx).Debug("repository: creating series", zap.String("title", series.Title))
+
+	if err := r.DB.WithContext(ctx).Table("series").Create(series).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to create series", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *seriesRepository) GetList(ctx context.Context, seriesFilter *dto.SeriesFilter) ([]domain.Series, int64, error) {
+	var series []domain.Series
+	var total int64
+
+	query := r.DB.WithContext(ctx).Table("series")
+
+	if seriesFilter.GetSearch() != "" {
+		query = query.Where("title LIKE ?", "%"+seriesFilter.GetSearch()+"%")
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to count series", zap.Error(err))
+		return nil, 0, err
+	}
+
+	sortBy := seriesFilter.GetSortBy()
+	if !seriesSortColumns[sortBy] {
+		sortBy = "created_date"
+	}
+
+	query = query.Order(sortBy + " " + seriesFilter.GetSortOrder()).
+		Offset(seriesFilter.GetOffset()).
+		Limit(seriesFilter.GetDefaultLimit())
+
+	if err := query.Find(&series).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to get series", zap.Error(err))
+		return nil, 0, err
+	}
+
+	return series, total, nil
+}
+
+func (r *seriesRepository) GetDetailByID(ctx context.Context, id uint) (*domain.Series, error) {
+	var series domain.Series
+	if err := r.DB.WithContext(ctx).Table("series").First(&series, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, dto.ErrSeriesNotFound
+		}
+		logger.FromContext(ctx).Error("repository: failed to get series detail", zap.Uint("id", id), zap.Error(err))
+		return nil, err
+	}
+
+	return &series, nil
+}
+
+func (r *seriesRepository) GetBySlug(ctx context.Context, slug string) (*domain.Series, error) {
+	var series domain.Series
+	if err := r.DB.WithContext(ctx).Table("series").Where("slug = ?", slug).First(&series).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, dto.ErrSeriesNotFound
+		}
+		logger.FromContext(ctx).Error("repository: failed to get series by slug", zap.String("slug", slug), zap.Error(err))
+		return nil, err
+	}
+
+	return &series, nil
+}
+
+func (r *seriesRepository) UpdateByID(ctx context.Context, id uint, series *domain.Series) error {
+	logger.FromContext(ctx).Debug("repository: updating series", zap.Uint("id", id))
+
+	result := r.DB.WithContext(ctx).Table("series").Where("id = ?", id).Updates(map[string]any{
+		"title":        series.Title,
+		"slug":         series.Slug,
+		"description":  series.Description,
+		"updated_date": series.UpdatedDate,
+	})
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("repository: failed to update series", zap.Uint("id", id), zap.Error(result.Error))
+		return result.Error
+	}
+
+	return nil
+}
+
+func (r *seriesRepository) DeleteByID(ctx context.Context, id uint) error {
+	logger.FromContext(ctx).Debug("repository: deleting series", zap.Uint("id", id))
+
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table("series_articles").Where("series_id = ?", id).Delete(&seriesArticle{}).Error; err != nil {
+			return err
+		}
+		return tx.Table("series").Delete(&domain.Series{}, id).Error
+	})
+	if err != nil {
+		logger.FromContext(ctx).Error("repository: failed to delete series", zap.Uint("id", id), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *seriesRepository) GetArticles(ctx context.Context, seriesID uint) ([]domain.Article, error) {
+	var articles []domain.Article
+	err := r.DB.WithContext(ctx).Table("posts").
+		Select("posts.id", "posts.title", "posts.slug", "posts.category", "posts.status", "posts.created_date", "posts.updated_date").
+		Joins("JOIN series_articles sa ON sa.article_id = posts.id").
+		Where("sa.series_id = ?", seriesID).
+		Order("sa.position ASC").
+		Find(&articles).Error
+	if err != nil {
+		logger.FromContext(ctx).Error("repository: failed to get series articles", zap.Uint("series_id", seriesID), zap.Error(err))
+		return nil, err
+	}
+
+	return articles, nil
+}
+
+// SetArticles replace semua anggota series sesuai urutan articleIDs
+func (r *seriesRepository) SetArticles(ctx context.Context, seriesID uint, articleIDs []uint) error {
+	logger.FromContext(ctx).Debug("repository: setting series articles", zap.Uint("series_id", seriesID), zap.Int("count", len(articleIDs)))
+
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table("series_articles").Where("series_id = ?", seriesID).Delete(&seriesArticle{}).Error; err != nil {
+			return err
+		}
+
+		if len(articleIDs) == 0 {
+			return nil
+		}
+
+		rows := make([]seriesArticle, len(articleIDs))
+		for i, articleID := range articleIDs {
+			rows[i] = seriesArticle{SeriesID: seriesID, ArticleID: articleID, Position: i + 1}
+		}
+		return tx.Table("series_articles").Create(&rows).Error
+	})
+	if err != nil {
+		logger.FromContext(ctx).Error("repository: failed to set series articles", zap.Uint("series_id", seriesID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
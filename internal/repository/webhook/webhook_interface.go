@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/webhook"
+)
+
+type WebhookRepository interface {
+	Create(ctx context.Context, subscription *domain.WebhookSubscription) error
+	GetList(ctx context.Context, webhookFilter *dto.WebhookFilter) ([]domain.WebhookSubscription, int64, error)
+	GetDetailByID(ctx context.Context, id uint) (*domain.WebhookSubscription, error)
+	GetActive(ctx context.Context) ([]domain.WebhookSubscription, error)
+	UpdateByID(ctx context.Context, id uint, subscription *domain.WebhookSubscription) error
+	DeleteByID(ctx context.Context, id uint) error
+
+	// Deliveries, delivery yang sama (subscription + event) hanya dibuat sekali
+	CreateDeliveries(ctx context.Context, deliveries []domain.WebhookDelivery) error
+	GetDeliveries(ctx context.Context, subscriptionID uint, deliveryFilter *dto.DeliveryFilter) ([]domain.WebhookDelivery, int64, error)
+	GetDelivery(ctx context.Context, subscriptionID, id uint) (*domain.WebhookDelivery, error)
+	FetchDueDeliveries(ctx context.Context, now time.Time, limit int) ([]domain.WebhookDelivery, error)
+	ClaimDelivery(ctx context.Context, delivery *domain.WebhookDelivery, leaseUntil time.Time) (bool, error)
+	UpdateDeliveryState(ctx context.Context, delivery *domain.WebhookDelivery) error
+	ResetDelivery(ctx context.Context, delivery *domain.WebhookDelivery) (bool, error)
+}
@@ -0,0 +1,279 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/webhook"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Panjang maksimal last_error yang disimpan
+const maxErrorLength = 1000
+
+// Kolom yang boleh dipakai untuk sorting
+var webhookSortColumns = map[string]bool{
+	"created_date": true,
+	"updated_date": true,
+}
+
+// webhook_subscriptions dan webhook_deliveries di scope tenant lewat callback gorm.
+// Worker membaca antrian semua tenant dengan context tenant.WithAllTenants
+type webhookRepository struct {
+	DB  *gorm.DB
+	log *zap.Logger
+}
+
+func NewWebhookRepository(DB *gorm.DB, log *zap.Logger) WebhookRepository {
+	return &webhookRepository{
+		DB:  DB,
+		log: log,
+	}
+}
+
+func (r *webhookRepository) Create(ctx context.Context, subscription *domain.WebhookSubscription) error {
+	logger.FromContext(ctx).Debug("repository: creating webhook", zap.String("url", subscription.URL))
+
+	if err := r.DB.WithContext(ctx).Table("webhook_subscriptions").Create(subscription).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to create webhook", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *webhookRepository) GetList(ctx context.Context, webhookFilter *dto.WebhookFilter) ([]domain.WebhookSubscription, int64, error) {
+	var subscriptions []domain.WebhookSubscription
+	var total int64
+
+	query := r.DB.WithContext(ctx).Table("webhook_subscriptions")
+
+	if webhookFilter.GetSearch() != "" {
+		query = query.Where("url LIKE ?", "%"+webhookFilter.GetSearch()+"%")
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to count webhooks", zap.Error(err))
+		return nil, 0, err
+	}
+
+	sortBy := webhookFilter.GetSortBy()
+	if !webhookSortColumns[sortBy] {
+		sortBy = "created_date"
+	}
+
+	query = query.Order(sortBy + " " + webhookFilter.GetSortOrder()).
+		Offset(webhookFilter.GetOffset()).
+		Limit(webhookFilter.GetDefaultLimit())
+
+	if err := query.Find(&subscriptions).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to get webhooks", zap.Error(err))
+		return nil, 0, err
+	}
+
+	return subscriptions, total, nil
+}
+
+func (r *webhookRepository) GetDetailByID(ctx context.Context, id uint) (*domain.WebhookSubscription, error) {
+	var subscription domain.WebhookSubscription
+	if err := r.DB.WithContext(ctx).Table("webhook_subscriptions").First(&subscription, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, dto.ErrWebhookNotFound
+		}
+		logger.FromContext(ctx).Error("repository: failed to get webhook detail", zap.Uint("id", id), zap.Error(err))
+		return nil, err
+	}
+
+	return &subscription, nil
+}
+
+func (r *webhookRepository) GetActive(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	var subscriptions []domain.WebhookSubscription
+	if err := r.DB.WithContext(ctx).Table("webhook_subscriptions").Where("active = ?", true).Find(&subscriptions).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to get active webhooks", zap.Error(err))
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+func (r *webhookRepository) UpdateByID(ctx context.Context, id uint, subscription *domain.WebhookSubscription) error {
+	logger.FromContext(ctx).Debug("repository: updating webhook", zap.Uint("id", id))
+
+	// Select supaya active = false tetap ikut di update
+	if err := r.DB.WithContext(ctx).Table("webhook_subscriptions").Where("id = ?", id).
+		Select("url", "event_types", "secret", "active", "updated_date").
+		Updates(subscription).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to update webhook", zap.Uint("id", id), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *webhookRepository) DeleteByID(ctx context.Context, id uint) error {
+	logger.FromContext(ctx).Debug("repository: deleting webhook", zap.Uint("id", id))
+
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table("webhook_deliveries").Where("subscription_id = ?", id).Delete(&domain.WebhookDelivery{}).Error; err != nil {
+			return err
+		}
+		return tx.Table("webhook_subscriptions").Delete(&domain.WebhookSubscription{}, id).Error
+	})
+	if err != nil {
+		logger.FromContext(ctx).Error("repository: failed to delete webhook", zap.Uint("id", id), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *webhookRepository) CreateDeliveries(ctx context.Context, deliveries []domain.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	// Event yang dikirim ulang oleh outbox relay tidak membuat delivery baru
+	if err := r.DB.WithContext(ctx).Table("webhook_deliveries").
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&deliveries).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to create webhook deliveries", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *webhookRepository) GetDeliveries(ctx context.Context, subscriptionID uint, deliveryFilter *dto.DeliveryFilter) ([]domain.WebhookDelivery, int64, error) {
+	var deliveries []domain.WebhookDelivery
+	var total int64
+
+	query := r.DB.WithContext(ctx).Table("webhook_deliveries").Where("subscription_id = ?", subscriptionID)
+
+	if deliveryFilter.Filters.Status != "" {
+		query = query.Where("status = ?", deliveryFilter.Filters.Status)
+	}
+
+	if deliveryFilter.Filters.EventType != "" {
+		query = query.Where("event_type = ?", deliveryFilter.Filters.EventType)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to count webhook deliveries", zap.Error(err))
+		return nil, 0, err
+	}
+
+	sortBy := deliveryFilter.GetSortBy()
+	if !webhookSortColumns[sortBy] {
+		sortBy = "created_date"
+	}
+
+	query = query.Order(sortBy + " " + deliveryFilter.GetSortOrder()).
+		Order("id " + deliveryFilter.GetSortOrder()).
+		Offset(deliveryFilter.GetOffset()).
+		Limit(deliveryFilter.GetDefaultLimit())
+
+	if err := query.Find(&deliveries).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to get webhook deliveries", zap.Error(err))
+		return nil, 0, err
+	}
+
+	return deliveries, total, nil
+}
+
+func (r *webhookRepository) GetDelivery(ctx context.Context, subscriptionID, id uint) (*domain.WebhookDelivery, error) {
+	var delivery domain.WebhookDelivery
+	if err := r.DB.WithContext(ctx).Table("webhook_deliveries").
+		Where("subscription_id = ?", subscriptionID).
+		First(&delivery, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, dto.ErrDeliveryNotFound
+		}
+		logger.FromContext(ctx).Error("repository: failed to get webhook delivery", zap.Uint("id", id), zap.Error(err))
+		return nil, err
+	}
+
+	return &delivery, nil
+}
+
+func (r *webhookRepository) FetchDueDeliveries(ctx context.Context, now time.Time, limit int) ([]domain.WebhookDelivery, error) {
+	var deliveries []domain.WebhookDelivery
+	if err := r.DB.WithContext(ctx).Table("webhook_deliveries").
+		Where("status = ? AND next_attempt_at <= ?", domain.DeliveryPending, now).
+		Order("next_attempt_at ASC").
+		Limit(limit).
+		Find(&deliveries).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to fetch due webhook deliveries", zap.Error(err))
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// ClaimDelivery sama seperti claim di outbox, attempts dipakai sebagai versi supaya
+// satu delivery tidak dikirim dua worker sekaligus
+func (r *webhookRepository) ClaimDelivery(ctx context.Context, delivery *domain.WebhookDelivery, leaseUntil time.Time) (bool, error) {
+	result := r.DB.WithContext(ctx).Table("webhook_deliveries").
+		Where("id = ? AND attempts = ? AND status = ?", delivery.ID, delivery.Attempts, domain.DeliveryPending).
+		Updates(map[string]any{
+			"attempts":        delivery.Attempts + 1,
+			"next_attempt_at": leaseUntil,
+		})
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("repository: failed to claim webhook delivery", zap.Uint("id", delivery.ID), zap.Error(result.Error))
+		return false, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	delivery.Attempts++
+	delivery.NextAttemptAt = leaseUntil
+	return true, nil
+}
+
+// UpdateDeliveryState simpan hasil percobaan kirim
+func (r *webhookRepository) UpdateDeliveryState(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	if len(delivery.LastError) > maxErrorLength {
+		delivery.LastError = delivery.LastError[:maxErrorLength]
+	}
+
+	if err := r.DB.WithContext(ctx).Table("webhook_deliveries").Where("id = ?", delivery.ID).Updates(map[string]any{
+		"status":           delivery.Status,
+		"next_attempt_at":  delivery.NextAttemptAt,
+		"last_status_code": delivery.LastStatusCode,
+		"last_error":       delivery.LastError,
+		"delivered_at":     delivery.DeliveredAt,
+		"updated_date":     delivery.UpdatedDate,
+	}).Error; err != nil {
+		logger.FromContext(ctx).Error("repository: failed to update webhook delivery", zap.Uint("id", delivery.ID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// ResetDelivery antrikan ulang delivery dengan jatah retry penuh, return false kalau delivery masih pending
+func (r *webhookRepository) ResetDelivery(ctx context.Context, delivery *domain.WebhookDelivery) (bool, error) {
+	result := r.DB.WithContext(ctx).Table("webhook_deliveries").
+		Where("id = ? AND status <> ?", delivery.ID, domain.DeliveryPending).
+		Updates(map[string]any{
+			"status":          delivery.Status,
+			"attempts":        delivery.Attempts,
+			"next_attempt_at": delivery.NextAttemptAt,
+			"delivered_at":    delivery.DeliveredAt,
+			"updated_date":    delivery.UpdatedDate,
+		})
+	if result.Error != nil {
+		logger.FromContext(ctx).Error("repository: failed to reset webhook delivery", zap.Uint("id", delivery.ID), zap.Error(result.Error))
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,24 @@
+package routes
+
+import (
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/handler"
+	middleware "github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/middlewares"
+	"github.com/gofiber/fiber/v2"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+// AdminRoutes endpoint operasional, tidak terikat tenant jadi di daftarkan sebelum middleware tenant
+func AdminRoutes(
+	router fiber.Router,
+	config *viper.Viper,
+	level zap.AtomicLevel,
+	log *zap.Logger,
+) {
+	logLevelHandler := handler.NewLogLevelHandler(level, log)
+
+	admin := router.Group("/admin", middleware.NewAdminMiddleware(config.GetString("ADMIN_TOKEN"), log))
+
+	admin.Get("/log-level", logLevelHandler.Get)
+	admin.Put("/log-level", logLevelHandler.Update)
+}
@@ -3,29 +3,77 @@ package routes
 import (
 	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/handler"
 	repository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/article"
+	auditRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/audit"
+	mediaRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/media"
+	outboxRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/outbox"
+	seriesRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/series"
 	usecase "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/usecase/article"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/events"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/presence"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/transaction"
 	"github.com/gofiber/fiber/v2"
+	"github.com/spf13/viper"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 )
 
+// ArticleRoutes return usecase supaya router bisa menghentikan import job saat shutdown
 func ArticleRoutes(
 	router fiber.Router,
+	config *viper.Viper,
 	DB *gorm.DB,
+	presenceHub *presence.Hub,
 	log *zap.Logger,
-) {
+) usecase.ArticleUsecase {
 	// Depedency Injection
 	articleRepo := repository.NewArticleRepository(DB, log)
-	articleUsecase := usecase.NewArticleUsecase(articleRepo, log)
-	articleHandler := handler.NewArticleHandler(articleUsecase, log)
+	mediaRepo := mediaRepository.NewMediaRepository(DB, log)
+	seriesRepo := seriesRepository.NewSeriesRepository(DB, log)
+	auditRepo := auditRepository.NewAuditRepository(DB, log)
+	outboxRepo := outboxRepository.NewOutboxRepository(DB, log)
+	articleUsecase := usecase.NewArticleUsecase(articleRepo, mediaRepo, seriesRepo, auditRepo, outboxRepo, transaction.NewTransactor(DB), changeNotifier(presenceHub), config, log)
+	articleHandler := handler.NewArticleHandler(articleUsecase, config, log)
 
 	// Routes
 	articles := router.Group("/article")
 
 	articles.Get("/", articleHandler.GetList)
 	articles.Post("/", articleHandler.Create)
+	articles.Post("/import", articleHandler.Import)
+	articles.Get("/import/:job_id", articleHandler.GetImportJob)
 	articles.Get("/:article_id", articleHandler.GetDetailByID)
+	articles.Get("/:article_id/related", articleHandler.GetRelated)
+	articles.Get("/:article_id/translations", articleHandler.GetTranslations)
+	articles.Post("/:article_id/translations", articleHandler.CreateTranslation)
 	articles.Put("/:article_id", articleHandler.UpdateByID)
 	articles.Delete("/:article_id", articleHandler.DeleteByID)
 
+	// Presence editor lewat websocket, nonaktif kalau presence backend gagal di init
+	if presenceHub != nil {
+		presenceHandler := handler.NewArticlePresenceHandler(articleUsecase, presenceHub, config, log)
+		articles.Get("/:article_id/presence", presenceHandler.Upgrade, presenceHandler.Connect())
+	}
+
+	return articleUsecase
+}
+
+// changeNotifier hindari interface berisi nil pointer ketika presence tidak aktif
+func changeNotifier(presenceHub *presence.Hub) usecase.ChangeNotifier {
+	if presenceHub == nil {
+		return nil
+	}
+
+	return presenceHub
+}
+
+// ArticleStreamRoutes harus di daftarkan sebelum ArticleRoutes supaya /article/stream tidak tertangkap /:article_id
+func ArticleStreamRoutes(
+	router fiber.Router,
+	config *viper.Viper,
+	stream *events.Stream,
+	log *zap.Logger,
+) {
+	streamHandler := handler.NewArticleStreamHandler(stream, config, log)
+
+	router.Get("/article/stream", streamHandler.Stream)
 }
@@ -0,0 +1,30 @@
+package routes
+
+import (
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/handler"
+	repository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/audit"
+	usecase "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/usecase/audit"
+	middleware "github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/middlewares"
+	"github.com/gofiber/fiber/v2"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+func AuditRoutes(
+	router fiber.Router,
+	config *viper.Viper,
+	DB *gorm.DB,
+	log *zap.Logger,
+) {
+	// Depedency Injection
+	auditRepo := repository.NewAuditRepository(DB, log)
+	auditUsecase := usecase.NewAuditUsecase(auditRepo, log)
+	auditHandler := handler.NewAuditHandler(auditUsecase, log)
+
+	// Audit log hanya untuk moderator / compliance
+	audit := router.Group("/audit", middleware.NewModeratorMiddleware(config.GetString("MODERATOR_TOKEN"), log))
+
+	audit.Get("/", auditHandler.GetList)
+	audit.Get("/export", auditHandler.Export)
+}
@@ -0,0 +1,39 @@
+package routes
+
+import (
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/handler"
+	articleRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/article"
+	repository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/comment"
+	usecase "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/usecase/comment"
+	middleware "github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/middlewares"
+	"github.com/gofiber/fiber/v2"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+func CommentRoutes(
+	router fiber.Router,
+	config *viper.Viper,
+	DB *gorm.DB,
+	log *zap.Logger,
+) {
+	// Depedency Injection
+	commentRepo := repository.NewCommentRepository(DB, log)
+	articleRepo := articleRepository.NewArticleRepository(DB, log)
+	commentUsecase := usecase.NewCommentUsecase(commentRepo, articleRepo, log)
+	commentHandler := handler.NewCommentHandler(commentUsecase, log)
+
+	// Public routes
+	articles := router.Group("/article")
+
+	articles.Get("/:article_id/comments", commentHandler.GetThreads)
+	articles.Post("/:article_id/comments", commentHandler.Create)
+
+	// Moderator routes
+	comments := router.Group("/comments", middleware.NewModeratorMiddleware(config.GetString("MODERATOR_TOKEN"), log))
+
+	comments.Get("/", commentHandler.GetModerationQueue)
+	comments.Put("/:comment_id/approve", commentHandler.Approve)
+	comments.Put("/:comment_id/reject", commentHandler.Reject)
+}
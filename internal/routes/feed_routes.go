@@ -0,0 +1,42 @@
+package routes
+
+import (
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/handler"
+	repository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/article"
+	auditRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/audit"
+	mediaRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/media"
+	outboxRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/outbox"
+	seriesRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/series"
+	usecase "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/usecase/article"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/transaction"
+	"github.com/gofiber/fiber/v2"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+func FeedRoutes(
+	router fiber.Router,
+	config *viper.Viper,
+	DB *gorm.DB,
+	log *zap.Logger,
+) {
+	// Depedency Injection
+	articleRepo := repository.NewArticleRepository(DB, log)
+	mediaRepo := mediaRepository.NewMediaRepository(DB, log)
+	seriesRepo := seriesRepository.NewSeriesRepository(DB, log)
+	auditRepo := auditRepository.NewAuditRepository(DB, log)
+	outboxRepo := outboxRepository.NewOutboxRepository(DB, log)
+	articleUsecase := usecase.NewArticleUsecase(articleRepo, mediaRepo, seriesRepo, auditRepo, outboxRepo, transaction.NewTransactor(DB), nil, config, log)
+	feedHandler := handler.NewFeedHandler(articleUsecase, config, log)
+
+	// Routes
+	feeds := router.Group("/feeds")
+
+	feeds.Get("/rss.xml", feedHandler.RSS)
+	feeds.Get("/atom.xml", feedHandler.Atom)
+	feeds.Get("/feed.json", feedHandler.JSON)
+	feeds.Get("/category/:name/rss.xml", feedHandler.CategoryRSS)
+	feeds.Get("/category/:name/atom.xml", feedHandler.CategoryAtom)
+	feeds.Get("/category/:name/feed.json", feedHandler.CategoryJSON)
+}
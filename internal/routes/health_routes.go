@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/handler"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/health"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// Path probe, dipakai juga oleh middleware untuk melewati tracing dan metrics
+const (
+	LivenessPath  = "/healthz"
+	ReadinessPath = "/readyz"
+)
+
+// HealthRoutes probe untuk kubernetes, harus di daftarkan sebelum middleware tenant
+// karena probe tidak membawa header tenant
+func HealthRoutes(router fiber.Router, checker *health.Checker, log *zap.Logger) {
+	healthHandler := handler.NewHealthHandler(checker, log)
+
+	router.Get(LivenessPath, healthHandler.Liveness)
+	router.Get(ReadinessPath, healthHandler.Readiness)
+}
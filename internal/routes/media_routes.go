@@ -0,0 +1,32 @@
+package routes
+
+import (
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/handler"
+	repository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/media"
+	usecase "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/usecase/media"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/storage"
+	"github.com/gofiber/fiber/v2"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+func MediaRoutes(
+	router fiber.Router,
+	config *viper.Viper,
+	DB *gorm.DB,
+	mediaStorage storage.Storage,
+	log *zap.Logger,
+) {
+	// Depedency Injection
+	mediaRepo := repository.NewMediaRepository(DB, log)
+	mediaUsecase := usecase.NewMediaUsecase(mediaRepo, mediaStorage, config, log)
+	mediaHandler := handler.NewMediaHandler(mediaUsecase, log)
+
+	// Routes
+	media := router.Group("/media")
+
+	media.Post("/", mediaHandler.Upload)
+	media.Get("/:media_id", mediaHandler.GetDetailByID)
+	media.Delete("/:media_id", mediaHandler.DeleteByID)
+}
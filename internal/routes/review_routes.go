@@ -0,0 +1,41 @@
+package routes
+
+import (
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/handler"
+	articleRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/article"
+	repository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/review"
+	article "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/usecase/article"
+	usecase "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/usecase/review"
+	middleware "github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/middlewares"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/notifier"
+	"github.com/gofiber/fiber/v2"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+func ReviewRoutes(
+	router fiber.Router,
+	config *viper.Viper,
+	DB *gorm.DB,
+	articleUsecase article.ArticleUsecase,
+	reviewNotifier notifier.Notifier,
+	log *zap.Logger,
+) {
+	// Depedency Injection
+	reviewRepo := repository.NewReviewRepository(DB, log)
+	articleRepo := articleRepository.NewArticleRepository(DB, log)
+	reviewUsecase := usecase.NewReviewUsecase(reviewRepo, articleRepo, articleUsecase, reviewNotifier, log)
+	reviewHandler := handler.NewReviewHandler(reviewUsecase, log)
+
+	// Routes
+	articles := router.Group("/article")
+
+	articles.Get("/:article_id/reviews", reviewHandler.GetByArticle)
+
+	// Submit dan keputusan review butuh JWT editor, author / reviewer diambil dari claim token
+	editor := middleware.NewEditorMiddleware(config.GetString("EDITOR_JWT_SECRET"), config.GetString("EDITOR_JWT_CLAIM"), log)
+	articles.Post("/:article_id/reviews", editor, reviewHandler.Submit)
+	articles.Post("/:article_id/reviews/:review_id/approve", editor, reviewHandler.Approve)
+	articles.Post("/:article_id/reviews/:review_id/request-changes", editor, reviewHandler.RequestChanges)
+}
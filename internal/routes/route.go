@@ -1,8 +1,20 @@
 package routes
 
 import (
+	"context"
+	"errors"
 	"time"
 
+	outboxRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/outbox"
+	webhookRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/webhook"
+	outboxUsecase "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/usecase/outbox"
+	webhookUsecase "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/usecase/webhook"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/events"
+	middleware "github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/middlewares"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/notifier"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/presence"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/storage"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/tenant"
 	"github.com/gofiber/fiber/v2"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
@@ -14,6 +26,11 @@ type Router struct {
 	config *viper.Viper
 	log    *zap.Logger
 	DB     *gorm.DB
+
+	bus     *events.Bus                       // domain event in-process dari outbox relay
+	stream  *events.Stream                    // replay buffer untuk SSE, dibuat di SetupRoutes
+	hub     *presence.Hub                     // presence editor per artikel, nil kalau backend gagal di init
+	closers []func(ctx context.Context) error // worker background yang di stop saat shutdown
 }
 
 func NewRouter(
@@ -27,11 +44,20 @@ func NewRouter(
 		config: config,
 		log:    log,
 		DB:     DB,
+		bus:    events.NewBus(),
 	}
 }
 
 // Simple Routes
 func (r *Router) SetupRoutes(api fiber.Router, config *viper.Viper, log *zap.Logger, DB *gorm.DB) {
+	// Tenant harus di resolve sebelum route manapun, termasuk feed & sitemap di luar /api.
+	// Feed & sitemap dibaca publik tanpa token tenant, tenant dari subdomain atau default
+	r.app.Use(middleware.NewTenantMiddleware(tenant.NewResolver(r.config), r.log, "/feeds/", "/sitemap.xml", "/sitemaps/"))
+	r.app.Use(middleware.NewAuditMiddleware(r.config.GetString("AUDIT_ACTOR_HEADER")))
+
+	// Logger per request untuk handler, usecase dan repository lewat logger.FromContext
+	r.app.Use(middleware.NewContextLoggerMiddleware(r.log))
+
 	// Root Endpoint
 	api.Get("/", r.RootHandler)
 
@@ -39,7 +65,135 @@ func (r *Router) SetupRoutes(api fiber.Router, config *viper.Viper, log *zap.Log
 	apiV1 := api.Group("/v1")
 	apiV1.Get("/", r.RootHandler)
 
-	ArticleRoutes(apiV1, r.DB, r.log)
+	// Domain event artikel ditulis ke outbox lalu dikirim relay ke OUTBOX_PUBLISHERS
+	busPublished := r.startOutboxRelay()
+	r.startWebhookWorker()
+
+	// Live update artikel lewat SSE, event diambil dari bus instance ini. Tanpa relay yang publish ke bus
+	// stream tidak pernah menerima event, endpoint menjawab 503 daripada koneksi yang diam selamanya
+	if busPublished {
+		r.stream = events.NewStream(r.bus, r.config.GetInt("SSE_REPLAY_SIZE"))
+	} else {
+		r.log.Warn("article stream disabled, outbox relay does not publish to the bus on this instance")
+	}
+	ArticleStreamRoutes(apiV1, r.config, r.stream, r.log)
+
+	// Presence editor, state dibagi antar replica lewat PRESENCE_BACKEND
+	r.startPresenceHub()
+
+	articleUsecase := ArticleRoutes(apiV1, r.config, r.DB, r.hub, r.log)
+	r.closers = append(r.closers, articleUsecase.Close)
+	CommentRoutes(apiV1, r.config, r.DB, r.log)
+	SeriesRoutes(apiV1, r.config, r.DB, r.log)
+	AuditRoutes(apiV1, r.config, r.DB, r.log)
+	WebhookRoutes(apiV1, r.config, r.DB, r.log)
+
+	// Editorial review, notifikasi dikirim lewat driver di NOTIFIER_DRIVERS
+	reviewNotifier, err := notifier.NewNotifier(r.config, r.log)
+	if err != nil {
+		r.log.Error("failed to init notifier, review routes disabled", zap.Error(err))
+	} else {
+		ReviewRoutes(apiV1, r.config, r.DB, articleUsecase, reviewNotifier, r.log)
+	}
+
+	// Media upload, storage driver dipilih dari STORAGE_DRIVER
+	mediaStorage, err := storage.NewStorage(r.config, r.log)
+	if err != nil {
+		r.log.Error("failed to init media storage, media routes disabled", zap.Error(err))
+	} else {
+		MediaRoutes(apiV1, r.config, r.DB, mediaStorage, r.log)
+
+		// File dari local storage di serve langsung oleh fiber
+		if local, ok := mediaStorage.(*storage.LocalStorage); ok {
+			r.app.Static(local.URLPrefix(), local.BaseDir(), fiber.Static{
+				ModifyResponse: func(c *fiber.Ctx) error {
+					c.Set(fiber.HeaderXContentTypeOptions, "nosniff")
+					return nil
+				},
+			})
+		}
+	}
+
+	// Public feeds & sitemap, di luar prefix /api
+	FeedRoutes(r.app, r.config, r.DB, r.log)
+	SitemapRoutes(r.app, r.config, r.DB, r.log)
+}
+
+// startOutboxRelay true kalau relay jalan dan publish ke bus, syarat stream SSE menerima event
+func (r *Router) startOutboxRelay() bool {
+	if r.config.IsSet("OUTBOX_RELAY_ENABLED") && !r.config.GetBool("OUTBOX_RELAY_ENABLED") {
+		r.log.Info("outbox relay disabled, events stay in outbox until a relay runs")
+		return false
+	}
+
+	publisher, err := events.NewPublisher(r.config, r.log, r.bus)
+	if err != nil {
+		r.log.Error("failed to init outbox publisher, outbox relay disabled", zap.Error(err))
+		return false
+	}
+
+	// Event juga di fan-out ke webhook subscription, pengirimannya oleh delivery worker
+	webhookDispatcher := webhookUsecase.NewWebhookDispatcher(webhookRepository.NewWebhookRepository(r.DB, r.log), r.log)
+	busPublished := events.Includes(publisher, r.bus)
+	publisher = events.Multi{publisher, webhookDispatcher}
+
+	relay := outboxUsecase.NewOutboxRelay(outboxRepository.NewOutboxRepository(r.DB, r.log), publisher, r.config, r.log)
+	relay.Start()
+	r.closers = append(r.closers, relay.Stop)
+
+	return busPublished
+}
+
+func (r *Router) startWebhookWorker() {
+	if r.config.IsSet("WEBHOOK_WORKER_ENABLED") && !r.config.GetBool("WEBHOOK_WORKER_ENABLED") {
+		r.log.Info("webhook delivery worker disabled")
+		return
+	}
+
+	worker := webhookUsecase.NewDeliveryWorker(webhookRepository.NewWebhookRepository(r.DB, r.log), r.config, r.log)
+	worker.Start()
+	r.closers = append(r.closers, worker.Stop)
+}
+
+func (r *Router) startPresenceHub() {
+	backend, err := presence.NewBackend(r.config, r.log)
+	if err != nil {
+		r.log.Error("failed to init presence backend, presence disabled", zap.Error(err))
+		return
+	}
+
+	hub, err := presence.NewHub(backend, r.config, r.log)
+	if err != nil {
+		r.log.Error("failed to init presence hub, presence disabled", zap.Error(err))
+		return
+	}
+	r.hub = hub
+}
+
+// CloseStreams putus semua koneksi SSE dan websocket, dipanggil sebelum server shutdown karena
+// koneksi streaming tidak pernah selesai sendiri dan akan menahan shutdown sampai timeout
+func (r *Router) CloseStreams() {
+	if r.stream != nil {
+		r.stream.Close()
+	}
+
+	if r.hub != nil {
+		if err := r.hub.Close(); err != nil {
+			r.log.Warn("failed to close presence hub", zap.Error(err))
+		}
+	}
+}
+
+// Close stop semua worker background, dipanggil setelah server berhenti menerima request
+func (r *Router) Close(ctx context.Context) error {
+	var errs []error
+	for _, closer := range r.closers {
+		if err := closer(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
 }
 
 func (r *Router) RootHandler(c *fiber.Ctx) error {
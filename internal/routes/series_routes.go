@@ -0,0 +1,37 @@
+package routes
+
+import (
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/handler"
+	articleRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/article"
+	repository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/series"
+	usecase "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/usecase/series"
+	"github.com/gofiber/fiber/v2"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+func SeriesRoutes(
+	router fiber.Router,
+	config *viper.Viper,
+	DB *gorm.DB,
+	log *zap.Logger,
+) {
+	// Depedency Injection
+	seriesRepo := repository.NewSeriesRepository(DB, log)
+	articleRepo := articleRepository.NewArticleRepository(DB, log)
+	seriesUsecase := usecase.NewSeriesUsecase(seriesRepo, articleRepo, log)
+	seriesHandler := handler.NewSeriesHandler(seriesUsecase, log)
+
+	// Routes
+	series := router.Group("/series")
+
+	series.Get("/", seriesHandler.GetList)
+	series.Post("/", seriesHandler.Create)
+	series.Get("/:series_id", seriesHandler.GetDetailByID)
+	series.Put("/:series_id", seriesHandler.UpdateByID)
+	series.Delete("/:series_id", seriesHandler.DeleteByID)
+	series.Post("/:series_id/articles", seriesHandler.AddArticle)
+	series.Put("/:series_id/articles", seriesHandler.Reorder)
+	series.Delete("/:series_id/articles/:article_id", seriesHandler.RemoveArticle)
+}
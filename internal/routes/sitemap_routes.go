@@ -0,0 +1,36 @@
+package routes
+
+import (
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/handler"
+	repository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/article"
+	auditRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/audit"
+	mediaRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/media"
+	outboxRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/outbox"
+	seriesRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/series"
+	usecase "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/usecase/article"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/transaction"
+	"github.com/gofiber/fiber/v2"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+func SitemapRoutes(
+	router fiber.Router,
+	config *viper.Viper,
+	DB *gorm.DB,
+	log *zap.Logger,
+) {
+	// Depedency Injection
+	articleRepo := repository.NewArticleRepository(DB, log)
+	mediaRepo := mediaRepository.NewMediaRepository(DB, log)
+	seriesRepo := seriesRepository.NewSeriesRepository(DB, log)
+	auditRepo := auditRepository.NewAuditRepository(DB, log)
+	outboxRepo := outboxRepository.NewOutboxRepository(DB, log)
+	articleUsecase := usecase.NewArticleUsecase(articleRepo, mediaRepo, seriesRepo, auditRepo, outboxRepo, transaction.NewTransactor(DB), nil, config, log)
+	sitemapHandler := handler.NewSitemapHandler(articleUsecase, config, log)
+
+	// Routes
+	router.Get("/sitemap.xml", sitemapHandler.Sitemap)
+	router.Get("/sitemaps/sitemap-:page.xml", sitemapHandler.Page)
+}
@@ -0,0 +1,35 @@
+package routes
+
+import (
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/handler"
+	repository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/webhook"
+	usecase "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/usecase/webhook"
+	middleware "github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/middlewares"
+	"github.com/gofiber/fiber/v2"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+func WebhookRoutes(
+	router fiber.Router,
+	config *viper.Viper,
+	DB *gorm.DB,
+	log *zap.Logger,
+) {
+	// Depedency Injection
+	webhookRepo := repository.NewWebhookRepository(DB, log)
+	webhookUsecase := usecase.NewWebhookUsecase(webhookRepo, config, log)
+	webhookHandler := handler.NewWebhookHandler(webhookUsecase, log)
+
+	// Subscription menyimpan secret partner, hanya untuk moderator
+	webhooks := router.Group("/webhooks", middleware.NewModeratorMiddleware(config.GetString("MODERATOR_TOKEN"), log))
+
+	webhooks.Get("/", webhookHandler.GetList)
+	webhooks.Post("/", webhookHandler.Create)
+	webhooks.Get("/:webhook_id", webhookHandler.GetDetailByID)
+	webhooks.Put("/:webhook_id", webhookHandler.UpdateByID)
+	webhooks.Delete("/:webhook_id", webhookHandler.DeleteByID)
+	webhooks.Get("/:webhook_id/deliveries", webhookHandler.GetDeliveries)
+	webhooks.Post("/:webhook_id/deliveries/:delivery_id/redeliver", webhookHandler.Redeliver)
+}
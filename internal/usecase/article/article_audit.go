@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/audit"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// articleSnapshot bentuk artikel di audit log dan payload domain event. content_html, excerpt
+// dan metadata lain tidak ikut karena semuanya turunan dari content
+type articleSnapshot struct {
+	ID            uint       `json:"id"`
+	Title         string     `json:"title"`
+	Slug          string     `json:"slug"`
+	Content       string     `json:"content"`
+	ContentFormat string     `json:"content_format"`
+	Category      string     `json:"category"`
+	Status        string     `json:"status"`
+	CoverMediaID  *uint      `json:"cover_media_id"`
+	AttachmentIDs []uint     `json:"attachment_ids"`
+	ApprovedAt    *time.Time `json:"approved_at"`
+	CreatedDate   time.Time  `json:"created_date"`
+	UpdatedDate   time.Time  `json:"updated_date"`
+}
+
+// snapshotArticle harus dipanggil sebelum artikel di ubah, hasilnya sudah berupa JSON
+func snapshotArticle(article *domain.Article) json.RawMessage {
+	if article == nil {
+		return nil
+	}
+
+	snapshot, _ := json.Marshal(articleSnapshot{
+		ID:            article.ID,
+		Title:         article.Title,
+		Slug:          article.Slug,
+		Content:       article.Content,
+		ContentFormat: article.ContentFormat,
+		Category:      article.Category,
+		Status:        article.Status,
+		CoverMediaID:  article.CoverMediaID,
+		AttachmentIDs: mediaIDs(article.Attachments),
+		ApprovedAt:    article.ApprovedAt,
+		CreatedDate:   article.CreatedDate,
+		UpdatedDate:   article.UpdatedDate,
+	})
+
+	return snapshot
+}
+
+// recordAudit catat perubahan artikel beserta actor, request ID dan IP dari context.
+// Harus dipanggil di dalam transaction yang sama dengan write ke posts, gagal menulis audit membatalkan perubahan
+func (u *articleUsecase) recordAudit(ctx context.Context, action domain.AuditAction, id uint, before, after json.RawMessage) error {
+	metadata := audit.FromContext(ctx)
+	entry := &domain.AuditLog{
+		Actor:       metadata.Actor,
+		RequestID:   metadata.RequestID,
+		IP:          metadata.IP,
+		Action:      string(action),
+		Entity:      domain.AuditEntityArticle,
+		EntityID:    id,
+		Before:      before,
+		After:       after,
+		CreatedDate: time.Now(),
+	}
+
+	if err := u.repoAudit.Create(ctx, entry); err != nil {
+		logger.FromContext(ctx).Error("failed to record audit log",
+			zap.String("actor", entry.Actor),
+			zap.String("action", entry.Action),
+			zap.Uint("entity_id", id),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	auditRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/audit"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/audit"
+	"go.uber.org/zap"
+)
+
+type recordingAuditRepository struct {
+	auditRepository.AuditRepository
+	entries []*domain.AuditLog
+	err     error
+}
+
+func (f *recordingAuditRepository) Create(_ context.Context, entry *domain.AuditLog) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.entries = append(f.entries, entry)
+	return nil
+}
+
+func TestRecordAudit(t *testing.T) {
+	before := json.RawMessage(`{"id":1,"title":"old"}`)
+	after := json.RawMessage(`{"id":1,"title":"new"}`)
+
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		repoErr       error
+		wantActor     string
+		wantRequestID string
+		wantIP        string
+	}{
+		{
+			name:          "metadata from request",
+			ctx:           audit.WithMetadata(context.Background(), audit.Metadata{Actor: "alice", RequestID: "req-1", IP: "10.0.0.1"}),
+			wantActor:     "alice",
+			wantRequestID: "req-1",
+			wantIP:        "10.0.0.1",
+		},
+		{name: "without metadata is anonymous", ctx: context.Background(), wantActor: audit.AnonymousActor},
+		{name: "repository error is returned", ctx: context.Background(), repoErr: errors.New("insert failed")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &recordingAuditRepository{err: tt.repoErr}
+			uc := &articleUsecase{repoAudit: repo, log: zap.NewNop()}
+
+			// Error harus sampai ke caller supaya transaction perubahan artikel ikut di rollback
+			err := uc.recordAudit(tt.ctx, domain.AuditUpdate, 1, before, after)
+			if !errors.Is(err, tt.repoErr) {
+				t.Fatalf("recordAudit() error = %v, want %v", err, tt.repoErr)
+			}
+			if tt.repoErr != nil {
+				return
+			}
+
+			if len(repo.entries) != 1 {
+				t.Fatalf("got %d entries, want 1", len(repo.entries))
+			}
+			entry := repo.entries[0]
+			if entry.Actor != tt.wantActor || entry.RequestID != tt.wantRequestID || entry.IP != tt.wantIP {
+				t.Errorf("entry metadata = %q %q %q, want %q %q %q", entry.Actor, entry.RequestID, entry.IP, tt.wantActor, tt.wantRequestID, tt.wantIP)
+			}
+			if entry.Action != "update" || entry.Entity != domain.AuditEntityArticle || entry.EntityID != 1 || entry.CreatedDate.IsZero() {
+				t.Errorf("entry = %+v", entry)
+			}
+			if string(entry.Before) != string(before) || string(entry.After) != string(after) {
+				t.Errorf("entry before / after = %s / %s", entry.Before, entry.After)
+			}
+		})
+	}
+}
+
+func TestSnapshotArticle(t *testing.T) {
+	if got := snapshotArticle(nil); got != nil {
+		t.Fatalf("snapshotArticle(nil) = %s, want nil", got)
+	}
+
+	article := &domain.Article{
+		ID:          1,
+		Title:       "Title",
+		Content:     "**content**",
+		ContentHTML: "<p><strong>content</strong></p>",
+		Excerpt:     "content",
+		Status:      string(domain.StatusDraft),
+		Attachments: []domain.Media{{ID: 3}, {ID: 5}},
+	}
+
+	var snapshot map[string]any
+	if err := json.Unmarshal(snapshotArticle(article), &snapshot); err != nil {
+		t.Fatalf("snapshot is not valid JSON: %v", err)
+	}
+
+	if snapshot["title"] != "Title" || snapshot["content"] != "**content**" || snapshot["status"] != "Draft" {
+		t.Errorf("snapshot = %v", snapshot)
+	}
+	if !reflect.DeepEqual(snapshot["attachment_ids"], []any{3.0, 5.0}) {
+		t.Errorf("attachment_ids = %v, want [3 5]", snapshot["attachment_ids"])
+	}
+	for _, key := range []string{"content_html", "excerpt"} {
+		if _, ok := snapshot[key]; ok {
+			t.Errorf("snapshot contains derived field %q", key)
+		}
+	}
+}
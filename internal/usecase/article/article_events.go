@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/audit"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/events"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/tenant"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// appendEvents tulis domain event ke outbox. Harus dipanggil di dalam transaction yang sama
+// dengan write ke posts, sehingga event hanya ada kalau perubahannya ter-commit
+func (u *articleUsecase) appendEvents(ctx context.Context, id uint, payload json.RawMessage, eventTypes ...string) error {
+	tenantID, _ := tenant.FromContext(ctx)
+	now := time.Now()
+
+	for _, eventType := range eventTypes {
+		event := &domain.OutboxEvent{
+			EventID:       uuid.NewString(),
+			TenantID:      tenantID,
+			EventType:     eventType,
+			AggregateType: events.AggregateArticle,
+			AggregateID:   id,
+			Payload:       payload,
+			OccurredAt:    now,
+			NextAttemptAt: now,
+		}
+
+		if err := u.repoOutbox.Append(ctx, event); err != nil {
+			logger.FromContext(ctx).Error("failed to append article event", zap.Uint("id", id), zap.String("event", eventType), zap.Error(err))
+			return err
+		}
+	}
+
+	return nil
+}
+
+// notifyChanged kabari editor yang sedang membuka artikel, dipanggil setelah commit
+func (u *articleUsecase) notifyChanged(ctx context.Context, article *domain.Article) {
+	if u.changes == nil {
+		return
+	}
+
+	u.changes.ArticleChanged(ctx, article.ID, audit.FromContext(ctx).Actor, article.UpdatedDate)
+}
@@ -0,0 +1,373 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/article"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/audit"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/events"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/tenant"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Job yang sudah selesai lebih lama dari ini akan dibuang dari memory
+const importJobRetention = 24 * time.Hour
+
+type importJobStore struct {
+	mu   sync.RWMutex
+	jobs map[string]*dto.ImportJob
+
+	// ctx induk semua job, di cancel saat shutdown. running menunggu job yang masih berjalan
+	ctx     context.Context
+	cancel  context.CancelFunc
+	running sync.WaitGroup
+}
+
+func newImportJobStore() *importJobStore {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &importJobStore{
+		jobs:   make(map[string]*dto.ImportJob),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+func (s *importJobStore) add(job *dto.ImportJob) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Cleanup job lama supaya map tidak terus membesar
+	for id, existing := range s.jobs {
+		if existing.FinishedAt != nil && time.Since(*existing.FinishedAt) > importJobRetention {
+			delete(s.jobs, id)
+		}
+	}
+
+	s.jobs[job.ID] = job
+}
+
+func (s *importJobStore) update(id string, fn func(job *dto.ImportJob)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if job, ok := s.jobs[id]; ok {
+		fn(job)
+	}
+}
+
+func (s *importJobStore) get(id string) (*dto.ImportJob, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	job, ok := s.jobs[id]
+	if !ok {
+		return nil, false
+	}
+
+	// Return copy supaya caller tidak race dengan worker
+	copied := *job
+	return &copied, true
+}
+
+func (u *articleUsecase) Import(ctx context.Context, payload *dto.ImportPayload, dryRun bool) (*dto.ImportReport, error) {
+	logger.FromContext(ctx).Info("importing articles", zap.Int("rows", payload.Len()), zap.Bool("dry_run", dryRun))
+
+	if payload.Len() == 0 {
+		return nil, dto.ErrImportEmpty
+	}
+
+	report := &dto.ImportReport{
+		DryRun: dryRun,
+		Total:  payload.Len(),
+		Failed: len(payload.ParseErrors),
+		Errors: append([]dto.ImportLineError{}, payload.ParseErrors...),
+	}
+
+	keys := newImportKeys()
+	for _, row := range payload.Rows {
+		if err := ctx.Err(); err != nil {
+			logger.FromContext(ctx).Warn("import cancelled", zap.Error(err))
+			return report, err
+		}
+
+		created, err := u.importRow(ctx, row, dryRun, keys)
+		if err != nil {
+			report.AddError(row.Line, err)
+			continue
+		}
+
+		if created {
+			report.Created++
+		} else {
+			report.Updated++
+		}
+	}
+
+	sort.SliceStable(report.Errors, func(i, j int) bool {
+		return report.Errors[i].Line < report.Errors[j].Line
+	})
+
+	logger.FromContext(ctx).Info("articles imported",
+		zap.Bool("dry_run", dryRun),
+		zap.Int("total", report.Total),
+		zap.Int("created", report.Created),
+		zap.Int("updated", report.Updated),
+		zap.Int("failed", report.Failed),
+	)
+
+	return report, nil
+}
+
+// importRow upsert satu baris berdasarkan slug lalu title, return true kalau baris dibuat baru
+func (u *articleUsecase) importRow(ctx context.Context, row dto.ImportArticleRow, dryRun bool, keys *importKeys) (bool, error) {
+	if err := row.Validate(); err != nil {
+		return false, err
+	}
+
+	// Dry run hanya cek database, jadi duplikat di dalam file yang sama harus di cek di sini
+	// supaya hasil dry run sama dengan import sebenarnya
+	if err := keys.claim(row); err != nil {
+		return false, err
+	}
+
+	existing, err := u.findImportTarget(ctx, row)
+	if err != nil {
+		return false, err
+	}
+
+	if existing == nil {
+		if dryRun {
+			return true, nil
+		}
+
+		article := &domain.Article{
+			Title:         row.Title,
+			Slug:          row.Slug,
+			Content:       row.Content,
+			ContentFormat: row.ContentFormat,
+			Category:      row.Category,
+			Status:        row.Status,
+		}
+		if _, err := u.create(ctx, article); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	// Import melewati aturan review yang sama dengan update biasa
+	contentChanged := existing.Title != row.Title || existing.Content != row.Content ||
+		(row.ContentFormat != "" && row.ContentFormat != existing.ContentFormat)
+	resetApproval, err := u.checkReview(ctx, existing, contentChanged, row.Status)
+	if err != nil {
+		return false, err
+	}
+
+	// Title baru tidak boleh bentrok dengan article lain
+	if existing.Title != row.Title {
+		other, err := u.repoArticle.GetByTitle(ctx, row.Title)
+		if err != nil && !errors.Is(err, dto.ErrArticleNotFound) {
+			return false, dto.ErrFailedUpdateArticle.Wrap(err)
+		}
+		if other != nil && other.ID != existing.ID {
+			return false, dto.ErrArticleExists
+		}
+	}
+
+	if dryRun {
+		return false, nil
+	}
+
+	if err := u.loadMedia(ctx, existing); err != nil {
+		logger.FromContext(ctx).Warn("failed to load article media", zap.Uint("id", existing.ID), zap.Error(err))
+	}
+	before := snapshotArticle(existing)
+	wasPublished := existing.IsPublished()
+
+	existing.Title = row.Title
+	existing.Content = row.Content
+	existing.Category = row.Category
+	existing.Status = row.Status
+	if row.ContentFormat != "" {
+		existing.ContentFormat = row.ContentFormat
+	}
+	existing.EnsureSlug()
+
+	if err := u.renderContent(existing); err != nil {
+		logger.FromContext(ctx).Error("failed to render imported article", zap.Uint("id", existing.ID), zap.Error(err))
+		return false, dto.ErrFailedUpdateArticle.Wrap(err)
+	}
+	existing.UpdatedDate = time.Now()
+
+	err = u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := u.repoArticle.UpdateByID(ctx, existing.ID, existing); err != nil {
+			logger.FromContext(ctx).Error("failed to update imported article", zap.Uint("id", existing.ID), zap.Error(err))
+			return err
+		}
+
+		if resetApproval {
+			if err := u.repoArticle.UpdateReviewState(ctx, existing.ID, existing.Status, nil); err != nil {
+				logger.FromContext(ctx).Error("failed to reset imported article approval", zap.Uint("id", existing.ID), zap.Error(err))
+				return err
+			}
+			existing.ApprovedAt = nil
+		}
+
+		eventTypes := []string{events.ArticleUpdated}
+		if existing.IsPublished() && !wasPublished {
+			eventTypes = append(eventTypes, events.ArticlePublished)
+		}
+		after := snapshotArticle(existing)
+		if err := u.appendEvents(ctx, existing.ID, after, eventTypes...); err != nil {
+			return err
+		}
+		return u.recordAudit(ctx, domain.AuditUpdate, existing.ID, before, after)
+	})
+	if err != nil {
+		return false, dto.ErrFailedUpdateArticle.Wrap(err)
+	}
+	u.related.invalidate()
+	u.notifyChanged(ctx, existing)
+
+	return false, nil
+}
+
+// importKeys title dan slug yang sudah dipakai baris sebelumnya di file yang sama
+type importKeys struct {
+	titles map[string]struct{}
+	slugs  map[string]struct{}
+}
+
+func newImportKeys() *importKeys {
+	return &importKeys{
+		titles: make(map[string]struct{}),
+		slugs:  make(map[string]struct{}),
+	}
+}
+
+// claim catat title dan slug baris, error kalau salah satunya sudah dipakai baris lain
+func (k *importKeys) claim(row dto.ImportArticleRow) error {
+	slug := row.Slug
+	if slug == "" {
+		slug = domain.Slugify(row.Title)
+	}
+
+	if _, ok := k.titles[row.Title]; ok {
+		return dto.ErrImportDuplicateRow
+	}
+	if _, ok := k.slugs[slug]; ok {
+		return dto.ErrImportDuplicateRow
+	}
+
+	k.titles[row.Title] = struct{}{}
+	k.slugs[slug] = struct{}{}
+	return nil
+}
+
+func (u *articleUsecase) findImportTarget(ctx context.Context, row dto.ImportArticleRow) (*domain.Article, error) {
+	if row.Slug != "" {
+		existing, err := u.repoArticle.GetBySlug(ctx, row.Slug)
+		if err == nil {
+			return existing, nil
+		}
+		if !errors.Is(err, dto.ErrArticleNotFound) {
+			return nil, dto.ErrFailedCreateArticle.Wrap(err)
+		}
+	}
+
+	existing, err := u.repoArticle.GetByTitle(ctx, row.Title)
+	if err != nil {
+		if errors.Is(err, dto.ErrArticleNotFound) {
+			return nil, nil
+		}
+		return nil, dto.ErrFailedCreateArticle.Wrap(err)
+	}
+
+	return existing, nil
+}
+
+func (u *articleUsecase) StartImportJob(ctx context.Context, payload *dto.ImportPayload, dryRun bool) *dto.ImportJob {
+	tenantID, _ := tenant.FromContext(ctx)
+	job := &dto.ImportJob{
+		ID:        uuid.New().String(),
+		TenantID:  tenantID,
+		Status:    dto.ImportJobPending,
+		DryRun:    dryRun,
+		TotalRows: payload.Len(),
+		CreatedAt: time.Now(),
+	}
+	u.importJobs.add(job)
+
+	logger.FromContext(ctx).Info("import job queued", zap.String("job_id", job.ID), zap.Int("rows", payload.Len()))
+
+	// Job berjalan di luar lifecycle request, jadi pakai context sendiri yang ikut di cancel saat shutdown,
+	// dengan tenant, logger dan metadata audit (actor, request ID, IP) dari request yang memulai job
+	jobCtx := tenant.WithTenant(u.importJobs.ctx, tenantID)
+	jobCtx = logger.WithLogger(jobCtx, logger.FromContext(ctx))
+	jobCtx = audit.WithMetadata(jobCtx, audit.FromContext(ctx))
+
+	u.importJobs.running.Add(1)
+	go func() {
+		defer u.importJobs.running.Done()
+
+		u.importJobs.update(job.ID, func(j *dto.ImportJob) {
+			j.Status = dto.ImportJobRunning
+		})
+
+		report, err := u.Import(jobCtx, payload, dryRun)
+		finishedAt := time.Now()
+
+		u.importJobs.update(job.ID, func(j *dto.ImportJob) {
+			j.Report = report
+			j.FinishedAt = &finishedAt
+			j.Status = dto.ImportJobCompleted
+			if err != nil {
+				j.Status = dto.ImportJobFailed
+				j.Error = err.Error()
+			}
+		})
+
+		logger.FromContext(jobCtx).Info("import job finished", zap.String("job_id", job.ID), zap.Error(err))
+	}()
+
+	queued, _ := u.importJobs.get(job.ID)
+	return queued
+}
+
+// Close cancel import job yang masih berjalan lalu tunggu sampai selesai, baris yang belum di proses
+// tidak di import dan job tercatat failed
+func (u *articleUsecase) Close(ctx context.Context) error {
+	u.importJobs.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		u.importJobs.running.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		logger.FromContext(ctx).Info("import jobs stopped")
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (u *articleUsecase) GetImportJob(ctx context.Context, id string) (*dto.ImportJob, error) {
+	// Job tenant lain diperlakukan seperti tidak ada
+	tenantID, _ := tenant.FromContext(ctx)
+	job, ok := u.importJobs.get(id)
+	if !ok || job.TenantID != tenantID {
+		return nil, dto.ErrImportJobNotFound
+	}
+
+	return job, nil
+}
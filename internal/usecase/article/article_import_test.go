@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/article"
+	repository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/article"
+	auditRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/audit"
+	mediaRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/media"
+	outboxRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/outbox"
+	"go.uber.org/zap"
+)
+
+// fakeArticleRepository satu artikel di memory, hanya method yang dipakai import
+type fakeArticleRepository struct {
+	repository.ArticleRepository
+	article      *domain.Article
+	updated      bool
+	reviewStates []*time.Time
+}
+
+func (f *fakeArticleRepository) GetBySlug(_ context.Context, slug string) (*domain.Article, error) {
+	if f.article == nil || f.article.Slug != slug {
+		return nil, dto.ErrArticleNotFound
+	}
+	copied := *f.article
+	return &copied, nil
+}
+
+func (f *fakeArticleRepository) GetByTitle(_ context.Context, title string) (*domain.Article, error) {
+	if f.article == nil || f.article.Title != title {
+		return nil, dto.ErrArticleNotFound
+	}
+	copied := *f.article
+	return &copied, nil
+}
+
+func (f *fakeArticleRepository) UpdateByID(_ context.Context, _ uint, article *domain.Article) error {
+	f.updated = true
+	f.article.Title = article.Title
+	f.article.Content = article.Content
+	f.article.Status = article.Status
+	return nil
+}
+
+func (f *fakeArticleRepository) UpdateReviewState(_ context.Context, _ uint, status string, approvedAt *time.Time) error {
+	f.reviewStates = append(f.reviewStates, approvedAt)
+	f.article.Status = status
+	f.article.ApprovedAt = approvedAt
+	return nil
+}
+
+type fakeMediaRepository struct {
+	mediaRepository.MediaRepository
+}
+
+func (fakeMediaRepository) GetAttachments(context.Context, uint) ([]domain.Media, error) {
+	return nil, nil
+}
+
+type fakeOutboxRepository struct {
+	outboxRepository.OutboxRepository
+	events []string
+}
+
+func (f *fakeOutboxRepository) Append(_ context.Context, event *domain.OutboxEvent) error {
+	f.events = append(f.events, event.EventType)
+	return nil
+}
+
+type fakeAuditRepository struct {
+	auditRepository.AuditRepository
+}
+
+func (fakeAuditRepository) Create(context.Context, *domain.AuditLog) error {
+	return nil
+}
+
+type fakeTransactor struct{}
+
+func (fakeTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestImportRowReviewRules(t *testing.T) {
+	approvedAt := time.Now().Add(-time.Hour)
+	const content = "original article content"
+
+	tests := []struct {
+		name        string
+		status      domain.ArticleStatus
+		approved    bool
+		rowContent  string
+		rowStatus   domain.ArticleStatus
+		wantErr     error
+		wantStatus  domain.ArticleStatus
+		wantApprove bool
+		wantReset   bool
+	}{
+		{name: "content change while in review is rejected", status: domain.StatusInReview, rowContent: "rewritten article content", rowStatus: domain.StatusDraft, wantErr: dto.ErrArticleInReview},
+		{name: "unapproved draft cannot be published", status: domain.StatusDraft, rowContent: content, rowStatus: domain.StatusPublish, wantErr: dto.ErrReviewRequired},
+		{name: "changed content cannot be published on old approval", status: domain.StatusDraft, approved: true, rowContent: "rewritten article content", rowStatus: domain.StatusPublish, wantErr: dto.ErrReviewRequired},
+		{name: "content change resets approval", status: domain.StatusDraft, approved: true, rowContent: "rewritten article content", rowStatus: domain.StatusDraft, wantStatus: domain.StatusDraft, wantReset: true},
+		{name: "approved draft can be published", status: domain.StatusDraft, approved: true, rowContent: content, rowStatus: domain.StatusPublish, wantStatus: domain.StatusPublish, wantApprove: true},
+		{name: "published article stays editable", status: domain.StatusPublish, approved: true, rowContent: "rewritten article content", rowStatus: domain.StatusPublish, wantStatus: domain.StatusPublish, wantApprove: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			article := &domain.Article{
+				ID:            1,
+				Title:         "Imported article",
+				Slug:          "imported-article",
+				Content:       content,
+				ContentFormat: string(domain.FormatMarkdown),
+				Category:      "News",
+				Status:        string(tt.status),
+			}
+			if tt.approved {
+				article.ApprovedAt = &approvedAt
+			}
+
+			repo := &fakeArticleRepository{article: article}
+			u := &articleUsecase{
+				repoArticle:    repo,
+				repoMedia:      fakeMediaRepository{},
+				repoAudit:      fakeAuditRepository{},
+				repoOutbox:     &fakeOutboxRepository{},
+				transactor:     fakeTransactor{},
+				related:        newRelatedCache(0),
+				reviewRequired: true,
+				log:            zap.NewNop(),
+			}
+
+			row := dto.ImportArticleRow{
+				Line: 1,
+				Slug: article.Slug,
+				CreateArticleRequest: dto.CreateArticleRequest{
+					Title:    article.Title,
+					Content:  tt.rowContent,
+					Category: article.Category,
+					Status:   string(tt.rowStatus),
+				},
+			}
+
+			created, err := u.importRow(context.Background(), row, false, newImportKeys())
+			if created {
+				t.Fatal("existing article reported as created")
+			}
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				if repo.updated || len(repo.reviewStates) > 0 {
+					t.Fatal("rejected row must not change the article")
+				}
+				if repo.article.Content != content || repo.article.Status != string(tt.status) {
+					t.Fatalf("article changed to content %q status %q", repo.article.Content, repo.article.Status)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("importRow: %v", err)
+			}
+			if repo.article.Status != string(tt.wantStatus) {
+				t.Fatalf("status = %q, want %q", repo.article.Status, tt.wantStatus)
+			}
+			if got := repo.article.IsApproved(); got != tt.wantApprove {
+				t.Fatalf("approved = %v, want %v", got, tt.wantApprove)
+			}
+			if reset := len(repo.reviewStates) == 1 && repo.reviewStates[0] == nil; reset != tt.wantReset {
+				t.Fatalf("approval reset = %v, want %v", reset, tt.wantReset)
+			}
+		})
+	}
+}
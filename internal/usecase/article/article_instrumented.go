@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/article"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/apperror"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/metrics"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Label usecase di metric blog_usecase_operations_total
+const metricsUsecase = "article"
+
+// instrumentedArticleUsecase buat span dan hitung outcome setiap operasi ArticleUsecase
+type instrumentedArticleUsecase struct {
+	next ArticleUsecase
+}
+
+func withInstrumentation(next ArticleUsecase) ArticleUsecase {
+	return &instrumentedArticleUsecase{next: next}
+}
+
+// instrument buka span ArticleUsecase.<method>, fungsi yang dikembalikan mencatat outcome dan menutup span
+func instrument(ctx context.Context, method, operation string) (context.Context, func(error)) {
+	ctx, span := tracing.Start(ctx, "ArticleUsecase."+method)
+
+	return ctx, func(err error) {
+		outcome := outcomeOf(err)
+		metrics.ObserveOperation(metricsUsecase, operation, outcome)
+
+		// Error bisnis (rejected) bukan kegagalan, span hanya di tandai error untuk outcome error
+		span.SetAttributes(attribute.String("usecase.outcome", outcome))
+		if outcome == metrics.OutcomeError {
+			tracing.End(span, err)
+			return
+		}
+		span.End()
+	}
+}
+
+// outcomeOf error bisnis (validasi, not found, conflict) di hitung rejected, sisanya error
+func outcomeOf(err error) string {
+	if err == nil {
+		return metrics.OutcomeSuccess
+	}
+
+	if apperror.KindOf(err) == apperror.KindInternal {
+		return metrics.OutcomeError
+	}
+
+	return metrics.OutcomeRejected
+}
+
+func (m *instrumentedArticleUsecase) Create(ctx context.Context, article *domain.Article) (*domain.Article, error) {
+	ctx, done := instrument(ctx, "Create", "create")
+	created, err := m.next.Create(ctx, article)
+	done(err)
+	return created, err
+}
+
+func (m *instrumentedArticleUsecase) GetList(ctx context.Context, filter *dto.ArticleFilter) ([]domain.Article, int64, error) {
+	ctx, done := instrument(ctx, "GetList", "get_list")
+	articles, total, err := m.next.GetList(ctx, filter)
+	done(err)
+	return articles, total, err
+}
+
+func (m *instrumentedArticleUsecase) GetDetailByID(ctx context.Context, id uint, lang string) (*domain.Article, error) {
+	ctx, done := instrument(ctx, "GetDetailByID", "get_detail")
+	article, err := m.next.GetDetailByID(ctx, id, lang)
+	done(err)
+	return article, err
+}
+
+func (m *instrumentedArticleUsecase) UpdateByID(ctx context.Context, id uint, updateReq *dto.UpdateArticleRequest) (*domain.Article, error) {
+	ctx, done := instrument(ctx, "UpdateByID", "update")
+	article, err := m.next.UpdateByID(ctx, id, updateReq)
+	done(err)
+	return article, err
+}
+
+func (m *instrumentedArticleUsecase) DeleteByID(ctx context.Context, id uint) error {
+	ctx, done := instrument(ctx, "DeleteByID", "delete")
+	err := m.next.DeleteByID(ctx, id)
+	done(err)
+	return err
+}
+
+func (m *instrumentedArticleUsecase) GetRelated(ctx context.Context, id uint, limit int) ([]domain.Article, error) {
+	ctx, done := instrument(ctx, "GetRelated", "get_related")
+	articles, err := m.next.GetRelated(ctx, id, limit)
+	done(err)
+	return articles, err
+}
+
+func (m *instrumentedArticleUsecase) LoadSeriesNavigation(ctx context.Context, article *domain.Article, seriesID uint) error {
+	ctx, done := instrument(ctx, "LoadSeriesNavigation", "load_series_navigation")
+	err := m.next.LoadSeriesNavigation(ctx, article, seriesID)
+	done(err)
+	return err
+}
+
+func (m *instrumentedArticleUsecase) CreateTranslation(ctx context.Context, articleID uint, translation *domain.ArticleTranslation) (*domain.ArticleTranslation, error) {
+	ctx, done := instrument(ctx, "CreateTranslation", "create_translation")
+	created, err := m.next.CreateTranslation(ctx, articleID, translation)
+	done(err)
+	return created, err
+}
+
+func (m *instrumentedArticleUsecase) GetTranslations(ctx context.Context, articleID uint) ([]domain.ArticleTranslation, error) {
+	ctx, done := instrument(ctx, "GetTranslations", "get_translations")
+	translations, err := m.next.GetTranslations(ctx, articleID)
+	done(err)
+	return translations, err
+}
+
+func (m *instrumentedArticleUsecase) CountPublished(ctx context.Context) (int64, error) {
+	ctx, done := instrument(ctx, "CountPublished", "count_published")
+	total, err := m.next.CountPublished(ctx)
+	done(err)
+	return total, err
+}
+
+func (m *instrumentedArticleUsecase) StreamPublished(ctx context.Context, offset, limit int, fn func([]domain.Article) error) error {
+	ctx, done := instrument(ctx, "StreamPublished", "stream_published")
+	err := m.next.StreamPublished(ctx, offset, limit, fn)
+	done(err)
+	return err
+}
+
+func (m *instrumentedArticleUsecase) Import(ctx context.Context, payload *dto.ImportPayload, dryRun bool) (*dto.ImportReport, error) {
+	ctx, done := instrument(ctx, "Import", "import")
+	report, err := m.next.Import(ctx, payload, dryRun)
+	done(err)
+	return report, err
+}
+
+func (m *instrumentedArticleUsecase) StartImportJob(ctx context.Context, payload *dto.ImportPayload, dryRun bool) *dto.ImportJob {
+	// Job berjalan di background dengan context sendiri, span hanya mencakup pembuatan job
+	ctx, done := instrument(ctx, "StartImportJob", "start_import_job")
+	job := m.next.StartImportJob(ctx, payload, dryRun)
+	done(nil)
+	return job
+}
+
+func (m *instrumentedArticleUsecase) GetImportJob(ctx context.Context, id string) (*dto.ImportJob, error) {
+	ctx, done := instrument(ctx, "GetImportJob", "get_import_job")
+	job, err := m.next.GetImportJob(ctx, id)
+	done(err)
+	return job, err
+}
+
+func (m *instrumentedArticleUsecase) TransitionReview(ctx context.Context, id uint, apply func(ctx context.Context, article *domain.Article) (*domain.ReviewTransition, error)) (*domain.Article, error) {
+	ctx, done := instrument(ctx, "TransitionReview", "transition_review")
+	article, err := m.next.TransitionReview(ctx, id, apply)
+	done(err)
+	return article, err
+}
+
+// Close bukan operasi bisnis, tidak di trace dan tidak di hitung
+func (m *instrumentedArticleUsecase) Close(ctx context.Context) error {
+	return m.next.Close(ctx)
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/article"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/apperror"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/metrics"
+)
+
+func TestOutcomeOf(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{name: "success", want: metrics.OutcomeSuccess},
+		{name: "validation error", err: dto.ErrTitleRequired, want: metrics.OutcomeRejected},
+		{name: "wrapped not found", err: fmt.Errorf("find: %w", apperror.NotFound("NOT_FOUND", "article not found")), want: metrics.OutcomeRejected},
+		{name: "conflict", err: apperror.Conflict("CONFLICT", "slug already used"), want: metrics.OutcomeRejected},
+		{name: "internal error", err: apperror.Internal("INTERNAL", "failed"), want: metrics.OutcomeError},
+		// Error di luar apperror dianggap kegagalan sistem, bukan penolakan input
+		{name: "plain error", err: errors.New("connection reset"), want: metrics.OutcomeError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := outcomeOf(tt.err); got != tt.want {
+				t.Errorf("outcomeOf() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
 	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/article"
@@ -10,7 +11,34 @@ import (
 type ArticleUsecase interface {
 	Create(ctx context.Context, article *domain.Article) (*domain.Article, error)
 	GetList(ctx context.Context, filter *dto.ArticleFilter) ([]domain.Article, int64, error)
-	GetDetailByID(ctx context.Context, id uint) (*domain.Article, error)
+	GetDetailByID(ctx context.Context, id uint, lang string) (*domain.Article, error)
 	UpdateByID(ctx context.Context, id uint, updateReq *dto.UpdateArticleRequest) (*domain.Article, error)
 	DeleteByID(ctx context.Context, id uint) error
+	GetRelated(ctx context.Context, id uint, limit int) ([]domain.Article, error)
+	LoadSeriesNavigation(ctx context.Context, article *domain.Article, seriesID uint) error
+
+	// Translations
+	CreateTranslation(ctx context.Context, articleID uint, translation *domain.ArticleTranslation) (*domain.ArticleTranslation, error)
+	GetTranslations(ctx context.Context, articleID uint) ([]domain.ArticleTranslation, error)
+
+	// Published listing untuk sitemap
+	CountPublished(ctx context.Context) (int64, error)
+	StreamPublished(ctx context.Context, offset, limit int, fn func([]domain.Article) error) error
+
+	// Bulk import
+	Import(ctx context.Context, payload *dto.ImportPayload, dryRun bool) (*dto.ImportReport, error)
+	StartImportJob(ctx context.Context, payload *dto.ImportPayload, dryRun bool) *dto.ImportJob
+	GetImportJob(ctx context.Context, id string) (*dto.ImportJob, error)
+
+	// Review workflow, keputusan review dan status artikel disimpan dalam satu transaction
+	TransitionReview(ctx context.Context, id uint, apply func(ctx context.Context, article *domain.Article) (*domain.ReviewTransition, error)) (*domain.Article, error)
+
+	// Close stop import job yang masih berjalan, dipanggil saat shutdown
+	Close(ctx context.Context) error
+}
+
+// ChangeNotifier di kabari setelah update artikel berhasil, dipakai presence untuk memberi tahu
+// editor lain bahwa artikel yang sedang dibuka sudah berubah
+type ChangeNotifier interface {
+	ArticleChanged(ctx context.Context, articleID uint, actor string, updatedAt time.Time)
 }
@@ -0,0 +1,236 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/article"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/similarity"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/tenant"
+	"go.uber.org/zap"
+)
+
+const (
+	DefaultRelatedLimit = 5
+	MaxRelatedLimit     = 20
+
+	// Bobot ranking, total 1
+	relatedWeightText     = 0.7
+	relatedWeightCategory = 0.2
+	relatedWeightRecency  = 0.1
+
+	// Skor recency turun setengah setiap 30 hari
+	relatedRecencyHalfLife = 30 * 24 * time.Hour
+
+	// Title dihitung beberapa kali supaya lebih berpengaruh dari body
+	relatedTitleBoost = 3
+
+	defaultRelatedCacheTTL = 10 * time.Minute
+)
+
+// relatedCache simpan index TF-IDF per tenant. Setiap request membandingkan versi artikel published
+// (jumlah dan updated_date terbaru) dengan versi saat index dibangun, sehingga perubahan dari jalur
+// manapun dan dari replica lain langsung terlihat. TTL hanya batas atas umur index
+type relatedCache struct {
+	mu      sync.Mutex // hanya menjaga map tenants, bukan proses build
+	ttl     time.Duration
+	tenants map[string]*relatedEntry
+}
+
+// relatedEntry lock per tenant, build index satu tenant tidak menahan request tenant lain
+type relatedEntry struct {
+	mu    sync.Mutex
+	index *relatedIndex
+}
+
+// relatedIndex semua artikel published satu tenant beserta hasil ranking per artikel
+type relatedIndex struct {
+	builtAt  time.Time
+	version  domain.ArticleSetVersion
+	index    *similarity.Index
+	articles map[uint]domain.Article
+	results  map[uint][]domain.Article
+}
+
+func newRelatedCache(ttl time.Duration) *relatedCache {
+	if ttl <= 0 {
+		ttl = defaultRelatedCacheTTL
+	}
+
+	return &relatedCache{ttl: ttl, tenants: make(map[string]*relatedEntry)}
+}
+
+func (c *relatedCache) entry(tenantID string) *relatedEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.tenants[tenantID]
+	if !ok {
+		entry = &relatedEntry{}
+		c.tenants[tenantID] = entry
+	}
+
+	return entry
+}
+
+// invalidate buang index semua tenant setelah write di instance ini, tanpa menunggu cek versi
+func (c *relatedCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.tenants = make(map[string]*relatedEntry)
+}
+
+func (idx *relatedIndex) fresh(version domain.ArticleSetVersion, ttl time.Duration) bool {
+	return idx != nil && time.Since(idx.builtAt) <= ttl && idx.version.Equal(version)
+}
+
+func (u *articleUsecase) GetRelated(ctx context.Context, id uint, limit int) ([]domain.Article, error) {
+	logger.FromContext(ctx).Info("getting related articles", zap.Uint("id", id), zap.Int("limit", limit))
+
+	if limit < 1 {
+		limit = DefaultRelatedLimit
+	}
+	if limit > MaxRelatedLimit {
+		limit = MaxRelatedLimit
+	}
+
+	version, err := u.repoArticle.VersionByStatus(ctx, string(domain.StatusPublish))
+	if err != nil {
+		logger.FromContext(ctx).Error("failed to get published articles version", zap.Error(err))
+		return nil, err
+	}
+
+	tenantID, _ := tenant.FromContext(ctx)
+	entry := u.related.entry(tenantID)
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	idx := entry.index
+	if !idx.fresh(version, u.related.ttl) {
+		built, err := u.buildRelatedIndex(ctx, version)
+		if err != nil {
+			logger.FromContext(ctx).Error("failed to build related index", zap.Error(err))
+			return nil, err
+		}
+		idx = built
+		entry.index = idx
+	}
+
+	// Related hanya untuk artikel published
+	if _, ok := idx.articles[id]; !ok {
+		return nil, dto.ErrArticleNotFound
+	}
+
+	ranked, ok := idx.results[id]
+	if !ok {
+		ranked = idx.rank(id)
+		idx.results[id] = ranked
+	}
+
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+
+	return append([]domain.Article(nil), ranked...), nil
+}
+
+// buildRelatedIndex dipanggil dengan lock tenant sudah dipegang, repository otomatis
+// hanya membaca artikel milik tenant di ctx
+func (u *articleUsecase) buildRelatedIndex(ctx context.Context, version domain.ArticleSetVersion) (*relatedIndex, error) {
+	articles := make(map[uint]domain.Article)
+	docs := make(map[uint][]string)
+
+	err := u.repoArticle.StreamContentByStatus(ctx, string(domain.StatusPublish), streamBatchSize, func(batch []domain.Article) error {
+		for _, article := range batch {
+			terms := similarity.Tokenize(domain.StripMarkup(article.Content))
+			titleTerms := similarity.Tokenize(article.Title)
+			for i := 0; i < relatedTitleBoost; i++ {
+				terms = append(terms, titleTerms...)
+			}
+			docs[article.ID] = terms
+
+			if article.WordCount == 0 && article.Content != "" {
+				article.ComputeMetadata(u.excerptLength)
+			}
+
+			// Content tidak perlu disimpan, response related hanya listing
+			article.Content = ""
+			articles[article.ID] = article
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Debug("related index built", zap.Int("articles", len(articles)))
+	return &relatedIndex{
+		builtAt:  time.Now(),
+		version:  version,
+		index:    similarity.NewIndex(docs),
+		articles: articles,
+		results:  make(map[uint][]domain.Article),
+	}, nil
+}
+
+func (c *relatedIndex) rank(id uint) []domain.Article {
+	source := c.articles[id]
+	now := time.Now()
+
+	type candidate struct {
+		article domain.Article
+		score   float64
+	}
+
+	var candidates []candidate
+	for otherID, other := range c.articles {
+		if otherID == id {
+			continue
+		}
+
+		text := c.index.Similarity(id, otherID)
+		sameCategory := other.Category != "" && other.Category == source.Category
+
+		// Artikel tanpa kesamaan apapun tidak dianggap related
+		if text == 0 && !sameCategory {
+			continue
+		}
+
+		score := relatedWeightText * text
+		if sameCategory {
+			score += relatedWeightCategory
+		}
+
+		age := now.Sub(other.UpdatedDate)
+		if age < 0 {
+			age = 0
+		}
+		score += relatedWeightRecency * math.Pow(0.5, float64(age)/float64(relatedRecencyHalfLife))
+
+		candidates = append(candidates, candidate{article: other, score: score})
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score > candidates[j].score
+		}
+		return candidates[i].article.ID > candidates[j].article.ID
+	})
+
+	if len(candidates) > MaxRelatedLimit {
+		candidates = candidates[:MaxRelatedLimit]
+	}
+
+	ranked := make([]domain.Article, len(candidates))
+	for i, candidate := range candidates {
+		ranked[i] = candidate.article
+	}
+
+	return ranked
+}
@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/events"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// TransitionReview jalankan apply (simpan round atau keputusan review) lalu ubah status artikel dalam
+// satu transaction bersama domain event dan audit log. apply return nil jika status artikel tetap.
+// Error dari apply dan repository dikembalikan apa adanya supaya review usecase bisa memetakannya
+func (u *articleUsecase) TransitionReview(
+	ctx context.Context,
+	id uint,
+	apply func(ctx context.Context, article *domain.Article) (*domain.ReviewTransition, error),
+) (*domain.Article, error) {
+	var article *domain.Article
+	changed := false
+
+	err := u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		current, err := u.repoArticle.GetDetailByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		article = current
+
+		transition, err := apply(ctx, current)
+		if err != nil || transition == nil {
+			return err
+		}
+
+		if err := u.loadMedia(ctx, current); err != nil {
+			logger.FromContext(ctx).Warn("failed to load article media", zap.Uint("id", id), zap.Error(err))
+		}
+		before := snapshotArticle(current)
+
+		if err := u.repoArticle.UpdateReviewState(ctx, id, string(transition.Status), transition.ApprovedAt); err != nil {
+			logger.FromContext(ctx).Error("failed to update article review state", zap.Uint("id", id), zap.Error(err))
+			return err
+		}
+		current.Status = string(transition.Status)
+		current.ApprovedAt = transition.ApprovedAt
+		current.UpdatedDate = time.Now()
+		changed = true
+
+		after := snapshotArticle(current)
+		if err := u.appendEvents(ctx, id, after, events.ArticleUpdated); err != nil {
+			return err
+		}
+		return u.recordAudit(ctx, domain.AuditUpdate, id, before, after)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if changed {
+		u.related.invalidate()
+		u.notifyChanged(ctx, article)
+
+		logger.FromContext(ctx).Info("article review state changed", zap.Uint("id", id), zap.String("status", article.Status))
+	}
+
+	return article, nil
+}
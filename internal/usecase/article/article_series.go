@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/article"
+	seriesDto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/series"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// LoadSeriesNavigation isi posisi article di series beserta article sebelum / sesudahnya.
+// Navigasi hanya melewati article published, article yang sedang dibuka selalu ikut dihitung
+func (u *articleUsecase) LoadSeriesNavigation(ctx context.Context, article *domain.Article, seriesID uint) error {
+	series, err := u.repoSeries.GetDetailByID(ctx, seriesID)
+	if err != nil {
+		if errors.Is(err, seriesDto.ErrSeriesNotFound) {
+			return dto.ErrNotInSeries
+		}
+		logger.FromContext(ctx).Error("failed to get series", zap.Uint("series_id", seriesID), zap.Error(err))
+		return err
+	}
+
+	members, err := u.repoSeries.GetArticles(ctx, seriesID)
+	if err != nil {
+		logger.FromContext(ctx).Error("failed to get series articles", zap.Uint("series_id", seriesID), zap.Error(err))
+		return err
+	}
+
+	visible := make([]domain.Article, 0, len(members))
+	current := -1
+	for _, member := range members {
+		if member.ID == article.ID {
+			current = len(visible)
+		} else if !member.IsPublished() {
+			continue
+		}
+		visible = append(visible, member)
+	}
+
+	if current < 0 {
+		return dto.ErrNotInSeries
+	}
+
+	navigation := &domain.SeriesNavigation{
+		SeriesID: series.ID,
+		Title:    series.Title,
+		Slug:     series.Slug,
+		Position: current + 1,
+		Total:    len(visible),
+	}
+
+	if current > 0 {
+		navigation.Previous = &visible[current-1]
+	}
+	if current < len(visible)-1 {
+		navigation.Next = &visible[current+1]
+	}
+
+	article.Series = navigation
+	return nil
+}
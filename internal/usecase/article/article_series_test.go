@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/article"
+	seriesDto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/series"
+	seriesRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/series"
+	"go.uber.org/zap"
+)
+
+type fakeSeriesRepository struct {
+	seriesRepository.SeriesRepository
+	members []domain.Article
+}
+
+func (f *fakeSeriesRepository) GetDetailByID(_ context.Context, id uint) (*domain.Series, error) {
+	if id != 1 {
+		return nil, seriesDto.ErrSeriesNotFound
+	}
+	return &domain.Series{ID: 1, Title: "Go Basics", Slug: "go-basics"}, nil
+}
+
+func (f *fakeSeriesRepository) GetArticles(context.Context, uint) ([]domain.Article, error) {
+	return f.members, nil
+}
+
+func TestLoadSeriesNavigation(t *testing.T) {
+	publish := string(domain.StatusPublish)
+	draft := string(domain.StatusDraft)
+
+	// Article 3 masih draft, navigasi publik melewatinya
+	members := []domain.Article{
+		{ID: 1, Status: publish},
+		{ID: 2, Status: publish},
+		{ID: 3, Status: draft},
+		{ID: 4, Status: publish},
+	}
+
+	tests := []struct {
+		name         string
+		article      domain.Article
+		seriesID     uint
+		wantPosition int
+		wantTotal    int
+		wantPrevious uint
+		wantNext     uint
+		wantErr      error
+	}{
+		{name: "first", article: members[0], seriesID: 1, wantPosition: 1, wantTotal: 3, wantNext: 2},
+		{name: "skips draft as next", article: members[1], seriesID: 1, wantPosition: 2, wantTotal: 3, wantPrevious: 1, wantNext: 4},
+		{name: "skips draft as previous", article: members[3], seriesID: 1, wantPosition: 3, wantTotal: 3, wantPrevious: 2},
+		{name: "draft itself is counted", article: members[2], seriesID: 1, wantPosition: 3, wantTotal: 4, wantPrevious: 2, wantNext: 4},
+		{name: "not a member", article: domain.Article{ID: 9, Status: publish}, seriesID: 1, wantErr: dto.ErrNotInSeries},
+		{name: "series not found", article: members[0], seriesID: 9, wantErr: dto.ErrNotInSeries},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &articleUsecase{repoSeries: &fakeSeriesRepository{members: members}, log: zap.NewNop()}
+
+			article := tt.article
+			err := uc.LoadSeriesNavigation(context.Background(), &article, tt.seriesID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("LoadSeriesNavigation() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			navigation := article.Series
+			if navigation.Slug != "go-basics" || navigation.Position != tt.wantPosition || navigation.Total != tt.wantTotal {
+				t.Errorf("navigation = %+v, want position %d of %d", navigation, tt.wantPosition, tt.wantTotal)
+			}
+			if got := idOf(navigation.Previous); got != tt.wantPrevious {
+				t.Errorf("previous = %d, want %d", got, tt.wantPrevious)
+			}
+			if got := idOf(navigation.Next); got != tt.wantNext {
+				t.Errorf("next = %d, want %d", got, tt.wantNext)
+			}
+		})
+	}
+}
+
+func idOf(article *domain.Article) uint {
+	if article == nil {
+		return 0
+	}
+	return article.ID
+}
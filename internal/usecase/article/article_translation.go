@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/article"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/apperror"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/locale"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"go.uber.org/zap"
+)
+
+func (u *articleUsecase) CreateTranslation(ctx context.Context, articleID uint, translation *domain.ArticleTranslation) (*domain.ArticleTranslation, error) {
+	translation.Locale = locale.Normalize(translation.Locale)
+	logger.FromContext(ctx).Info("creating article translation", zap.Uint("article_id", articleID), zap.String("locale", translation.Locale))
+
+	if !u.locales.IsSupported(translation.Locale) {
+		return nil, dto.ErrUnsupportedLocale
+	}
+
+	// Konten default locale disimpan langsung di tabel posts
+	if translation.Locale == u.locales.Default {
+		return nil, dto.ErrTranslationDefaultLocale
+	}
+
+	if translation.ContentFormat == "" {
+		translation.ContentFormat = string(domain.FormatMarkdown)
+	}
+
+	if err := translation.Validate(); err != nil {
+		logger.FromContext(ctx).Warn("translation validation failed", zap.Error(err))
+		// Aturan domain dilanggar, dilaporkan sebagai error validasi (400)
+		return nil, apperror.Validation(string(dto.ErrCodeValidation), err.Error())
+	}
+
+	if _, err := u.repoArticle.GetDetailByID(ctx, articleID); err != nil {
+		logger.FromContext(ctx).Warn("article not found for translation", zap.Uint("article_id", articleID), zap.Error(err))
+		return nil, dto.ErrArticleNotFound
+	}
+
+	existing, err := u.repoArticle.GetTranslation(ctx, articleID, translation.Locale)
+	if err != nil && !errors.Is(err, dto.ErrArticleNotFound) {
+		logger.FromContext(ctx).Error("failed to check existing translation", zap.Error(err))
+		return nil, dto.ErrFailedCreateTranslation.Wrap(err)
+	}
+	if existing != nil {
+		return nil, dto.ErrTranslationExists
+	}
+
+	// Title unik per locale
+	sameTitle, err := u.repoArticle.GetTranslationByTitle(ctx, translation.Locale, translation.Title)
+	if err != nil && !errors.Is(err, dto.ErrArticleNotFound) {
+		logger.FromContext(ctx).Error("failed to check translation title", zap.Error(err))
+		return nil, dto.ErrFailedCreateTranslation.Wrap(err)
+	}
+	if sameTitle != nil {
+		logger.FromContext(ctx).Warn("translation with same title already exists", zap.String("locale", translation.Locale), zap.String("title", translation.Title))
+		return nil, dto.ErrArticleExists
+	}
+
+	// Render pakai pipeline yang sama dengan article
+	rendered := &domain.Article{Content: translation.Content, ContentFormat: translation.ContentFormat}
+	if err := u.renderContent(rendered); err != nil {
+		logger.FromContext(ctx).Error("failed to render translation content", zap.Error(err))
+		return nil, dto.ErrFailedCreateTranslation.Wrap(err)
+	}
+
+	translation.ArticleID = articleID
+	translation.ContentHTML = rendered.ContentHTML
+	translation.Excerpt = rendered.Excerpt
+	translation.WordCount = rendered.WordCount
+	translation.ReadingTime = rendered.ReadingTime
+	translation.CreatedDate = time.Now()
+	translation.UpdatedDate = time.Now()
+
+	if err := u.repoArticle.CreateTranslation(ctx, translation); err != nil {
+		logger.FromContext(ctx).Error("failed to save translation", zap.Error(err))
+		return nil, dto.ErrFailedCreateTranslation.Wrap(err)
+	}
+
+	logger.FromContext(ctx).Info("article translation created successfully", zap.Uint("article_id", articleID), zap.String("locale", translation.Locale))
+	return translation, nil
+}
+
+func (u *articleUsecase) GetTranslations(ctx context.Context, articleID uint) ([]domain.ArticleTranslation, error) {
+	logger.FromContext(ctx).Info("getting article translations", zap.Uint("article_id", articleID))
+
+	if _, err := u.repoArticle.GetDetailByID(ctx, articleID); err != nil {
+		return nil, dto.ErrArticleNotFound
+	}
+
+	translations, err := u.repoArticle.GetTranslations(ctx, articleID)
+	if err != nil {
+		logger.FromContext(ctx).Error("failed to get article translations", zap.Uint("article_id", articleID), zap.Error(err))
+		return nil, err
+	}
+
+	return translations, nil
+}
+
+// localize tampilkan translation sesuai locale, fallback ke konten default locale jika belum ada
+func (u *articleUsecase) localize(ctx context.Context, article *domain.Article, requested string) {
+	article.Locale = u.locales.Default
+
+	requested = locale.Normalize(requested)
+	if requested == "" || requested == u.locales.Default {
+		return
+	}
+
+	translation, err := u.repoArticle.GetTranslation(ctx, article.ID, requested)
+	if err != nil {
+		if !errors.Is(err, dto.ErrArticleNotFound) {
+			logger.FromContext(ctx).Warn("failed to get article translation", zap.Uint("id", article.ID), zap.Error(err))
+		}
+		return
+	}
+
+	translation.ApplyTo(article)
+}
+
+func (u *articleUsecase) localizeList(ctx context.Context, articles []domain.Article, requested string) {
+	for i := range articles {
+		articles[i].Locale = u.locales.Default
+	}
+
+	requested = locale.Normalize(requested)
+	if len(articles) == 0 || requested == "" || requested == u.locales.Default {
+		return
+	}
+
+	ids := make([]uint, len(articles))
+	for i, article := range articles {
+		ids[i] = article.ID
+	}
+
+	translations, err := u.repoArticle.GetTranslationsByLocale(ctx, ids, requested)
+	if err != nil {
+		logger.FromContext(ctx).Warn("failed to get article translations", zap.String("locale", requested), zap.Error(err))
+		return
+	}
+
+	byArticle := make(map[uint]*domain.ArticleTranslation, len(translations))
+	for i := range translations {
+		byArticle[translations[i].ArticleID] = &translations[i]
+	}
+
+	for i := range articles {
+		if translation, ok := byArticle[articles[i].ID]; ok {
+			translation.ApplyTo(&articles[i])
+		}
+	}
+}
@@ -2,44 +2,132 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
 	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/article"
 	repository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/article"
+	auditRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/audit"
+	mediaRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/media"
+	outboxRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/outbox"
+	seriesRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/series"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/apperror"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/locale"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/markup"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/events"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/transaction"
+	"github.com/spf13/viper"
 	"go.uber.org/zap"
 )
 
+// Jumlah row per query ketika membaca article secara streaming
+const streamBatchSize = 1000
+
 type articleUsecase struct {
-	repoArticle repository.ArticleRepository
-	log         *zap.Logger
+	repoArticle    repository.ArticleRepository
+	repoMedia      mediaRepository.MediaRepository
+	repoSeries     seriesRepository.SeriesRepository
+	repoAudit      auditRepository.AuditRepository
+	repoOutbox     outboxRepository.OutboxRepository
+	transactor     transaction.Transactor
+	changes        ChangeNotifier // boleh nil
+	importJobs     *importJobStore
+	related        *relatedCache
+	locales        locale.Settings
+	excerptLength  int
+	reviewRequired bool // artikel harus approved lewat editorial review sebelum Publish
+	log            *zap.Logger
+}
+
+func NewArticleUsecase(
+	repoArticle repository.ArticleRepository,
+	repoMedia mediaRepository.MediaRepository,
+	repoSeries seriesRepository.SeriesRepository,
+	repoAudit auditRepository.AuditRepository,
+	repoOutbox outboxRepository.OutboxRepository,
+	transactor transaction.Transactor,
+	changes ChangeNotifier,
+	config *viper.Viper,
+	log *zap.Logger,
+) ArticleUsecase {
+	// Setiap operasi di trace dan di hitung di metric blog_usecase_operations_total
+	return withInstrumentation(&articleUsecase{
+		repoArticle:    repoArticle,
+		repoMedia:      repoMedia,
+		repoSeries:     repoSeries,
+		repoAudit:      repoAudit,
+		repoOutbox:     repoOutbox,
+		transactor:     transactor,
+		changes:        changes,
+		importJobs:     newImportJobStore(),
+		related:        newRelatedCache(config.GetDuration("RELATED_CACHE_TTL")),
+		locales:        locale.FromConfig(config),
+		excerptLength:  excerptLengthFromConfig(config, log),
+		reviewRequired: !config.IsSet("REVIEW_REQUIRED") || config.GetBool("REVIEW_REQUIRED"),
+		log:            log,
+	})
 }
 
-func NewArticleUsecase(repoArticle repository.ArticleRepository, log *zap.Logger) ArticleUsecase {
-	return &articleUsecase{
-		repoArticle: repoArticle,
-		log:         log,
+// excerptLengthFromConfig EXCERPT_LENGTH dibatasi lebar kolom excerpt supaya insert tidak gagal / terpotong
+func excerptLengthFromConfig(config *viper.Viper, log *zap.Logger) int {
+	length := config.GetInt("EXCERPT_LENGTH")
+	if length > domain.MaxExcerptLength {
+		log.Warn("EXCERPT_LENGTH exceeds excerpt column width, clamped",
+			zap.Int("configured", length),
+			zap.Int("max", domain.MaxExcerptLength),
+		)
+		return domain.MaxExcerptLength
 	}
+
+	return length
 }
 
 func (u *articleUsecase) Create(ctx context.Context, article *domain.Article) (*domain.Article, error) {
-	u.log.Info("creating new article", zap.String("title", article.Title))
+	// Artikel baru belum pernah di review, jadi tidak boleh langsung Publish
+	if u.reviewRequired && article.IsPublished() {
+		logger.FromContext(ctx).Warn("article must be reviewed before publishing", zap.String("title", article.Title))
+		return nil, dto.ErrReviewRequired
+	}
+
+	return u.create(ctx, article)
+}
+
+// create tanpa pengecekan review, dipakai juga oleh import yang memindahkan konten yang sudah terbit
+func (u *articleUsecase) create(ctx context.Context, article *domain.Article) (*domain.Article, error) {
+	logger.FromContext(ctx).Info("creating new article", zap.String("title", article.Title))
 
 	// Validate request
 	if err := article.Validate(); err != nil {
-		u.log.Warn("article validation failed", zap.Error(err))
-		return nil, err
+		logger.FromContext(ctx).Warn("article validation failed", zap.Error(err))
+		// Aturan domain dilanggar, dilaporkan sebagai error validasi (400)
+		return nil, apperror.Validation(string(dto.ErrCodeValidation), err.Error())
 	}
 
-	// Check existing data by title
+	// Check existing data by title, tabel posts berisi konten default locale
+	// sehingga uniqueness title translation di cek terpisah per locale
 	existing, err := u.repoArticle.GetByTitle(ctx, article.Title)
-	if err != nil && err != dto.ErrArticleNotFound {
-		u.log.Error("failed to check existing article", zap.Error(err))
-		return nil, dto.ErrFailedCreateArticle
+	if err != nil && !errors.Is(err, dto.ErrArticleNotFound) {
+		logger.FromContext(ctx).Error("failed to check existing article", zap.Error(err))
+		return nil, dto.ErrFailedCreateArticle.Wrap(err)
 	}
 
 	if existing != nil {
-		u.log.Warn("article with same title already exists", zap.String("title", article.Title))
+		logger.FromContext(ctx).Warn("article with same title already exists", zap.String("title", article.Title))
+		return nil, dto.ErrArticleExists
+	}
+
+	// Check existing data by slug
+	article.EnsureSlug()
+	existing, err = u.repoArticle.GetBySlug(ctx, article.Slug)
+	if err != nil && !errors.Is(err, dto.ErrArticleNotFound) {
+		logger.FromContext(ctx).Error("failed to check existing slug", zap.Error(err))
+		return nil, dto.ErrFailedCreateArticle.Wrap(err)
+	}
+
+	if existing != nil {
+		logger.FromContext(ctx).Warn("article with same slug already exists", zap.String("slug", article.Slug))
 		return nil, dto.ErrArticleExists
 	}
 
@@ -51,18 +139,59 @@ func (u *articleUsecase) Create(ctx context.Context, article *domain.Article) (*
 		article.Status = "Draft"
 	}
 
-	if err := u.repoArticle.Create(ctx, article); err != nil {
-		u.log.Error("failed to save article to database", zap.Error(err))
-		return nil, dto.ErrFailedCreateArticle
+	// Render content ke HTML sekali saat write, hasilnya disimpan sebagai cache
+	if err := u.renderContent(article); err != nil {
+		logger.FromContext(ctx).Error("failed to render article content", zap.Error(err))
+		return nil, dto.ErrFailedCreateArticle.Wrap(err)
+	}
+
+	// Validate media reference
+	if err := u.resolveCover(ctx, article); err != nil {
+		return nil, err
 	}
 
-	u.log.Info("article created successfully", zap.Uint("id", article.ID), zap.String("title", article.Title))
+	attachments, err := u.resolveAttachments(ctx, article.Attachments)
+	if err != nil {
+		return nil, err
+	}
+
+	// Article, attachment dan domain event ditulis dalam satu transaction
+	err = u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := u.repoArticle.Create(ctx, article); err != nil {
+			logger.FromContext(ctx).Error("failed to save article to database", zap.Error(err))
+			return err
+		}
+
+		if len(attachments) > 0 {
+			if err := u.repoMedia.SetAttachments(ctx, article.ID, mediaIDs(attachments)); err != nil {
+				logger.FromContext(ctx).Error("failed to save article attachments", zap.Uint("id", article.ID), zap.Error(err))
+				return err
+			}
+		}
+		article.Attachments = attachments
+
+		eventTypes := []string{events.ArticleCreated}
+		if article.IsPublished() {
+			eventTypes = append(eventTypes, events.ArticlePublished)
+		}
+		after := snapshotArticle(article)
+		if err := u.appendEvents(ctx, article.ID, after, eventTypes...); err != nil {
+			return err
+		}
+		return u.recordAudit(ctx, domain.AuditCreate, article.ID, nil, after)
+	})
+	if err != nil {
+		return nil, dto.ErrFailedCreateArticle.Wrap(err)
+	}
+	u.related.invalidate()
+
+	logger.FromContext(ctx).Info("article created successfully", zap.Uint("id", article.ID), zap.String("title", article.Title))
 
 	return article, nil
 }
 
 func (u *articleUsecase) GetList(ctx context.Context, filter *dto.ArticleFilter) ([]domain.Article, int64, error) {
-	u.log.Info("getting article list",
+	logger.FromContext(ctx).Info("getting article list",
 		zap.Int("page", filter.GetDefaultPage()),
 		zap.Int("limit", filter.GetDefaultLimit()),
 		zap.String("category", filter.GetCategory()),
@@ -71,61 +200,100 @@ func (u *articleUsecase) GetList(ctx context.Context, filter *dto.ArticleFilter)
 
 	// Validate filter
 	if err := filter.Validate(); err != nil {
-		u.log.Warn("filter validation failed", zap.Error(err))
+		logger.FromContext(ctx).Warn("filter validation failed", zap.Error(err))
 		return nil, 0, err
 	}
 
 	// Get data from repository
 	articles, total, err := u.repoArticle.GetList(ctx, filter)
 	if err != nil {
-		u.log.Error("failed to get articles from repository", zap.Error(err))
-		return nil, 0, dto.ErrFailedCreateArticle
+		logger.FromContext(ctx).Error("failed to get articles from repository", zap.Error(err))
+		return nil, 0, dto.ErrFailedGetList.Wrap(err)
+	}
+
+	// Data lama belum punya metadata, hitung on the fly
+	for i := range articles {
+		if articles[i].WordCount == 0 && articles[i].Content != "" {
+			articles[i].ComputeMetadata(u.excerptLength)
+		}
 	}
 
-	u.log.Info("articles retrieved successfully", zap.Int("count", len(articles)), zap.Int64("total", total))
+	u.localizeList(ctx, articles, filter.GetLocale())
+
+	logger.FromContext(ctx).Info("articles retrieved successfully", zap.Int("count", len(articles)), zap.Int64("total", total))
 
 	return articles, total, nil
 }
 
-func (u *articleUsecase) GetDetailByID(ctx context.Context, id uint) (*domain.Article, error) {
-	u.log.Info("getting article detail", zap.Uint("id", id))
+func (u *articleUsecase) GetDetailByID(ctx context.Context, id uint, lang string) (*domain.Article, error) {
+	logger.FromContext(ctx).Info("getting article detail", zap.Uint("id", id), zap.String("lang", lang))
 
 	// Get article by id
 	article, err := u.repoArticle.GetDetailByID(ctx, id)
 	if err != nil {
-		u.log.Warn("article not found", zap.Uint("id", id), zap.Error(err))
-		return nil, dto.ErrArticleNotFound
+		logger.FromContext(ctx).Warn("failed to get article", zap.Uint("id", id), zap.Error(err))
+		// Not found dari repository diteruskan, error database lain menjadi Internal
+		return nil, apperror.Wrap(err, string(dto.ErrCodeDBError), "failed to get article")
 	}
 
-	u.log.Info("article detail retrieved successfully", zap.Uint("id", id))
+	// Data lama belum punya cache HTML, render on the fly
+	if article.ContentHTML == "" && article.Content != "" {
+		if err := u.renderContent(article); err != nil {
+			logger.FromContext(ctx).Warn("failed to render article content", zap.Uint("id", id), zap.Error(err))
+		}
+	}
+
+	if err := u.loadMedia(ctx, article); err != nil {
+		logger.FromContext(ctx).Warn("failed to load article media", zap.Uint("id", id), zap.Error(err))
+	}
+
+	u.localize(ctx, article, lang)
+
+	logger.FromContext(ctx).Info("article detail retrieved successfully", zap.Uint("id", id))
 	return article, nil
 }
 
 func (u *articleUsecase) UpdateByID(ctx context.Context, id uint, updateReq *dto.UpdateArticleRequest) (*domain.Article, error) {
-	u.log.Info("updating article", zap.Uint("id", id))
+	logger.FromContext(ctx).Info("updating article", zap.Uint("id", id))
 
 	// Validate request
 	if err := updateReq.Validate(); err != nil {
-		u.log.Warn("update request validation failed", zap.Error(err))
+		logger.FromContext(ctx).Warn("update request validation failed", zap.Error(err))
 		return nil, err
 	}
 
 	// Get existing article data
 	article, err := u.repoArticle.GetDetailByID(ctx, id)
 	if err != nil {
-		u.log.Warn("article not found for update", zap.Uint("id", id), zap.Error(err))
-		return nil, dto.ErrArticleNotFound
+		logger.FromContext(ctx).Warn("failed to get article for update", zap.Uint("id", id), zap.Error(err))
+		return nil, apperror.Wrap(err, string(dto.ErrCodeUpdateFailed), "failed to update article")
+	}
+
+	// State sebelum update untuk audit log, media ikut di load supaya attachment tercatat
+	if err := u.loadMedia(ctx, article); err != nil {
+		logger.FromContext(ctx).Warn("failed to load article media", zap.Uint("id", id), zap.Error(err))
+	}
+	before := snapshotArticle(article)
+	wasPublished := article.IsPublished()
+
+	contentChanged := (updateReq.Title != "" && updateReq.Title != article.Title) ||
+		(updateReq.Content != "" && updateReq.Content != article.Content) ||
+		(updateReq.ContentFormat != "" && updateReq.ContentFormat != article.ContentFormat)
+
+	resetApproval, err := u.checkReview(ctx, article, contentChanged, updateReq.Status)
+	if err != nil {
+		return nil, err
 	}
 
 	if updateReq.Title != "" {
 		// Check if new title already exists (but not in this article)
 		existing, err := u.repoArticle.GetByTitle(ctx, updateReq.Title)
-		if err != nil && err != dto.ErrArticleNotFound {
-			u.log.Error("failed to check title availability", zap.Error(err))
-			return nil, dto.ErrFailedUpdateArticle
+		if err != nil && !errors.Is(err, dto.ErrArticleNotFound) {
+			logger.FromContext(ctx).Error("failed to check title availability", zap.Error(err))
+			return nil, dto.ErrFailedUpdateArticle.Wrap(err)
 		}
 		if existing != nil && existing.ID != id {
-			u.log.Warn("title already exists", zap.String("title", updateReq.Title))
+			logger.FromContext(ctx).Warn("title already exists", zap.String("title", updateReq.Title))
 			return nil, dto.ErrArticleExists
 		}
 		article.Title = updateReq.Title
@@ -135,6 +303,18 @@ func (u *articleUsecase) UpdateByID(ctx context.Context, id uint, updateReq *dto
 		article.Content = updateReq.Content
 	}
 
+	if updateReq.ContentFormat != "" {
+		article.ContentFormat = updateReq.ContentFormat
+	}
+
+	// Content atau format berubah, cache HTML harus di render ulang
+	if updateReq.Content != "" || updateReq.ContentFormat != "" || article.ContentHTML == "" {
+		if err := u.renderContent(article); err != nil {
+			logger.FromContext(ctx).Error("failed to render article content", zap.Uint("id", id), zap.Error(err))
+			return nil, dto.ErrFailedUpdateArticle.Wrap(err)
+		}
+	}
+
 	if updateReq.Category != "" {
 		article.Category = updateReq.Category
 	}
@@ -143,34 +323,238 @@ func (u *articleUsecase) UpdateByID(ctx context.Context, id uint, updateReq *dto
 		article.Status = updateReq.Status
 	}
 
+	if updateReq.CoverMediaID != nil {
+		article.CoverMediaID = updateReq.CoverMediaID
+		if err := u.resolveCover(ctx, article); err != nil {
+			return nil, err
+		}
+	}
+
+	var attachments []domain.Media
+	if updateReq.AttachmentIDs != nil {
+		requested := make([]domain.Media, len(updateReq.AttachmentIDs))
+		for i, mediaID := range updateReq.AttachmentIDs {
+			requested[i] = domain.Media{ID: mediaID}
+		}
+		if attachments, err = u.resolveAttachments(ctx, requested); err != nil {
+			return nil, err
+		}
+	}
+
+	article.EnsureSlug()
 	article.UpdatedDate = time.Now()
 
-	// Update article by id
-	if err := u.repoArticle.UpdateByID(ctx, id, article); err != nil {
-		u.log.Error("failed to update article", zap.Uint("id", id), zap.Error(err))
-		return nil, dto.ErrFailedUpdateArticle
+	err = u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		// Update article by id
+		if err := u.repoArticle.UpdateByID(ctx, id, article); err != nil {
+			logger.FromContext(ctx).Error("failed to update article", zap.Uint("id", id), zap.Error(err))
+			return err
+		}
+
+		if updateReq.AttachmentIDs != nil {
+			if err := u.repoMedia.SetAttachments(ctx, id, mediaIDs(attachments)); err != nil {
+				logger.FromContext(ctx).Error("failed to update article attachments", zap.Uint("id", id), zap.Error(err))
+				return err
+			}
+			article.Attachments = attachments
+		}
+
+		if resetApproval {
+			if err := u.repoArticle.UpdateReviewState(ctx, id, article.Status, nil); err != nil {
+				logger.FromContext(ctx).Error("failed to reset article approval", zap.Uint("id", id), zap.Error(err))
+				return err
+			}
+			article.ApprovedAt = nil
+			logger.FromContext(ctx).Info("article approval reset after content change", zap.Uint("id", id))
+		}
+
+		eventTypes := []string{events.ArticleUpdated}
+		if article.IsPublished() && !wasPublished {
+			eventTypes = append(eventTypes, events.ArticlePublished)
+		}
+		after := snapshotArticle(article)
+		if err := u.appendEvents(ctx, id, after, eventTypes...); err != nil {
+			return err
+		}
+		return u.recordAudit(ctx, domain.AuditUpdate, id, before, after)
+	})
+	if err != nil {
+		return nil, dto.ErrFailedUpdateArticle.Wrap(err)
+	}
+
+	u.related.invalidate()
+
+	if err := u.loadMedia(ctx, article); err != nil {
+		logger.FromContext(ctx).Warn("failed to load article media", zap.Uint("id", id), zap.Error(err))
 	}
 
-	u.log.Info("article updated successfully", zap.Uint("id", id))
+	u.notifyChanged(ctx, article)
+
+	logger.FromContext(ctx).Info("article updated successfully", zap.Uint("id", id))
 	return article, nil
 }
 
+// checkReview aturan editorial review untuk perubahan artikel yang sudah ada, dipakai update dan import.
+// Return true kalau approval harus di hapus karena isi artikel berubah
+func (u *articleUsecase) checkReview(ctx context.Context, article *domain.Article, contentChanged bool, status string) (bool, error) {
+	// Isi yang sedang di review tidak boleh berubah, reviewer harus menilai versi yang di submit
+	if contentChanged && article.IsInReview() {
+		logger.FromContext(ctx).Warn("article content cannot change while in review", zap.Uint("id", article.ID))
+		return false, dto.ErrArticleInReview
+	}
+
+	// Perubahan isi setelah approved membatalkan approval, termasuk jika sekalian di publish
+	resetApproval := contentChanged && article.IsApproved() && !article.IsPublished()
+
+	// Publish hanya untuk artikel yang sudah approved, artikel yang sudah Publish tetap boleh di edit
+	if u.reviewRequired && status == string(domain.StatusPublish) && !article.IsPublished() &&
+		(!article.IsApproved() || resetApproval) {
+		logger.FromContext(ctx).Warn("article must be reviewed before publishing", zap.Uint("id", article.ID), zap.String("status", article.Status))
+		return false, dto.ErrReviewRequired
+	}
+
+	return resetApproval, nil
+}
+
 func (u *articleUsecase) DeleteByID(ctx context.Context, id uint) error {
-	u.log.Info("deleting article", zap.Uint("id", id))
+	logger.FromContext(ctx).Info("deleting article", zap.Uint("id", id))
 
 	// Check exists article
 	article, err := u.repoArticle.GetDetailByID(ctx, id)
 	if err != nil {
-		u.log.Warn("article not found for delete", zap.Uint("id", id), zap.Error(err))
-		return dto.ErrArticleNotFound
+		logger.FromContext(ctx).Warn("failed to get article for delete", zap.Uint("id", id), zap.Error(err))
+		return apperror.Wrap(err, string(dto.ErrCodeDeleteFailed), "failed to delete article")
+	}
+
+	if err := u.loadMedia(ctx, article); err != nil {
+		logger.FromContext(ctx).Warn("failed to load article media", zap.Uint("id", id), zap.Error(err))
+	}
+	before := snapshotArticle(article)
+
+	// Delete if exist, payload event berisi state terakhir sebelum dihapus
+	err = u.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := u.repoArticle.DeleteByID(ctx, id); err != nil {
+			logger.FromContext(ctx).Error("failed to delete article", zap.Uint("id", id), zap.Error(err))
+			return err
+		}
+		if err := u.appendEvents(ctx, id, before, events.ArticleDeleted); err != nil {
+			return err
+		}
+		return u.recordAudit(ctx, domain.AuditDelete, id, before, nil)
+	})
+	if err != nil {
+		return dto.ErrFailedDeleteArticle.Wrap(err)
 	}
+	u.related.invalidate()
 
-	// Delete if exist
-	if err := u.repoArticle.DeleteByID(ctx, id); err != nil {
-		u.log.Error("failed to delete article", zap.Uint("id", id), zap.Error(err))
-		return dto.ErrFailedDeleteArticle
+	logger.FromContext(ctx).Info("article deleted successfully", zap.Uint("id", id), zap.String("title", article.Title))
+	return nil
+}
+
+func (u *articleUsecase) CountPublished(ctx context.Context) (int64, error) {
+	total, err := u.repoArticle.CountByStatus(ctx, string(domain.StatusPublish))
+	if err != nil {
+		logger.FromContext(ctx).Error("failed to count published articles", zap.Error(err))
+		return 0, err
+	}
+
+	return total, nil
+}
+
+func (u *articleUsecase) StreamPublished(ctx context.Context, offset, limit int, fn func([]domain.Article) error) error {
+	if err := u.repoArticle.StreamByStatus(ctx, string(domain.StatusPublish), offset, limit, streamBatchSize, fn); err != nil {
+		logger.FromContext(ctx).Error("failed to stream published articles", zap.Error(err))
+		return err
 	}
 
-	u.log.Info("article deleted successfully", zap.Uint("id", id), zap.String("title", article.Title))
 	return nil
 }
+
+func (u *articleUsecase) renderContent(article *domain.Article) error {
+	if article.ContentFormat == "" {
+		article.ContentFormat = string(domain.FormatMarkdown)
+	}
+
+	rendered, err := markup.Render(markup.Format(article.ContentFormat), article.Content)
+	if err != nil {
+		return err
+	}
+
+	article.ContentHTML = rendered
+	article.ComputeMetadata(u.excerptLength)
+	return nil
+}
+
+// resolveCover pastikan cover media ada dan berupa image
+func (u *articleUsecase) resolveCover(ctx context.Context, article *domain.Article) error {
+	if article.CoverMediaID == nil {
+		article.Cover = nil
+		return nil
+	}
+
+	cover, err := u.repoMedia.GetByID(ctx, *article.CoverMediaID)
+	if err != nil || !cover.IsImage() {
+		logger.FromContext(ctx).Warn("invalid cover media", zap.Uint("media_id", *article.CoverMediaID), zap.Error(err))
+		return dto.ErrInvalidCoverMedia
+	}
+
+	article.Cover = cover
+	return nil
+}
+
+// resolveAttachments load detail media sesuai urutan request
+func (u *articleUsecase) resolveAttachments(ctx context.Context, requested []domain.Media) ([]domain.Media, error) {
+	if len(requested) == 0 {
+		return []domain.Media{}, nil
+	}
+
+	ids := mediaIDs(requested)
+	found, err := u.repoMedia.GetByIDs(ctx, ids)
+	if err != nil {
+		logger.FromContext(ctx).Error("failed to load attachment media", zap.Error(err))
+		return nil, dto.ErrFailedUpdateArticle.Wrap(err)
+	}
+
+	byID := make(map[uint]domain.Media, len(found))
+	for _, media := range found {
+		byID[media.ID] = media
+	}
+
+	attachments := make([]domain.Media, 0, len(ids))
+	for _, mediaID := range ids {
+		media, ok := byID[mediaID]
+		if !ok {
+			logger.FromContext(ctx).Warn("attachment media not found", zap.Uint("media_id", mediaID))
+			return nil, dto.ErrAttachmentNotFound
+		}
+		attachments = append(attachments, media)
+	}
+
+	return attachments, nil
+}
+
+func (u *articleUsecase) loadMedia(ctx context.Context, article *domain.Article) error {
+	if article.CoverMediaID != nil {
+		cover, err := u.repoMedia.GetByID(ctx, *article.CoverMediaID)
+		if err != nil {
+			return err
+		}
+		article.Cover = cover
+	}
+
+	attachments, err := u.repoMedia.GetAttachments(ctx, article.ID)
+	if err != nil {
+		return err
+	}
+	article.Attachments = attachments
+
+	return nil
+}
+
+func mediaIDs(media []domain.Media) []uint {
+	ids := make([]uint, len(media))
+	for i, item := range media {
+		ids[i] = item.ID
+	}
+	return ids
+}
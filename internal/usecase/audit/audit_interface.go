@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/audit"
+)
+
+type AuditUsecase interface {
+	GetList(ctx context.Context, auditFilter *dto.AuditFilter) ([]domain.AuditLog, int64, error)
+	Export(ctx context.Context, auditFilter *dto.AuditFilter, fn func([]domain.AuditLog) error) error
+}
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/audit"
+	repository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/audit"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// Jumlah row per query ketika export
+const exportBatchSize = 1000
+
+type auditUsecase struct {
+	repoAudit repository.AuditRepository
+	log       *zap.Logger
+}
+
+func NewAuditUsecase(repoAudit repository.AuditRepository, log *zap.Logger) AuditUsecase {
+	return &auditUsecase{
+		repoAudit: repoAudit,
+		log:       log,
+	}
+}
+
+func (u *auditUsecase) GetList(ctx context.Context, auditFilter *dto.AuditFilter) ([]domain.AuditLog, int64, error) {
+	logger.FromContext(ctx).Info("getting audit logs",
+		zap.String("actor", auditFilter.GetActor()),
+		zap.String("entity", auditFilter.GetEntity()),
+		zap.String("action", auditFilter.GetAction()),
+	)
+
+	logs, total, err := u.repoAudit.GetList(ctx, auditFilter)
+	if err != nil {
+		return nil, 0, dto.ErrFailedGetAuditLogs.Wrap(err)
+	}
+
+	return logs, total, nil
+}
+
+// Export kirim semua audit log yang cocok dengan filter per batch, urut dari yang terlama
+func (u *auditUsecase) Export(ctx context.Context, auditFilter *dto.AuditFilter, fn func([]domain.AuditLog) error) error {
+	logger.FromContext(ctx).Info("exporting audit logs", zap.String("actor", auditFilter.GetActor()), zap.String("action", auditFilter.GetAction()))
+
+	if err := u.repoAudit.Stream(ctx, auditFilter, exportBatchSize, fn); err != nil {
+		return dto.ErrFailedGetAuditLogs.Wrap(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/comment"
+)
+
+type CommentUsecase interface {
+	Create(ctx context.Context, articleID uint, comment *domain.Comment) (*domain.Comment, error)
+	GetThreads(ctx context.Context, articleID uint, commentFilter *dto.CommentFilter) ([]domain.Comment, int64, error)
+	GetModerationQueue(ctx context.Context, commentFilter *dto.CommentFilter) ([]domain.Comment, int64, error)
+	Approve(ctx context.Context, id uint) (*domain.Comment, error)
+	Reject(ctx context.Context, id uint) (*domain.Comment, error)
+}
@@ -0,0 +1,189 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	articleDto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/article"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/comment"
+	articleRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/article"
+	repository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/comment"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"go.uber.org/zap"
+)
+
+type commentUsecase struct {
+	repoComment repository.CommentRepository
+	repoArticle articleRepository.ArticleRepository
+	log         *zap.Logger
+}
+
+func NewCommentUsecase(
+	repoComment repository.CommentRepository,
+	repoArticle articleRepository.ArticleRepository,
+	log *zap.Logger,
+) CommentUsecase {
+	return &commentUsecase{
+		repoComment: repoComment,
+		repoArticle: repoArticle,
+		log:         log,
+	}
+}
+
+func (u *commentUsecase) Create(ctx context.Context, articleID uint, comment *domain.Comment) (*domain.Comment, error) {
+	logger.FromContext(ctx).Info("creating new comment", zap.Uint("article_id", articleID))
+
+	if err := u.ensurePublished(ctx, articleID); err != nil {
+		return nil, err
+	}
+
+	// Balasan hanya boleh ke komentar approved pada artikel yang sama
+	if comment.ParentID != nil {
+		parent, err := u.repoComment.GetDetailByID(ctx, *comment.ParentID)
+		if err != nil {
+			if errors.Is(err, dto.ErrCommentNotFound) {
+				return nil, dto.ErrInvalidParent
+			}
+			logger.FromContext(ctx).Error("failed to get parent comment", zap.Error(err))
+			return nil, dto.ErrFailedCreateComment.Wrap(err)
+		}
+
+		if parent.ArticleID != articleID || !parent.IsApproved() {
+			logger.FromContext(ctx).Warn("invalid parent comment", zap.Uint("parent_id", parent.ID))
+			return nil, dto.ErrInvalidParent
+		}
+	}
+
+	// Komentar baru selalu masuk antrian moderasi
+	comment.ArticleID = articleID
+	comment.Status = string(domain.CommentPending)
+	comment.CreatedDate = time.Now()
+	comment.UpdatedDate = time.Now()
+
+	if err := u.repoComment.Create(ctx, comment); err != nil {
+		logger.FromContext(ctx).Error("failed to create comment", zap.Error(err))
+		return nil, dto.ErrFailedCreateComment.Wrap(err)
+	}
+
+	logger.FromContext(ctx).Info("comment created successfully", zap.Uint("id", comment.ID))
+	return comment, nil
+}
+
+func (u *commentUsecase) GetThreads(ctx context.Context, articleID uint, commentFilter *dto.CommentFilter) ([]domain.Comment, int64, error) {
+	logger.FromContext(ctx).Info("getting comment threads", zap.Uint("article_id", articleID))
+
+	if err := u.ensurePublished(ctx, articleID); err != nil {
+		return nil, 0, err
+	}
+
+	approved := string(domain.CommentApproved)
+
+	// Pagination berlaku untuk komentar root, balasan selalu ikut lengkap
+	threads, total, err := u.repoComment.GetThreads(ctx, articleID, approved, commentFilter.GetOffset(), commentFilter.GetDefaultLimit())
+	if err != nil {
+		logger.FromContext(ctx).Error("failed to get comment threads", zap.Error(err))
+		return nil, 0, err
+	}
+
+	if len(threads) == 0 {
+		return threads, total, nil
+	}
+
+	replies, err := u.repoComment.GetReplies(ctx, articleID, approved)
+	if err != nil {
+		logger.FromContext(ctx).Error("failed to get comment replies", zap.Error(err))
+		return nil, 0, err
+	}
+
+	return buildThreads(threads, replies), total, nil
+}
+
+func (u *commentUsecase) GetModerationQueue(ctx context.Context, commentFilter *dto.CommentFilter) ([]domain.Comment, int64, error) {
+	logger.FromContext(ctx).Info("getting moderation queue", zap.String("status", commentFilter.GetStatus()))
+
+	comments, total, err := u.repoComment.GetList(ctx, commentFilter)
+	if err != nil {
+		logger.FromContext(ctx).Error("failed to get moderation queue", zap.Error(err))
+		return nil, 0, err
+	}
+
+	return comments, total, nil
+}
+
+func (u *commentUsecase) Approve(ctx context.Context, id uint) (*domain.Comment, error) {
+	return u.moderate(ctx, id, domain.CommentApproved)
+}
+
+func (u *commentUsecase) Reject(ctx context.Context, id uint) (*domain.Comment, error) {
+	return u.moderate(ctx, id, domain.CommentRejected)
+}
+
+func (u *commentUsecase) moderate(ctx context.Context, id uint, status domain.CommentStatus) (*domain.Comment, error) {
+	logger.FromContext(ctx).Info("moderating comment", zap.Uint("id", id), zap.String("status", string(status)))
+
+	if _, err := u.repoComment.GetDetailByID(ctx, id); err != nil {
+		if errors.Is(err, dto.ErrCommentNotFound) {
+			return nil, err
+		}
+		logger.FromContext(ctx).Error("failed to get comment", zap.Uint("id", id), zap.Error(err))
+		return nil, dto.ErrFailedModerate.Wrap(err)
+	}
+
+	if err := u.repoComment.UpdateStatus(ctx, id, string(status)); err != nil {
+		logger.FromContext(ctx).Error("failed to update comment status", zap.Uint("id", id), zap.Error(err))
+		return nil, dto.ErrFailedModerate.Wrap(err)
+	}
+
+	comment, err := u.repoComment.GetDetailByID(ctx, id)
+	if err != nil {
+		logger.FromContext(ctx).Error("failed to get moderated comment", zap.Uint("id", id), zap.Error(err))
+		return nil, dto.ErrFailedModerate.Wrap(err)
+	}
+
+	logger.FromContext(ctx).Info("comment moderated successfully", zap.Uint("id", id), zap.String("status", comment.Status))
+	return comment, nil
+}
+
+// ensurePublished komentar hanya tersedia untuk artikel berstatus Publish
+func (u *commentUsecase) ensurePublished(ctx context.Context, articleID uint) error {
+	article, err := u.repoArticle.GetDetailByID(ctx, articleID)
+	if err != nil {
+		if errors.Is(err, articleDto.ErrArticleNotFound) {
+			return dto.ErrArticleNotFound
+		}
+		logger.FromContext(ctx).Error("failed to get article", zap.Uint("article_id", articleID), zap.Error(err))
+		return err
+	}
+
+	if domain.ArticleStatus(article.Status) != domain.StatusPublish {
+		logger.FromContext(ctx).Warn("article is not published", zap.Uint("article_id", articleID))
+		return dto.ErrArticleNotPublished
+	}
+
+	return nil
+}
+
+// buildThreads susun balasan ke parent masing-masing, balasan yang parent-nya
+// tidak approved otomatis tidak ikut tampil
+func buildThreads(threads, replies []domain.Comment) []domain.Comment {
+	children := make(map[uint][]domain.Comment)
+	for _, reply := range replies {
+		children[*reply.ParentID] = append(children[*reply.ParentID], reply)
+	}
+
+	var attach func(comment *domain.Comment)
+	attach = func(comment *domain.Comment) {
+		comment.Replies = children[comment.ID]
+		for i := range comment.Replies {
+			attach(&comment.Replies[i])
+		}
+	}
+
+	for i := range threads {
+		attach(&threads[i])
+	}
+
+	return threads
+}
@@ -0,0 +1,210 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	articleDto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/article"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/comment"
+	articleRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/article"
+	repository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/comment"
+	"go.uber.org/zap"
+)
+
+// fakeCommentRepository komentar di memory, GetThreads / GetReplies hanya filter status
+type fakeCommentRepository struct {
+	repository.CommentRepository
+	comments []domain.Comment
+	created  *domain.Comment
+}
+
+func (f *fakeCommentRepository) Create(_ context.Context, comment *domain.Comment) error {
+	comment.ID = uint(len(f.comments) + 1)
+	f.created = comment
+	f.comments = append(f.comments, *comment)
+	return nil
+}
+
+func (f *fakeCommentRepository) GetDetailByID(_ context.Context, id uint) (*domain.Comment, error) {
+	for _, comment := range f.comments {
+		if comment.ID == id {
+			copied := comment
+			return &copied, nil
+		}
+	}
+	return nil, dto.ErrCommentNotFound
+}
+
+func (f *fakeCommentRepository) UpdateStatus(_ context.Context, id uint, status string) error {
+	for i := range f.comments {
+		if f.comments[i].ID == id {
+			f.comments[i].Status = status
+		}
+	}
+	return nil
+}
+
+func (f *fakeCommentRepository) GetThreads(_ context.Context, articleID uint, status string, _, _ int) ([]domain.Comment, int64, error) {
+	var threads []domain.Comment
+	for _, comment := range f.comments {
+		if comment.ArticleID == articleID && comment.Status == status && comment.ParentID == nil {
+			threads = append(threads, comment)
+		}
+	}
+	return threads, int64(len(threads)), nil
+}
+
+func (f *fakeCommentRepository) GetReplies(_ context.Context, articleID uint, status string) ([]domain.Comment, error) {
+	var replies []domain.Comment
+	for _, comment := range f.comments {
+		if comment.ArticleID == articleID && comment.Status == status && comment.ParentID != nil {
+			replies = append(replies, comment)
+		}
+	}
+	return replies, nil
+}
+
+type fakeArticleRepository struct {
+	articleRepository.ArticleRepository
+	articles map[uint]domain.Article
+}
+
+func (f *fakeArticleRepository) GetDetailByID(_ context.Context, id uint) (*domain.Article, error) {
+	article, ok := f.articles[id]
+	if !ok {
+		return nil, articleDto.ErrArticleNotFound
+	}
+	return &article, nil
+}
+
+func uintPtr(v uint) *uint {
+	return &v
+}
+
+func newTestUsecase(comments ...domain.Comment) (CommentUsecase, *fakeCommentRepository) {
+	repoComment := &fakeCommentRepository{comments: comments}
+	repoArticle := &fakeArticleRepository{articles: map[uint]domain.Article{
+		1: {ID: 1, Status: string(domain.StatusPublish)},
+		2: {ID: 2, Status: string(domain.StatusDraft)},
+		3: {ID: 3, Status: string(domain.StatusPublish)},
+	}}
+	return NewCommentUsecase(repoComment, repoArticle, zap.NewNop()), repoComment
+}
+
+func TestCreate(t *testing.T) {
+	existing := []domain.Comment{
+		{ID: 1, ArticleID: 1, Status: string(domain.CommentApproved)},
+		{ID: 2, ArticleID: 1, Status: string(domain.CommentPending)},
+		{ID: 3, ArticleID: 3, Status: string(domain.CommentApproved)},
+	}
+
+	tests := []struct {
+		name      string
+		articleID uint
+		parentID  *uint
+		wantErr   error
+	}{
+		{name: "root comment", articleID: 1},
+		{name: "reply to approved comment", articleID: 1, parentID: uintPtr(1)},
+		{name: "article not found", articleID: 99, wantErr: dto.ErrArticleNotFound},
+		{name: "article not published", articleID: 2, wantErr: dto.ErrArticleNotPublished},
+		{name: "parent not found", articleID: 1, parentID: uintPtr(99), wantErr: dto.ErrInvalidParent},
+		{name: "parent pending", articleID: 1, parentID: uintPtr(2), wantErr: dto.ErrInvalidParent},
+		{name: "parent on other article", articleID: 1, parentID: uintPtr(3), wantErr: dto.ErrInvalidParent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo := newTestUsecase(existing...)
+
+			// Status dari client di abaikan, komentar baru selalu pending
+			comment := &domain.Comment{ParentID: tt.parentID, AuthorName: "Budi", Content: "Nice", Status: string(domain.CommentApproved)}
+			got, err := uc.Create(context.Background(), tt.articleID, comment)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Create() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if repo.created != nil {
+					t.Fatal("comment must not be stored on error")
+				}
+				return
+			}
+			if got.ArticleID != tt.articleID || got.Status != string(domain.CommentPending) || got.CreatedDate.IsZero() {
+				t.Errorf("created comment = %+v, want pending comment on article %d", got, tt.articleID)
+			}
+		})
+	}
+}
+
+func TestGetThreads(t *testing.T) {
+	approved := string(domain.CommentApproved)
+	uc, _ := newTestUsecase(
+		domain.Comment{ID: 1, ArticleID: 1, Status: approved},
+		domain.Comment{ID: 2, ArticleID: 1, Status: approved},
+		domain.Comment{ID: 3, ArticleID: 1, ParentID: uintPtr(1), Status: approved},
+		domain.Comment{ID: 4, ArticleID: 1, ParentID: uintPtr(3), Status: approved},
+		domain.Comment{ID: 5, ArticleID: 1, ParentID: uintPtr(1), Status: string(domain.CommentPending)},
+		// Parent rejected, balasan approved-nya tidak boleh muncul
+		domain.Comment{ID: 6, ArticleID: 1, Status: string(domain.CommentRejected)},
+		domain.Comment{ID: 7, ArticleID: 1, ParentID: uintPtr(6), Status: approved},
+		domain.Comment{ID: 8, ArticleID: 3, Status: approved},
+	)
+
+	threads, total, err := uc.GetThreads(context.Background(), 1, dto.NewCommentFilter())
+	if err != nil {
+		t.Fatalf("GetThreads() error = %v", err)
+	}
+
+	if total != 2 || len(threads) != 2 || threads[0].ID != 1 || threads[1].ID != 2 {
+		t.Fatalf("threads = %+v (total %d), want comments 1 and 2", threads, total)
+	}
+	if replies := threads[0].Replies; len(replies) != 1 || replies[0].ID != 3 {
+		t.Fatalf("replies of 1 = %+v, want only approved reply 3", replies)
+	}
+	if nested := threads[0].Replies[0].Replies; len(nested) != 1 || nested[0].ID != 4 {
+		t.Fatalf("replies of 3 = %+v, want nested reply 4", nested)
+	}
+	if len(threads[1].Replies) != 0 {
+		t.Fatalf("replies of 2 = %+v, want none", threads[1].Replies)
+	}
+
+	if _, _, err := uc.GetThreads(context.Background(), 2, dto.NewCommentFilter()); !errors.Is(err, dto.ErrArticleNotPublished) {
+		t.Fatalf("GetThreads() on draft error = %v, want %v", err, dto.ErrArticleNotPublished)
+	}
+}
+
+func TestModerate(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         uint
+		reject     bool
+		wantStatus domain.CommentStatus
+		wantErr    error
+	}{
+		{name: "approve", id: 1, wantStatus: domain.CommentApproved},
+		{name: "reject", id: 1, reject: true, wantStatus: domain.CommentRejected},
+		{name: "not found", id: 99, wantErr: dto.ErrCommentNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, _ := newTestUsecase(domain.Comment{ID: 1, ArticleID: 1, Status: string(domain.CommentPending)})
+
+			moderate := uc.Approve
+			if tt.reject {
+				moderate = uc.Reject
+			}
+
+			got, err := moderate(context.Background(), tt.id)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("moderate() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && got.Status != string(tt.wantStatus) {
+				t.Errorf("status = %q, want %q", got.Status, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/media"
+)
+
+type MediaUsecase interface {
+	Upload(ctx context.Context, req *dto.UploadMediaRequest) (*domain.Media, error)
+	GetDetailByID(ctx context.Context, id uint) (*domain.Media, error)
+	DeleteByID(ctx context.Context, id uint) error
+}
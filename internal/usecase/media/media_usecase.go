@@ -0,0 +1,241 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/media"
+	repository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/media"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/apperror"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/imaging"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/storage"
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultMaxUploadMB    = 10
+	defaultThumbnailWidth = 320
+	defaultMaxPixels      = 40_000_000 // 40 megapixel, sekitar 160MB RGBA saat di decode
+	defaultAllowedTypes   = "image/jpeg,image/png,image/gif,image/webp,application/pdf"
+)
+
+type mediaUsecase struct {
+	repoMedia      repository.MediaRepository
+	storage        storage.Storage
+	maxSize        int64
+	allowedTypes   map[string]bool
+	thumbnailWidth int
+	maxPixels      int64
+	log            *zap.Logger
+}
+
+func NewMediaUsecase(
+	repoMedia repository.MediaRepository,
+	storage storage.Storage,
+	config *viper.Viper,
+	log *zap.Logger,
+) MediaUsecase {
+	maxUploadMB := config.GetInt64("MEDIA_MAX_SIZE_MB")
+	if maxUploadMB <= 0 {
+		maxUploadMB = defaultMaxUploadMB
+	}
+
+	thumbnailWidth := config.GetInt("MEDIA_THUMBNAIL_WIDTH")
+	if thumbnailWidth <= 0 {
+		thumbnailWidth = defaultThumbnailWidth
+	}
+
+	maxPixels := config.GetInt64("MEDIA_MAX_IMAGE_PIXELS")
+	if maxPixels <= 0 {
+		maxPixels = defaultMaxPixels
+	}
+
+	allowed := config.GetString("MEDIA_ALLOWED_TYPES")
+	if allowed == "" {
+		allowed = defaultAllowedTypes
+	}
+
+	allowedTypes := make(map[string]bool)
+	for _, contentType := range strings.Split(allowed, ",") {
+		allowedTypes[strings.TrimSpace(contentType)] = true
+	}
+
+	return &mediaUsecase{
+		repoMedia:      repoMedia,
+		storage:        storage,
+		maxSize:        maxUploadMB * 1024 * 1024,
+		allowedTypes:   allowedTypes,
+		thumbnailWidth: thumbnailWidth,
+		maxPixels:      maxPixels,
+		log:            log,
+	}
+}
+
+func (u *mediaUsecase) Upload(ctx context.Context, req *dto.UploadMediaRequest) (*domain.Media, error) {
+	logger.FromContext(ctx).Info("uploading media", zap.String("file_name", req.FileName), zap.Int64("size", req.Size))
+
+	// Validate request
+	if err := req.Validate(u.maxSize); err != nil {
+		logger.FromContext(ctx).Warn("media validation failed", zap.Error(err))
+		return nil, err
+	}
+
+	// Baca maksimal maxSize+1 byte supaya ukuran asli tetap tervalidasi
+	data, err := io.ReadAll(io.LimitReader(req.Reader, u.maxSize+1))
+	if err != nil {
+		logger.FromContext(ctx).Error("failed to read uploaded file", zap.Error(err))
+		return nil, dto.ErrFailedUploadMedia.Wrap(err)
+	}
+	if int64(len(data)) > u.maxSize {
+		return nil, dto.ErrFileTooLarge
+	}
+
+	// Content type dari isi file, bukan dari header client
+	contentType := http.DetectContentType(data)
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil {
+		contentType = mediaType
+	}
+	if !u.allowedTypes[contentType] {
+		logger.FromContext(ctx).Warn("unsupported media type", zap.String("content_type", contentType))
+		return nil, dto.ErrUnsupportedMediaType
+	}
+
+	key := u.storageKey(contentType)
+
+	media := &domain.Media{
+		FileName:    filepath.Base(req.FileName),
+		ContentType: contentType,
+		Size:        int64(len(data)),
+		StorageKey:  key,
+		URL:         u.storage.URL(key),
+		CreatedDate: time.Now(),
+	}
+
+	// Dimensi di baca dari header image, image yang terlalu besar ditolak sebelum di simpan dan di decode
+	if media.IsImage() {
+		if width, height, err := imaging.DecodeConfig(bytes.NewReader(data)); err == nil {
+			if err := imaging.CheckPixels(width, height, u.maxPixels); err != nil {
+				logger.FromContext(ctx).Warn("image dimensions too large", zap.Int("width", width), zap.Int("height", height))
+				return nil, dto.ErrImageTooLarge
+			}
+			media.Width = width
+			media.Height = height
+		}
+	}
+
+	if err := u.storage.Put(ctx, key, bytes.NewReader(data), media.Size, contentType); err != nil {
+		logger.FromContext(ctx).Error("failed to store media", zap.String("key", key), zap.Error(err))
+		return nil, dto.ErrFailedUploadMedia.Wrap(err)
+	}
+
+	if media.IsImage() {
+		u.attachThumbnail(ctx, media, data)
+	}
+
+	if err := u.repoMedia.Create(ctx, media); err != nil {
+		logger.FromContext(ctx).Error("failed to save media", zap.Error(err))
+		u.cleanupStorage(ctx, media)
+		return nil, dto.ErrFailedUploadMedia.Wrap(err)
+	}
+
+	logger.FromContext(ctx).Info("media uploaded successfully", zap.Uint("id", media.ID), zap.String("key", key))
+	return media, nil
+}
+
+// attachThumbnail gagal generate thumbnail tidak menggagalkan upload
+func (u *mediaUsecase) attachThumbnail(ctx context.Context, media *domain.Media, data []byte) {
+	thumb, err := imaging.GenerateThumbnail(data, u.thumbnailWidth, u.maxPixels)
+	if err != nil {
+		logger.FromContext(ctx).Warn("failed to generate thumbnail", zap.String("key", media.StorageKey), zap.Error(err))
+		return
+	}
+
+	ext := ".jpg"
+	if thumb.ContentType == "image/png" {
+		ext = ".png"
+	}
+	thumbKey := strings.TrimSuffix(media.StorageKey, path.Ext(media.StorageKey)) + "_thumb" + ext
+
+	if err := u.storage.Put(ctx, thumbKey, bytes.NewReader(thumb.Data), int64(len(thumb.Data)), thumb.ContentType); err != nil {
+		logger.FromContext(ctx).Warn("failed to store thumbnail", zap.String("key", thumbKey), zap.Error(err))
+		return
+	}
+
+	media.ThumbnailKey = thumbKey
+	media.ThumbnailURL = u.storage.URL(thumbKey)
+}
+
+func (u *mediaUsecase) GetDetailByID(ctx context.Context, id uint) (*domain.Media, error) {
+	media, err := u.repoMedia.GetByID(ctx, id)
+	if err != nil {
+		logger.FromContext(ctx).Warn("failed to get media", zap.Uint("id", id), zap.Error(err))
+		// Not found dari repository diteruskan, error database lain menjadi Internal
+		return nil, apperror.Wrap(err, string(dto.ErrCodeInternalError), "failed to get media")
+	}
+
+	return media, nil
+}
+
+func (u *mediaUsecase) DeleteByID(ctx context.Context, id uint) error {
+	logger.FromContext(ctx).Info("deleting media", zap.Uint("id", id))
+
+	media, err := u.repoMedia.GetByID(ctx, id)
+	if err != nil {
+		logger.FromContext(ctx).Warn("failed to get media for delete", zap.Uint("id", id), zap.Error(err))
+		return apperror.Wrap(err, string(dto.ErrCodeDeleteFailed), "failed to delete media")
+	}
+
+	if err := u.repoMedia.DeleteByID(ctx, id); err != nil {
+		logger.FromContext(ctx).Error("failed to delete media", zap.Uint("id", id), zap.Error(err))
+		return dto.ErrFailedDeleteMedia.Wrap(err)
+	}
+
+	u.cleanupStorage(ctx, media)
+
+	logger.FromContext(ctx).Info("media deleted successfully", zap.Uint("id", id))
+	return nil
+}
+
+func (u *mediaUsecase) cleanupStorage(ctx context.Context, media *domain.Media) {
+	for _, key := range []string{media.StorageKey, media.ThumbnailKey} {
+		if key == "" {
+			continue
+		}
+		if err := u.storage.Delete(ctx, key); err != nil {
+			logger.FromContext(ctx).Warn("failed to delete stored file", zap.String("key", key), zap.Error(err))
+		}
+	}
+}
+
+// Extension ditentukan dari content type hasil deteksi, bukan dari nama file client
+var mediaExtensions = map[string]string{
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"image/gif":       ".gif",
+	"image/webp":      ".webp",
+	"application/pdf": ".pdf",
+}
+
+// storageKey format: media/2006/01/<uuid>.<ext>
+func (u *mediaUsecase) storageKey(contentType string) string {
+	ext, ok := mediaExtensions[contentType]
+	if !ok {
+		if exts, err := mime.ExtensionsByType(contentType); err == nil && len(exts) > 0 {
+			ext = exts[0]
+		}
+	}
+
+	return fmt.Sprintf("media/%s/%s%s", time.Now().Format("2006/01"), uuid.New().String(), ext)
+}
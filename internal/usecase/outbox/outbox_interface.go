@@ -0,0 +1,9 @@
+package usecase
+
+import "context"
+
+// Relay kirim event dari tabel outbox ke publisher di background
+type Relay interface {
+	Start()
+	Stop(ctx context.Context) error
+}
@@ -0,0 +1,183 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	repository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/outbox"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/backoff"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/events"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/tenant"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+// Batas atas jeda retry, backoff naik 2x per attempt sampai batas ini
+const maxBackoff = 10 * time.Minute
+
+type outboxRelay struct {
+	repoOutbox   repository.OutboxRepository
+	publisher    events.Publisher
+	pollInterval time.Duration
+	batchSize    int
+	maxAttempts  int
+	lease        time.Duration // lama event di tahan relay ini sebelum boleh diambil relay lain
+	cancel       context.CancelFunc
+	done         chan struct{}
+	once         sync.Once
+	log          *zap.Logger
+}
+
+func NewOutboxRelay(repoOutbox repository.OutboxRepository, publisher events.Publisher, config *viper.Viper, log *zap.Logger) Relay {
+	pollInterval := config.GetDuration("OUTBOX_POLL_INTERVAL")
+	if pollInterval <= 0 {
+		pollInterval = 2 * time.Second
+	}
+
+	batchSize := config.GetInt("OUTBOX_BATCH_SIZE")
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+
+	maxAttempts := config.GetInt("OUTBOX_MAX_ATTEMPTS")
+	if maxAttempts <= 0 {
+		maxAttempts = 10
+	}
+
+	lease := config.GetDuration("OUTBOX_LEASE")
+	if lease <= 0 {
+		lease = 30 * time.Second
+	}
+
+	return &outboxRelay{
+		repoOutbox:   repoOutbox,
+		publisher:    publisher,
+		pollInterval: pollInterval,
+		batchSize:    batchSize,
+		maxAttempts:  maxAttempts,
+		lease:        lease,
+		done:         make(chan struct{}),
+		log:          log,
+	}
+}
+
+func (r *outboxRelay) Start() {
+	r.once.Do(func() {
+		// Antrian berisi event semua tenant, tenant per event di set saat diproses
+		ctx, cancel := context.WithCancel(tenant.WithAllTenants(context.Background()))
+		r.cancel = cancel
+
+		go r.run(ctx)
+
+		r.log.Info("outbox relay started", zap.Duration("poll_interval", r.pollInterval), zap.Int("batch_size", r.batchSize))
+	})
+}
+
+// Stop tunggu batch yang sedang dikirim selesai, event yang belum terkirim diambil lagi saat start berikutnya
+func (r *outboxRelay) Stop(ctx context.Context) error {
+	if r.cancel == nil {
+		return nil
+	}
+	r.cancel()
+
+	select {
+	case <-r.done:
+		logger.FromContext(ctx).Info("outbox relay stopped")
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (r *outboxRelay) run(ctx context.Context) {
+	defer close(r.done)
+
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Batch penuh berarti masih ada antrian, langsung lanjut tanpa menunggu tick
+		for r.relayBatch(ctx) == r.batchSize && ctx.Err() == nil {
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// relayBatch return jumlah event yang diambil dari outbox
+func (r *outboxRelay) relayBatch(ctx context.Context) int {
+	due, err := r.repoOutbox.FetchDue(ctx, time.Now(), r.batchSize)
+	if err != nil {
+		if ctx.Err() == nil {
+			logger.FromContext(ctx).Error("failed to fetch outbox events", zap.Error(err))
+		}
+		return 0
+	}
+
+	for i := range due {
+		if ctx.Err() != nil {
+			break
+		}
+		r.deliver(ctx, &due[i])
+	}
+
+	return len(due)
+}
+
+func (r *outboxRelay) deliver(ctx context.Context, outboxEvent *domain.OutboxEvent) {
+	claimed, err := r.repoOutbox.Claim(ctx, outboxEvent, time.Now().Add(r.lease))
+	if err != nil || !claimed {
+		return
+	}
+
+	publishCtx, cancel := context.WithTimeout(ctx, r.lease)
+	defer cancel()
+
+	// Status akhir tetap ditulis walaupun relay sedang di stop
+	writeCtx := context.WithoutCancel(ctx)
+
+	if err := r.publisher.Publish(publishCtx, toEvent(outboxEvent)); err != nil {
+		if outboxEvent.Attempts >= r.maxAttempts {
+			logger.FromContext(ctx).Error("outbox event moved to dead letter",
+				zap.String("event_id", outboxEvent.EventID),
+				zap.String("event", outboxEvent.EventType),
+				zap.Int("attempts", outboxEvent.Attempts),
+				zap.Error(err),
+			)
+			r.repoOutbox.MarkDead(writeCtx, outboxEvent.ID, time.Now(), err.Error())
+			return
+		}
+
+		next := time.Now().Add(backoff.Exponential(outboxEvent.Attempts, time.Second, maxBackoff))
+		logger.FromContext(ctx).Warn("failed to publish outbox event, will retry",
+			zap.String("event_id", outboxEvent.EventID),
+			zap.String("event", outboxEvent.EventType),
+			zap.Int("attempts", outboxEvent.Attempts),
+			zap.Time("next_attempt_at", next),
+			zap.Error(err),
+		)
+		r.repoOutbox.Reschedule(writeCtx, outboxEvent.ID, next, err.Error())
+		return
+	}
+
+	r.repoOutbox.MarkPublished(writeCtx, outboxEvent.ID, time.Now())
+}
+
+func toEvent(outboxEvent *domain.OutboxEvent) events.Event {
+	return events.Event{
+		ID:            outboxEvent.EventID,
+		Type:          outboxEvent.EventType,
+		AggregateType: outboxEvent.AggregateType,
+		AggregateID:   outboxEvent.AggregateID,
+		TenantID:      outboxEvent.TenantID,
+		Payload:       outboxEvent.Payload,
+		OccurredAt:    outboxEvent.OccurredAt,
+	}
+}
@@ -0,0 +1,324 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	repository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/outbox"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/events"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+// fakeOutboxRepository tabel outbox di memory dengan aturan FetchDue dan Claim yang sama seperti query MySQL
+type fakeOutboxRepository struct {
+	repository.OutboxRepository
+	mu     sync.Mutex
+	events []domain.OutboxEvent
+
+	// stolen event yang sudah di claim relay lain sebelum relay ini
+	stolen map[uint]bool
+}
+
+func (f *fakeOutboxRepository) FetchDue(_ context.Context, now time.Time, limit int) ([]domain.OutboxEvent, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var due []domain.OutboxEvent
+	for _, event := range f.events {
+		if !pending(event) || event.NextAttemptAt.After(now) || f.blocked(event) {
+			continue
+		}
+		due = append(due, event)
+		if len(due) == limit {
+			break
+		}
+	}
+	return due, nil
+}
+
+// blocked ada event lebih lama yang belum selesai untuk aggregate yang sama
+func (f *fakeOutboxRepository) blocked(event domain.OutboxEvent) bool {
+	for _, previous := range f.events {
+		if previous.ID < event.ID && pending(previous) &&
+			previous.TenantID == event.TenantID &&
+			previous.AggregateType == event.AggregateType &&
+			previous.AggregateID == event.AggregateID {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *fakeOutboxRepository) Claim(_ context.Context, event *domain.OutboxEvent, leaseUntil time.Time) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	stored := f.find(event.ID)
+	if f.stolen[event.ID] {
+		stored.Attempts++
+	}
+	if stored.Attempts != event.Attempts || !pending(*stored) {
+		return false, nil
+	}
+
+	stored.Attempts++
+	stored.NextAttemptAt = leaseUntil
+	event.Attempts = stored.Attempts
+	event.NextAttemptAt = leaseUntil
+	return true, nil
+}
+
+func (f *fakeOutboxRepository) MarkPublished(_ context.Context, id uint, publishedAt time.Time) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.find(id).PublishedAt = &publishedAt
+	return nil
+}
+
+func (f *fakeOutboxRepository) Reschedule(_ context.Context, id uint, nextAttemptAt time.Time, lastError string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	stored := f.find(id)
+	stored.NextAttemptAt = nextAttemptAt
+	stored.LastError = lastError
+	return nil
+}
+
+func (f *fakeOutboxRepository) MarkDead(_ context.Context, id uint, failedAt time.Time, lastError string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	stored := f.find(id)
+	stored.FailedAt = &failedAt
+	stored.LastError = lastError
+	return nil
+}
+
+func (f *fakeOutboxRepository) find(id uint) *domain.OutboxEvent {
+	for i := range f.events {
+		if f.events[i].ID == id {
+			return &f.events[i]
+		}
+	}
+	panic("outbox event not found")
+}
+
+func (f *fakeOutboxRepository) get(id uint) domain.OutboxEvent {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return *f.find(id)
+}
+
+func pending(event domain.OutboxEvent) bool {
+	return event.PublishedAt == nil && event.FailedAt == nil
+}
+
+// recordingPublisher catat urutan event yang terkirim, failures jumlah kegagalan per event ID
+type recordingPublisher struct {
+	mu        sync.Mutex
+	published []string
+	failures  map[string]int
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, event events.Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.failures[event.ID] > 0 {
+		p.failures[event.ID]--
+		return errors.New("broker unavailable")
+	}
+	p.published = append(p.published, event.ID)
+	return nil
+}
+
+func (p *recordingPublisher) ids() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.published...)
+}
+
+func newTestRelay(repo *fakeOutboxRepository, publisher events.Publisher, batchSize int) *outboxRelay {
+	config := viper.New()
+	config.Set("OUTBOX_POLL_INTERVAL", time.Hour)
+	config.Set("OUTBOX_BATCH_SIZE", batchSize)
+	config.Set("OUTBOX_MAX_ATTEMPTS", 3)
+	return NewOutboxRelay(repo, publisher, config, zap.NewNop()).(*outboxRelay)
+}
+
+func articleEvent(id uint, eventID, tenantID string, articleID uint) domain.OutboxEvent {
+	return domain.OutboxEvent{
+		ID:            id,
+		EventID:       eventID,
+		TenantID:      tenantID,
+		EventType:     "article.updated",
+		AggregateType: "article",
+		AggregateID:   articleID,
+		Payload:       []byte(`{"id":1}`),
+		NextAttemptAt: time.Now().Add(-time.Second),
+	}
+}
+
+func TestRelayDeliver(t *testing.T) {
+	tests := []struct {
+		name          string
+		attempts      int
+		failures      int
+		stolen        bool
+		wantPublished bool
+		wantDead      bool
+		wantAttempts  int
+		wantBackoff   time.Duration
+	}{
+		{name: "published", wantPublished: true, wantAttempts: 1},
+		{name: "first failure is retried after base backoff", failures: 1, wantAttempts: 1, wantBackoff: time.Second},
+		{name: "backoff doubles per attempt", attempts: 1, failures: 1, wantAttempts: 2, wantBackoff: 2 * time.Second},
+		{name: "dead letter after max attempts", attempts: 2, failures: 1, wantDead: true, wantAttempts: 3},
+		{name: "claimed by another relay", stolen: true, wantAttempts: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := articleEvent(1, "evt-1", "brand-a", 1)
+			event.Attempts = tt.attempts
+			repo := &fakeOutboxRepository{events: []domain.OutboxEvent{event}, stolen: map[uint]bool{1: tt.stolen}}
+			publisher := &recordingPublisher{failures: map[string]int{"evt-1": tt.failures}}
+
+			start := time.Now()
+			if got := newTestRelay(repo, publisher, 10).relayBatch(context.Background()); got != 1 {
+				t.Fatalf("relayBatch() = %d, want 1", got)
+			}
+
+			stored := repo.get(1)
+			if published := len(publisher.ids()) == 1; published != tt.wantPublished || (stored.PublishedAt != nil) != tt.wantPublished {
+				t.Errorf("published = %v (published_at %v), want %v", published, stored.PublishedAt, tt.wantPublished)
+			}
+			if (stored.FailedAt != nil) != tt.wantDead {
+				t.Errorf("failed_at = %v, want dead %v", stored.FailedAt, tt.wantDead)
+			}
+			if stored.Attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", stored.Attempts, tt.wantAttempts)
+			}
+			if tt.failures > 0 && stored.LastError != "broker unavailable" {
+				t.Errorf("last_error = %q", stored.LastError)
+			}
+			if tt.wantBackoff > 0 {
+				delay := stored.NextAttemptAt.Sub(start)
+				if delay < tt.wantBackoff || delay > tt.wantBackoff+time.Second {
+					t.Errorf("next attempt in %s, want about %s", delay, tt.wantBackoff)
+				}
+			}
+		})
+	}
+}
+
+func TestRelayKeepsOrderPerAggregate(t *testing.T) {
+	repo := &fakeOutboxRepository{events: []domain.OutboxEvent{
+		articleEvent(1, "a1-first", "brand-a", 1),
+		articleEvent(2, "a1-second", "brand-a", 1),
+		articleEvent(3, "a2-first", "brand-a", 2),
+		// Article dengan ID sama di tenant lain adalah aggregate yang berbeda
+		articleEvent(4, "b1-first", "brand-b", 1),
+	}}
+	publisher := &recordingPublisher{failures: map[string]int{"a1-first": 1}}
+	relay := newTestRelay(repo, publisher, 10)
+	ctx := context.Background()
+
+	// a1-first gagal dan di reschedule, a1-second harus menunggu walaupun sudah due
+	relay.relayBatch(ctx)
+	if got, want := publisher.ids(), []string{"a2-first", "b1-first"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("after first batch published = %v, want %v", got, want)
+	}
+
+	if got := relay.relayBatch(ctx); got != 0 {
+		t.Fatalf("relayBatch() during backoff = %d, want 0", got)
+	}
+
+	// Backoff selesai
+	repo.mu.Lock()
+	repo.find(1).NextAttemptAt = time.Now().Add(-time.Second)
+	repo.mu.Unlock()
+
+	relay.relayBatch(ctx)
+	relay.relayBatch(ctx)
+	if got, want := publisher.ids(), []string{"a2-first", "b1-first", "a1-first", "a1-second"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("published = %v, want %v", got, want)
+	}
+}
+
+func TestRelayDeadEventUnblocksAggregate(t *testing.T) {
+	first := articleEvent(1, "a1-first", "brand-a", 1)
+	first.Attempts = 2
+	repo := &fakeOutboxRepository{events: []domain.OutboxEvent{first, articleEvent(2, "a1-second", "brand-a", 1)}}
+	publisher := &recordingPublisher{failures: map[string]int{"a1-first": 1}}
+	relay := newTestRelay(repo, publisher, 10)
+
+	relay.relayBatch(context.Background())
+	relay.relayBatch(context.Background())
+
+	if repo.get(1).FailedAt == nil {
+		t.Fatal("first event must be moved to dead letter")
+	}
+	if got, want := publisher.ids(), []string{"a1-second"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("published = %v, want %v", got, want)
+	}
+}
+
+func TestRelayStartDrainsFullBatches(t *testing.T) {
+	repo := &fakeOutboxRepository{}
+	for i := uint(1); i <= 5; i++ {
+		repo.events = append(repo.events, articleEvent(i, "evt", "brand-a", i))
+	}
+	publisher := &recordingPublisher{}
+
+	// Poll interval 1 jam, semua event harus terkirim tanpa menunggu tick
+	relay := newTestRelay(repo, publisher, 2)
+	if err := relay.Stop(context.Background()); err != nil {
+		t.Fatalf("Stop() before Start() error = %v", err)
+	}
+
+	relay.Start()
+	relay.Start()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for len(publisher.ids()) < 5 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := relay.Stop(ctx); err != nil {
+		t.Fatalf("Stop() error = %v", err)
+	}
+
+	if got := len(publisher.ids()); got != 5 {
+		t.Fatalf("published %d events, want 5", got)
+	}
+}
+
+func TestToEvent(t *testing.T) {
+	occurred := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	outboxEvent := articleEvent(1, "evt-1", "brand-a", 7)
+	outboxEvent.OccurredAt = occurred
+
+	want := events.Event{
+		ID:            "evt-1",
+		Type:          "article.updated",
+		AggregateType: "article",
+		AggregateID:   7,
+		TenantID:      "brand-a",
+		Payload:       []byte(`{"id":1}`),
+		OccurredAt:    occurred,
+	}
+	if got := toEvent(&outboxEvent); !reflect.DeepEqual(got, want) {
+		t.Fatalf("toEvent() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/review"
+)
+
+type ReviewUsecase interface {
+	Submit(ctx context.Context, articleID uint, request *dto.SubmitReviewRequest) ([]domain.Review, error)
+	GetByArticle(ctx context.Context, articleID uint) ([]domain.Review, error)
+	Approve(ctx context.Context, articleID, reviewID uint, request *dto.DecisionRequest) (*domain.Review, error)
+	RequestChanges(ctx context.Context, articleID, reviewID uint, request *dto.DecisionRequest) (*domain.Review, error)
+}
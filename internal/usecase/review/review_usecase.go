@@ -0,0 +1,296 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	articleDto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/article"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/review"
+	articleRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/article"
+	repository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/review"
+	articleUsecase "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/usecase/article"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/apperror"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/notifier"
+	"go.uber.org/zap"
+)
+
+type reviewUsecase struct {
+	repoReview  repository.ReviewRepository
+	repoArticle articleRepository.ArticleRepository
+	articles    articleUsecase.ArticleUsecase
+	notifier    notifier.Notifier
+	log         *zap.Logger
+}
+
+func NewReviewUsecase(
+	repoReview repository.ReviewRepository,
+	repoArticle articleRepository.ArticleRepository,
+	articles articleUsecase.ArticleUsecase,
+	notifier notifier.Notifier,
+	log *zap.Logger,
+) ReviewUsecase {
+	return &reviewUsecase{
+		repoReview:  repoReview,
+		repoArticle: repoArticle,
+		articles:    articles,
+		notifier:    notifier,
+		log:         log,
+	}
+}
+
+// Submit kirim artikel Draft ke reviewer. Setiap submit membuka round baru,
+// review lama yang masih pending dibatalkan dan approval sebelumnya dihapus
+func (u *reviewUsecase) Submit(ctx context.Context, articleID uint, request *dto.SubmitReviewRequest) ([]domain.Review, error) {
+	logger.FromContext(ctx).Info("submitting article for review", zap.Uint("article_id", articleID))
+
+	var reviews []domain.Review
+	var round int
+	article, err := u.articles.TransitionReview(ctx, articleID, func(ctx context.Context, article *domain.Article) (*domain.ReviewTransition, error) {
+		if domain.ArticleStatus(article.Status) != domain.StatusDraft {
+			logger.FromContext(ctx).Warn("article cannot be submitted for review", zap.Uint("article_id", articleID), zap.String("status", article.Status))
+			return nil, dto.ErrInvalidSubmit
+		}
+
+		latest, err := u.repoReview.LatestRound(ctx, articleID)
+		if err != nil {
+			return nil, err
+		}
+		round = latest + 1
+
+		now := time.Now()
+		reviews = make([]domain.Review, len(request.Reviewers))
+		for i, reviewer := range request.Reviewers {
+			reviews[i] = domain.Review{
+				ArticleID:   articleID,
+				Round:       round,
+				Author:      request.Author,
+				Reviewer:    reviewer,
+				Status:      string(domain.ReviewPending),
+				Note:        request.Note,
+				CreatedDate: now,
+			}
+		}
+
+		if err := u.repoReview.CreateRound(ctx, reviews); err != nil {
+			return nil, err
+		}
+
+		return &domain.ReviewTransition{Status: domain.StatusInReview}, nil
+	})
+	if err != nil {
+		return nil, transitionError(err, dto.ErrFailedSubmitReview)
+	}
+
+	// Notifikasi setelah commit, review yang di rollback tidak pernah dikabarkan
+	for _, review := range reviews {
+		u.notify(ctx, notifier.Event{
+			Type:         notifier.EventReviewRequested,
+			ArticleID:    articleID,
+			ArticleTitle: article.Title,
+			ReviewID:     review.ID,
+			Recipient:    review.Reviewer,
+			Actor:        review.Author,
+			Comment:      review.Note,
+		})
+	}
+
+	logger.FromContext(ctx).Info("article submitted for review", zap.Uint("article_id", articleID), zap.Int("round", round))
+	return reviews, nil
+}
+
+func (u *reviewUsecase) GetByArticle(ctx context.Context, articleID uint) ([]domain.Review, error) {
+	logger.FromContext(ctx).Info("getting article reviews", zap.Uint("article_id", articleID))
+
+	if _, err := u.getArticle(ctx, articleID); err != nil {
+		return nil, err
+	}
+
+	reviews, err := u.repoReview.GetByArticle(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	return reviews, nil
+}
+
+// Approve simpan persetujuan satu reviewer. Artikel baru dianggap approved jika
+// semua reviewer pada round tersebut sudah approve, lalu kembali ke Draft siap di publish
+func (u *reviewUsecase) Approve(ctx context.Context, articleID, reviewID uint, request *dto.DecisionRequest) (*domain.Review, error) {
+	logger.FromContext(ctx).Info("approving review", zap.Uint("article_id", articleID), zap.Uint("review_id", reviewID))
+
+	var review *domain.Review
+	approved := false
+	article, err := u.articles.TransitionReview(ctx, articleID, func(ctx context.Context, article *domain.Article) (*domain.ReviewTransition, error) {
+		var err error
+		if review, err = u.prepareDecision(ctx, article, reviewID, request.Reviewer); err != nil {
+			return nil, err
+		}
+
+		if err := u.repoReview.UpdateDecision(ctx, review.ID, string(domain.ReviewApproved), request.Comment); err != nil {
+			return nil, err
+		}
+
+		round, err := u.repoReview.GetByRound(ctx, articleID, review.Round)
+		if err != nil {
+			return nil, err
+		}
+
+		if !allApproved(round) {
+			return nil, nil
+		}
+
+		approved = true
+		approvedAt := time.Now()
+		return &domain.ReviewTransition{Status: domain.StatusDraft, ApprovedAt: &approvedAt}, nil
+	})
+	if err != nil {
+		return nil, transitionError(err, dto.ErrFailedDecideReview)
+	}
+
+	u.notify(ctx, notifier.Event{
+		Type:         notifier.EventReviewApproved,
+		ArticleID:    articleID,
+		ArticleTitle: article.Title,
+		ReviewID:     review.ID,
+		Recipient:    review.Author,
+		Actor:        review.Reviewer,
+		Comment:      request.Comment,
+	})
+
+	if approved {
+		u.notify(ctx, notifier.Event{
+			Type:         notifier.EventArticleApproved,
+			ArticleID:    articleID,
+			ArticleTitle: article.Title,
+			Recipient:    review.Author,
+			Actor:        review.Reviewer,
+		})
+
+		logger.FromContext(ctx).Info("article approved by all reviewers", zap.Uint("article_id", articleID), zap.Int("round", review.Round))
+	}
+
+	return u.repoReview.GetDetailByID(ctx, articleID, review.ID)
+}
+
+// RequestChanges kembalikan artikel ke Draft. Review lain pada round yang sama
+// dibatalkan karena author harus submit ulang setelah revisi
+func (u *reviewUsecase) RequestChanges(ctx context.Context, articleID, reviewID uint, request *dto.DecisionRequest) (*domain.Review, error) {
+	logger.FromContext(ctx).Info("requesting changes on review", zap.Uint("article_id", articleID), zap.Uint("review_id", reviewID))
+
+	var review *domain.Review
+	article, err := u.articles.TransitionReview(ctx, articleID, func(ctx context.Context, article *domain.Article) (*domain.ReviewTransition, error) {
+		var err error
+		if review, err = u.prepareDecision(ctx, article, reviewID, request.Reviewer); err != nil {
+			return nil, err
+		}
+
+		if err := u.repoReview.UpdateDecision(ctx, review.ID, string(domain.ReviewChangesRequested), request.Comment); err != nil {
+			return nil, err
+		}
+
+		if err := u.repoReview.CancelPending(ctx, articleID); err != nil {
+			return nil, err
+		}
+
+		return &domain.ReviewTransition{Status: domain.StatusDraft}, nil
+	})
+	if err != nil {
+		return nil, transitionError(err, dto.ErrFailedDecideReview)
+	}
+
+	u.notify(ctx, notifier.Event{
+		Type:         notifier.EventReviewChangesRequested,
+		ArticleID:    articleID,
+		ArticleTitle: article.Title,
+		ReviewID:     review.ID,
+		Recipient:    review.Author,
+		Actor:        review.Reviewer,
+		Comment:      request.Comment,
+	})
+
+	return u.repoReview.GetDetailByID(ctx, articleID, review.ID)
+}
+
+// prepareDecision pastikan review milik artikel, artikel sedang In Review dan reviewer sesuai assignment
+func (u *reviewUsecase) prepareDecision(ctx context.Context, article *domain.Article, reviewID uint, reviewer string) (*domain.Review, error) {
+	// Review hanya ditemukan jika milik artikel ini, artikel sudah ter-scope tenant
+	review, err := u.repoReview.GetDetailByID(ctx, article.ID, reviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !article.IsInReview() {
+		logger.FromContext(ctx).Warn("article is not in review", zap.Uint("article_id", article.ID), zap.String("status", article.Status))
+		return nil, dto.ErrArticleNotInReview
+	}
+
+	// Author tidak boleh memutuskan review artikelnya sendiri walaupun namanya ada di assignment
+	if strings.EqualFold(review.Author, reviewer) {
+		logger.FromContext(ctx).Warn("author tried to decide own review", zap.Uint("review_id", reviewID), zap.String("reviewer", reviewer))
+		return nil, dto.ErrSelfReview
+	}
+
+	if !strings.EqualFold(review.Reviewer, reviewer) {
+		logger.FromContext(ctx).Warn("reviewer mismatch", zap.Uint("review_id", reviewID), zap.String("reviewer", reviewer))
+		return nil, dto.ErrReviewerMismatch
+	}
+
+	if !review.IsPending() {
+		return nil, dto.ErrReviewAlreadyClosed
+	}
+
+	return review, nil
+}
+
+func (u *reviewUsecase) getArticle(ctx context.Context, articleID uint) (*domain.Article, error) {
+	article, err := u.repoArticle.GetDetailByID(ctx, articleID)
+	if err != nil {
+		if errors.Is(err, articleDto.ErrArticleNotFound) {
+			return nil, dto.ErrArticleNotFound
+		}
+		logger.FromContext(ctx).Error("failed to get article", zap.Uint("article_id", articleID), zap.Error(err))
+		return nil, err
+	}
+
+	return article, nil
+}
+
+// notify gagal kirim notifikasi tidak membatalkan perubahan status review
+func (u *reviewUsecase) notify(ctx context.Context, event notifier.Event) {
+	event.OccurredAt = time.Now()
+	if err := u.notifier.Notify(ctx, event); err != nil {
+		logger.FromContext(ctx).Warn("failed to send review notification", zap.String("event", event.Type), zap.Uint("article_id", event.ArticleID), zap.Error(err))
+	}
+}
+
+// transitionError error domain (not found, conflict, forbidden) dikembalikan apa adanya,
+// error database dibungkus failed
+func transitionError(err error, failed *apperror.Error) error {
+	if errors.Is(err, articleDto.ErrArticleNotFound) {
+		return dto.ErrArticleNotFound
+	}
+
+	if _, ok := apperror.As(err); ok {
+		return err
+	}
+
+	return failed.Wrap(err)
+}
+
+func allApproved(reviews []domain.Review) bool {
+	if len(reviews) == 0 {
+		return false
+	}
+
+	for _, review := range reviews {
+		if domain.ReviewStatus(review.Status) != domain.ReviewApproved {
+			return false
+		}
+	}
+
+	return true
+}
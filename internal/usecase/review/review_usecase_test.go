@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/review"
+	repository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/review"
+	articleUsecase "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/usecase/article"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/notifier"
+	"go.uber.org/zap"
+)
+
+// fakeReviewRepository satu round review di memory
+type fakeReviewRepository struct {
+	repository.ReviewRepository
+	reviews   []domain.Review
+	decisions int
+}
+
+func (f *fakeReviewRepository) GetDetailByID(_ context.Context, articleID, id uint) (*domain.Review, error) {
+	for _, review := range f.reviews {
+		if review.ArticleID == articleID && review.ID == id {
+			copied := review
+			return &copied, nil
+		}
+	}
+	return nil, dto.ErrReviewNotFound
+}
+
+func (f *fakeReviewRepository) UpdateDecision(_ context.Context, id uint, status, comment string) error {
+	f.decisions++
+	for i := range f.reviews {
+		if f.reviews[i].ID == id {
+			f.reviews[i].Status = status
+			f.reviews[i].Comment = comment
+		}
+	}
+	return nil
+}
+
+func (f *fakeReviewRepository) GetByRound(_ context.Context, _ uint, round int) ([]domain.Review, error) {
+	var reviews []domain.Review
+	for _, review := range f.reviews {
+		if review.Round == round {
+			reviews = append(reviews, review)
+		}
+	}
+	return reviews, nil
+}
+
+// fakeArticleUsecase TransitionReview tanpa database, artikel selalu In Review
+type fakeArticleUsecase struct {
+	articleUsecase.ArticleUsecase
+	article    *domain.Article
+	transition *domain.ReviewTransition
+}
+
+func (f *fakeArticleUsecase) TransitionReview(ctx context.Context, _ uint, apply func(ctx context.Context, article *domain.Article) (*domain.ReviewTransition, error)) (*domain.Article, error) {
+	transition, err := apply(ctx, f.article)
+	if err != nil {
+		return nil, err
+	}
+	f.transition = transition
+	return f.article, nil
+}
+
+type fakeNotifier struct{}
+
+func (fakeNotifier) Notify(context.Context, notifier.Event) error {
+	return nil
+}
+
+func TestApproveChecksReviewer(t *testing.T) {
+	tests := []struct {
+		name         string
+		reviewer     string
+		assigned     string // reviewer yang ditugaskan di review 1
+		wantErr      error
+		wantApproved bool
+	}{
+		{name: "assigned reviewer approves", reviewer: "bob", assigned: "bob", wantApproved: true},
+		{name: "assigned reviewer is case insensitive", reviewer: "Bob", assigned: "bob", wantApproved: true},
+		{name: "reviewer not in assignment", reviewer: "carol", assigned: "bob", wantErr: dto.ErrReviewerMismatch},
+		{name: "author cannot approve own article", reviewer: "alice", assigned: "bob", wantErr: dto.ErrSelfReview},
+		{name: "author cannot approve even when assigned", reviewer: "alice", assigned: "alice", wantErr: dto.ErrSelfReview},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeReviewRepository{reviews: []domain.Review{
+				{ID: 1, ArticleID: 10, Round: 1, Author: "alice", Reviewer: tt.assigned, Status: string(domain.ReviewPending)},
+			}}
+			articles := &fakeArticleUsecase{article: &domain.Article{ID: 10, Title: "Article", Status: string(domain.StatusInReview)}}
+			u := NewReviewUsecase(repo, nil, articles, fakeNotifier{}, zap.NewNop())
+
+			_, err := u.Approve(context.Background(), 10, 1, &dto.DecisionRequest{Reviewer: tt.reviewer})
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+				if repo.decisions != 0 {
+					t.Fatal("rejected decision must not be stored")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Approve: %v", err)
+			}
+			approved := articles.transition != nil && articles.transition.ApprovedAt != nil
+			if approved != tt.wantApproved {
+				t.Fatalf("article approved = %v, want %v", approved, tt.wantApproved)
+			}
+		})
+	}
+}
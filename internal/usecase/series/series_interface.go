@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/series"
+)
+
+type SeriesUsecase interface {
+	Create(ctx context.Context, series *domain.Series) (*domain.Series, error)
+	GetList(ctx context.Context, seriesFilter *dto.SeriesFilter) ([]domain.Series, int64, error)
+	GetDetailByID(ctx context.Context, id uint) (*domain.Series, error)
+	UpdateByID(ctx context.Context, id uint, updateReq *dto.UpdateSeriesRequest) (*domain.Series, error)
+	DeleteByID(ctx context.Context, id uint) error
+
+	// Membership
+	AddArticle(ctx context.Context, seriesID, articleID uint, position int) (*domain.Series, error)
+	RemoveArticle(ctx context.Context, seriesID, articleID uint) (*domain.Series, error)
+	Reorder(ctx context.Context, seriesID uint, articleIDs []uint) (*domain.Series, error)
+}
@@ -0,0 +1,286 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	articleDto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/article"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/series"
+	articleRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/article"
+	repository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/series"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/apperror"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"go.uber.org/zap"
+)
+
+type seriesUsecase struct {
+	repoSeries  repository.SeriesRepository
+	repoArticle articleRepository.ArticleRepository
+	log         *zap.Logger
+}
+
+func NewSeriesUsecase(
+	repoSeries repository.SeriesRepository,
+	repoArticle articleRepository.ArticleRepository,
+	log *zap.Logger,
+) SeriesUsecase {
+	return &seriesUsecase{
+		repoSeries:  repoSeries,
+		repoArticle: repoArticle,
+		log:         log,
+	}
+}
+
+func (u *seriesUsecase) Create(ctx context.Context, series *domain.Series) (*domain.Series, error) {
+	logger.FromContext(ctx).Info("creating new series", zap.String("title", series.Title))
+
+	if err := series.Validate(); err != nil {
+		logger.FromContext(ctx).Warn("series validation failed", zap.Error(err))
+		// Aturan domain dilanggar, dilaporkan sebagai error validasi (400)
+		return nil, apperror.Validation(string(dto.ErrCodeValidation), err.Error())
+	}
+
+	series.EnsureSlug()
+	if err := u.ensureSlugAvailable(ctx, series.Slug, 0); err != nil {
+		return nil, err
+	}
+
+	series.CreatedDate = time.Now()
+	series.UpdatedDate = time.Now()
+
+	if err := u.repoSeries.Create(ctx, series); err != nil {
+		logger.FromContext(ctx).Error("failed to save series", zap.Error(err))
+		return nil, dto.ErrFailedCreateSeries.Wrap(err)
+	}
+	series.Articles = []domain.Article{}
+
+	logger.FromContext(ctx).Info("series created successfully", zap.Uint("id", series.ID))
+	return series, nil
+}
+
+func (u *seriesUsecase) GetList(ctx context.Context, seriesFilter *dto.SeriesFilter) ([]domain.Series, int64, error) {
+	logger.FromContext(ctx).Info("getting series list", zap.Int("page", seriesFilter.GetDefaultPage()))
+
+	if err := seriesFilter.Validate(); err != nil {
+		return nil, 0, err
+	}
+
+	series, total, err := u.repoSeries.GetList(ctx, seriesFilter)
+	if err != nil {
+		logger.FromContext(ctx).Error("failed to get series list", zap.Error(err))
+		return nil, 0, err
+	}
+
+	return series, total, nil
+}
+
+func (u *seriesUsecase) GetDetailByID(ctx context.Context, id uint) (*domain.Series, error) {
+	logger.FromContext(ctx).Info("getting series detail", zap.Uint("id", id))
+
+	series, err := u.repoSeries.GetDetailByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.loadArticles(ctx, series); err != nil {
+		return nil, err
+	}
+
+	return series, nil
+}
+
+func (u *seriesUsecase) UpdateByID(ctx context.Context, id uint, updateReq *dto.UpdateSeriesRequest) (*domain.Series, error) {
+	logger.FromContext(ctx).Info("updating series", zap.Uint("id", id))
+
+	if err := updateReq.Validate(); err != nil {
+		return nil, err
+	}
+
+	series, err := u.repoSeries.GetDetailByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if updateReq.Title != "" && updateReq.Title != series.Title {
+		series.Title = updateReq.Title
+		series.Slug = domain.Slugify(series.Title)
+		if err := u.ensureSlugAvailable(ctx, series.Slug, id); err != nil {
+			return nil, err
+		}
+	}
+
+	if updateReq.Description != nil {
+		series.Description = *updateReq.Description
+	}
+
+	series.UpdatedDate = time.Now()
+
+	if err := u.repoSeries.UpdateByID(ctx, id, series); err != nil {
+		logger.FromContext(ctx).Error("failed to update series", zap.Uint("id", id), zap.Error(err))
+		return nil, dto.ErrFailedUpdateSeries.Wrap(err)
+	}
+
+	if err := u.loadArticles(ctx, series); err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("series updated successfully", zap.Uint("id", id))
+	return series, nil
+}
+
+func (u *seriesUsecase) DeleteByID(ctx context.Context, id uint) error {
+	logger.FromContext(ctx).Info("deleting series", zap.Uint("id", id))
+
+	if _, err := u.repoSeries.GetDetailByID(ctx, id); err != nil {
+		return err
+	}
+
+	if err := u.repoSeries.DeleteByID(ctx, id); err != nil {
+		logger.FromContext(ctx).Error("failed to delete series", zap.Uint("id", id), zap.Error(err))
+		return dto.ErrFailedDeleteSeries.Wrap(err)
+	}
+
+	logger.FromContext(ctx).Info("series deleted successfully", zap.Uint("id", id))
+	return nil
+}
+
+// AddArticle sisipkan article pada posisi tertentu (1-based), 0 berarti di akhir series
+func (u *seriesUsecase) AddArticle(ctx context.Context, seriesID, articleID uint, position int) (*domain.Series, error) {
+	logger.FromContext(ctx).Info("adding article to series", zap.Uint("series_id", seriesID), zap.Uint("article_id", articleID), zap.Int("position", position))
+
+	series, ids, err := u.getMembership(ctx, seriesID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := u.repoArticle.GetDetailByID(ctx, articleID); err != nil {
+		if errors.Is(err, articleDto.ErrArticleNotFound) {
+			return nil, dto.ErrArticleNotFound
+		}
+		logger.FromContext(ctx).Error("failed to get article", zap.Uint("article_id", articleID), zap.Error(err))
+		return nil, dto.ErrFailedUpdateSeries.Wrap(err)
+	}
+
+	if indexOf(ids, articleID) >= 0 {
+		return nil, dto.ErrArticleInSeries
+	}
+
+	if position < 1 || position > len(ids) {
+		ids = append(ids, articleID)
+	} else {
+		ids = append(ids[:position-1], append([]uint{articleID}, ids[position-1:]...)...)
+	}
+
+	return u.saveMembership(ctx, series, ids)
+}
+
+func (u *seriesUsecase) RemoveArticle(ctx context.Context, seriesID, articleID uint) (*domain.Series, error) {
+	logger.FromContext(ctx).Info("removing article from series", zap.Uint("series_id", seriesID), zap.Uint("article_id", articleID))
+
+	series, ids, err := u.getMembership(ctx, seriesID)
+	if err != nil {
+		return nil, err
+	}
+
+	i := indexOf(ids, articleID)
+	if i < 0 {
+		return nil, dto.ErrArticleNotInSeries
+	}
+
+	return u.saveMembership(ctx, series, append(ids[:i], ids[i+1:]...))
+}
+
+// Reorder articleIDs harus berisi semua anggota series tepat satu kali
+func (u *seriesUsecase) Reorder(ctx context.Context, seriesID uint, articleIDs []uint) (*domain.Series, error) {
+	logger.FromContext(ctx).Info("reordering series", zap.Uint("series_id", seriesID), zap.Int("count", len(articleIDs)))
+
+	series, ids, err := u.getMembership(ctx, seriesID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(articleIDs) != len(ids) {
+		return nil, dto.ErrInvalidOrder
+	}
+
+	seen := make(map[uint]bool, len(articleIDs))
+	for _, id := range articleIDs {
+		if seen[id] || indexOf(ids, id) < 0 {
+			return nil, dto.ErrInvalidOrder
+		}
+		seen[id] = true
+	}
+
+	return u.saveMembership(ctx, series, articleIDs)
+}
+
+func (u *seriesUsecase) getMembership(ctx context.Context, seriesID uint) (*domain.Series, []uint, error) {
+	series, err := u.repoSeries.GetDetailByID(ctx, seriesID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := u.loadArticles(ctx, series); err != nil {
+		return nil, nil, err
+	}
+
+	ids := make([]uint, len(series.Articles))
+	for i, article := range series.Articles {
+		ids[i] = article.ID
+	}
+
+	return series, ids, nil
+}
+
+func (u *seriesUsecase) saveMembership(ctx context.Context, series *domain.Series, articleIDs []uint) (*domain.Series, error) {
+	if err := u.repoSeries.SetArticles(ctx, series.ID, articleIDs); err != nil {
+		logger.FromContext(ctx).Error("failed to save series articles", zap.Uint("series_id", series.ID), zap.Error(err))
+		return nil, dto.ErrFailedUpdateSeries.Wrap(err)
+	}
+
+	if err := u.loadArticles(ctx, series); err != nil {
+		return nil, err
+	}
+
+	return series, nil
+}
+
+func (u *seriesUsecase) loadArticles(ctx context.Context, series *domain.Series) error {
+	articles, err := u.repoSeries.GetArticles(ctx, series.ID)
+	if err != nil {
+		logger.FromContext(ctx).Error("failed to get series articles", zap.Uint("series_id", series.ID), zap.Error(err))
+		return err
+	}
+
+	if articles == nil {
+		articles = []domain.Article{}
+	}
+	series.Articles = articles
+	return nil
+}
+
+func (u *seriesUsecase) ensureSlugAvailable(ctx context.Context, slug string, currentID uint) error {
+	existing, err := u.repoSeries.GetBySlug(ctx, slug)
+	if err != nil && !errors.Is(err, dto.ErrSeriesNotFound) {
+		logger.FromContext(ctx).Error("failed to check series slug", zap.Error(err))
+		return err
+	}
+
+	if existing != nil && existing.ID != currentID {
+		logger.FromContext(ctx).Warn("series with same slug already exists", zap.String("slug", slug))
+		return dto.ErrSeriesExists
+	}
+
+	return nil
+}
+
+func indexOf(ids []uint, id uint) int {
+	for i, existing := range ids {
+		if existing == id {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,233 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	articleDto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/article"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/series"
+	articleRepository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/article"
+	repository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/series"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/apperror"
+	"go.uber.org/zap"
+)
+
+// fakeSeriesRepository series dan urutan anggotanya di memory
+type fakeSeriesRepository struct {
+	repository.SeriesRepository
+	series  map[uint]domain.Series
+	members map[uint][]uint
+	saved   bool
+}
+
+func (f *fakeSeriesRepository) Create(_ context.Context, series *domain.Series) error {
+	series.ID = uint(len(f.series) + 1)
+	f.series[series.ID] = *series
+	return nil
+}
+
+func (f *fakeSeriesRepository) GetDetailByID(_ context.Context, id uint) (*domain.Series, error) {
+	series, ok := f.series[id]
+	if !ok {
+		return nil, dto.ErrSeriesNotFound
+	}
+	return &series, nil
+}
+
+func (f *fakeSeriesRepository) GetBySlug(_ context.Context, slug string) (*domain.Series, error) {
+	for _, series := range f.series {
+		if series.Slug == slug {
+			return &series, nil
+		}
+	}
+	return nil, dto.ErrSeriesNotFound
+}
+
+func (f *fakeSeriesRepository) UpdateByID(_ context.Context, id uint, series *domain.Series) error {
+	f.series[id] = *series
+	return nil
+}
+
+func (f *fakeSeriesRepository) GetArticles(_ context.Context, seriesID uint) ([]domain.Article, error) {
+	var articles []domain.Article
+	for _, id := range f.members[seriesID] {
+		articles = append(articles, domain.Article{ID: id})
+	}
+	return articles, nil
+}
+
+func (f *fakeSeriesRepository) SetArticles(_ context.Context, seriesID uint, articleIDs []uint) error {
+	f.saved = true
+	f.members[seriesID] = append([]uint(nil), articleIDs...)
+	return nil
+}
+
+type fakeArticleRepository struct {
+	articleRepository.ArticleRepository
+}
+
+func (fakeArticleRepository) GetDetailByID(_ context.Context, id uint) (*domain.Article, error) {
+	if id >= 100 {
+		return nil, articleDto.ErrArticleNotFound
+	}
+	return &domain.Article{ID: id}, nil
+}
+
+// newTestUsecase series 1 "Go Basics" berisi article 1, 2, 3
+func newTestUsecase() (SeriesUsecase, *fakeSeriesRepository) {
+	repo := &fakeSeriesRepository{
+		series: map[uint]domain.Series{
+			1: {ID: 1, Title: "Go Basics", Slug: "go-basics"},
+			2: {ID: 2, Title: "Empty", Slug: "empty"},
+		},
+		members: map[uint][]uint{1: {1, 2, 3}},
+	}
+	return NewSeriesUsecase(repo, fakeArticleRepository{}, zap.NewNop()), repo
+}
+
+func articleIDs(series *domain.Series) []uint {
+	ids := make([]uint, len(series.Articles))
+	for i, article := range series.Articles {
+		ids[i] = article.ID
+	}
+	return ids
+}
+
+func TestAddArticle(t *testing.T) {
+	tests := []struct {
+		name      string
+		seriesID  uint
+		articleID uint
+		position  int
+		want      []uint
+		wantErr   error
+	}{
+		{name: "append when position is zero", seriesID: 1, articleID: 4, want: []uint{1, 2, 3, 4}},
+		{name: "insert at first position", seriesID: 1, articleID: 4, position: 1, want: []uint{4, 1, 2, 3}},
+		{name: "insert in the middle", seriesID: 1, articleID: 4, position: 2, want: []uint{1, 4, 2, 3}},
+		{name: "insert before last", seriesID: 1, articleID: 4, position: 3, want: []uint{1, 2, 4, 3}},
+		{name: "position past the end appends", seriesID: 1, articleID: 4, position: 10, want: []uint{1, 2, 3, 4}},
+		{name: "empty series", seriesID: 2, articleID: 4, position: 1, want: []uint{4}},
+		{name: "already in series", seriesID: 1, articleID: 2, wantErr: dto.ErrArticleInSeries},
+		{name: "article not found", seriesID: 1, articleID: 100, wantErr: dto.ErrArticleNotFound},
+		{name: "series not found", seriesID: 9, articleID: 4, wantErr: dto.ErrSeriesNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo := newTestUsecase()
+
+			series, err := uc.AddArticle(context.Background(), tt.seriesID, tt.articleID, tt.position)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AddArticle() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if repo.saved {
+					t.Fatal("membership must not be saved on error")
+				}
+				return
+			}
+			if got := articleIDs(series); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("articles = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRemoveArticle(t *testing.T) {
+	tests := []struct {
+		name      string
+		articleID uint
+		want      []uint
+		wantErr   error
+	}{
+		{name: "first", articleID: 1, want: []uint{2, 3}},
+		{name: "middle", articleID: 2, want: []uint{1, 3}},
+		{name: "last", articleID: 3, want: []uint{1, 2}},
+		{name: "not in series", articleID: 4, wantErr: dto.ErrArticleNotInSeries},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, _ := newTestUsecase()
+
+			series, err := uc.RemoveArticle(context.Background(), 1, tt.articleID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RemoveArticle() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && !reflect.DeepEqual(articleIDs(series), tt.want) {
+				t.Errorf("articles = %v, want %v", articleIDs(series), tt.want)
+			}
+		})
+	}
+}
+
+func TestReorder(t *testing.T) {
+	tests := []struct {
+		name    string
+		order   []uint
+		wantErr error
+	}{
+		{name: "reversed", order: []uint{3, 2, 1}},
+		{name: "same order", order: []uint{1, 2, 3}},
+		{name: "missing member", order: []uint{3, 1}, wantErr: dto.ErrInvalidOrder},
+		{name: "extra member", order: []uint{3, 2, 1, 4}, wantErr: dto.ErrInvalidOrder},
+		{name: "duplicate", order: []uint{1, 1, 2}, wantErr: dto.ErrInvalidOrder},
+		{name: "unknown article", order: []uint{1, 2, 4}, wantErr: dto.ErrInvalidOrder},
+		{name: "empty", order: nil, wantErr: dto.ErrInvalidOrder},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo := newTestUsecase()
+
+			series, err := uc.Reorder(context.Background(), 1, tt.order)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Reorder() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				if !reflect.DeepEqual(repo.members[1], []uint{1, 2, 3}) {
+					t.Fatalf("members = %v, want unchanged", repo.members[1])
+				}
+				return
+			}
+			if !reflect.DeepEqual(articleIDs(series), tt.order) {
+				t.Errorf("articles = %v, want %v", articleIDs(series), tt.order)
+			}
+		})
+	}
+}
+
+func TestCreateAndUpdateSlug(t *testing.T) {
+	uc, _ := newTestUsecase()
+	ctx := context.Background()
+
+	created, err := uc.Create(ctx, &domain.Series{Title: "Advanced Go"})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if created.Slug != "advanced-go" || created.Articles == nil {
+		t.Errorf("created = %+v, want slug advanced-go and empty articles", created)
+	}
+
+	if _, err := uc.Create(ctx, &domain.Series{Title: "Go Basics"}); !errors.Is(err, dto.ErrSeriesExists) {
+		t.Errorf("Create() duplicate error = %v, want %v", err, dto.ErrSeriesExists)
+	}
+
+	var appErr *apperror.Error
+	if _, err := uc.Create(ctx, &domain.Series{Title: "Go"}); !errors.As(err, &appErr) || appErr.Kind != apperror.KindValidation {
+		t.Errorf("Create() short title error = %v, want a validation error", err)
+	}
+
+	// Update ke title yang sama tidak dianggap konflik dengan dirinya sendiri
+	title := "Go Basics"
+	if _, err := uc.UpdateByID(ctx, 1, &dto.UpdateSeriesRequest{Title: title}); err != nil {
+		t.Errorf("UpdateByID() same title error = %v", err)
+	}
+	if _, err := uc.UpdateByID(ctx, 2, &dto.UpdateSeriesRequest{Title: title}); !errors.Is(err, dto.ErrSeriesExists) {
+		t.Errorf("UpdateByID() taken title error = %v, want %v", err, dto.ErrSeriesExists)
+	}
+}
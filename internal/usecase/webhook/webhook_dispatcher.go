@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	repository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/webhook"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/events"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/tenant"
+	"go.uber.org/zap"
+)
+
+// webhookDispatcher publisher untuk outbox relay. Event tidak dikirim langsung, tapi
+// dicatat sebagai delivery per subscription lalu dikirim oleh DeliveryWorker
+type webhookDispatcher struct {
+	repoWebhook repository.WebhookRepository
+	log         *zap.Logger
+}
+
+func NewWebhookDispatcher(repoWebhook repository.WebhookRepository, log *zap.Logger) events.Publisher {
+	return &webhookDispatcher{
+		repoWebhook: repoWebhook,
+		log:         log,
+	}
+}
+
+func (d *webhookDispatcher) Publish(ctx context.Context, event events.Event) error {
+	ctx = tenant.WithTenant(ctx, event.TenantID)
+
+	subscriptions, err := d.repoWebhook.GetActive(ctx)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	var deliveries []domain.WebhookDelivery
+	for _, subscription := range subscriptions {
+		if !subscription.Matches(event.Type) {
+			continue
+		}
+
+		deliveries = append(deliveries, domain.WebhookDelivery{
+			TenantID:       event.TenantID,
+			SubscriptionID: subscription.ID,
+			EventID:        event.ID,
+			EventType:      event.Type,
+			Payload:        body,
+			Status:         string(domain.DeliveryPending),
+			NextAttemptAt:  now,
+			CreatedDate:    now,
+			UpdatedDate:    now,
+		})
+	}
+
+	if len(deliveries) > 0 {
+		logger.FromContext(ctx).Debug("webhook deliveries queued", zap.String("event_id", event.ID), zap.Int("count", len(deliveries)))
+	}
+
+	return d.repoWebhook.CreateDeliveries(ctx, deliveries)
+}
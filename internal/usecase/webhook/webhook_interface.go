@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/webhook"
+)
+
+type WebhookUsecase interface {
+	Create(ctx context.Context, req *dto.CreateWebhookRequest) (*domain.WebhookSubscription, error)
+	GetList(ctx context.Context, webhookFilter *dto.WebhookFilter) ([]domain.WebhookSubscription, int64, error)
+	GetDetailByID(ctx context.Context, id uint) (*domain.WebhookSubscription, error)
+	UpdateByID(ctx context.Context, id uint, req *dto.UpdateWebhookRequest) (*domain.WebhookSubscription, error)
+	DeleteByID(ctx context.Context, id uint) error
+
+	GetDeliveries(ctx context.Context, id uint, deliveryFilter *dto.DeliveryFilter) ([]domain.WebhookDelivery, int64, error)
+	Redeliver(ctx context.Context, id, deliveryID uint) (*domain.WebhookDelivery, error)
+}
+
+// DeliveryWorker kirim webhook delivery yang sudah jatuh tempo di background
+type DeliveryWorker interface {
+	Start()
+	Stop(ctx context.Context) error
+}
@@ -0,0 +1,224 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/url"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/webhook"
+	repository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/webhook"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/netguard"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+type webhookUsecase struct {
+	repoWebhook repository.WebhookRepository
+	guard       netguard.Guard
+	log         *zap.Logger
+}
+
+func NewWebhookUsecase(repoWebhook repository.WebhookRepository, config *viper.Viper, log *zap.Logger) WebhookUsecase {
+	return &webhookUsecase{
+		repoWebhook: repoWebhook,
+		guard:       newGuard(config),
+		log:         log,
+	}
+}
+
+// newGuard WEBHOOK_ALLOW_PRIVATE_NETWORKS hanya untuk development, misal receiver di localhost
+func newGuard(config *viper.Viper) netguard.Guard {
+	return netguard.Guard{AllowPrivate: config.GetBool("WEBHOOK_ALLOW_PRIVATE_NETWORKS")}
+}
+
+// checkURL tolak URL yang host-nya mengarah ke jaringan internal (SSRF). Worker tetap cek ulang
+// alamat saat connect karena DNS bisa berubah setelah subscribe
+func (u *webhookUsecase) checkURL(ctx context.Context, raw string) error {
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return dto.ErrInvalidURL
+	}
+
+	if err := u.guard.CheckHost(ctx, parsed.Hostname()); err != nil {
+		logger.FromContext(ctx).Warn("webhook url rejected", zap.String("url", raw), zap.Error(err))
+		return dto.ErrURLNotPublic.Wrap(err)
+	}
+
+	return nil
+}
+
+func (u *webhookUsecase) Create(ctx context.Context, req *dto.CreateWebhookRequest) (*domain.WebhookSubscription, error) {
+	logger.FromContext(ctx).Info("creating webhook", zap.String("url", req.URL), zap.Strings("event_types", req.EventTypes))
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := u.checkURL(ctx, req.URL); err != nil {
+		return nil, err
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		var err error
+		if secret, err = generateSecret(); err != nil {
+			logger.FromContext(ctx).Error("failed to generate webhook secret", zap.Error(err))
+			return nil, dto.ErrFailedCreateWebhook.Wrap(err)
+		}
+	}
+
+	subscription := &domain.WebhookSubscription{
+		URL:         req.URL,
+		EventTypes:  req.EventTypes,
+		Secret:      secret,
+		Active:      req.Active == nil || *req.Active,
+		CreatedDate: time.Now(),
+		UpdatedDate: time.Now(),
+	}
+
+	if err := u.repoWebhook.Create(ctx, subscription); err != nil {
+		logger.FromContext(ctx).Error("failed to save webhook", zap.Error(err))
+		return nil, dto.ErrFailedCreateWebhook.Wrap(err)
+	}
+
+	logger.FromContext(ctx).Info("webhook created successfully", zap.Uint("id", subscription.ID))
+	return subscription, nil
+}
+
+func (u *webhookUsecase) GetList(ctx context.Context, webhookFilter *dto.WebhookFilter) ([]domain.WebhookSubscription, int64, error) {
+	logger.FromContext(ctx).Info("getting webhook list", zap.Int("page", webhookFilter.GetDefaultPage()))
+
+	if err := webhookFilter.Validate(); err != nil {
+		return nil, 0, err
+	}
+
+	return u.repoWebhook.GetList(ctx, webhookFilter)
+}
+
+func (u *webhookUsecase) GetDetailByID(ctx context.Context, id uint) (*domain.WebhookSubscription, error) {
+	return u.repoWebhook.GetDetailByID(ctx, id)
+}
+
+func (u *webhookUsecase) UpdateByID(ctx context.Context, id uint, req *dto.UpdateWebhookRequest) (*domain.WebhookSubscription, error) {
+	logger.FromContext(ctx).Info("updating webhook", zap.Uint("id", id))
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	subscription, err := u.repoWebhook.GetDetailByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.URL != "" {
+		if err := u.checkURL(ctx, req.URL); err != nil {
+			return nil, err
+		}
+		subscription.URL = req.URL
+	}
+
+	if req.EventTypes != nil {
+		subscription.EventTypes = req.EventTypes
+	}
+
+	if req.Secret != "" {
+		subscription.Secret = req.Secret
+	}
+
+	if req.Active != nil {
+		subscription.Active = *req.Active
+	}
+
+	subscription.UpdatedDate = time.Now()
+
+	if err := u.repoWebhook.UpdateByID(ctx, id, subscription); err != nil {
+		logger.FromContext(ctx).Error("failed to update webhook", zap.Uint("id", id), zap.Error(err))
+		return nil, dto.ErrFailedUpdateWebhook.Wrap(err)
+	}
+
+	logger.FromContext(ctx).Info("webhook updated successfully", zap.Uint("id", id))
+	return subscription, nil
+}
+
+func (u *webhookUsecase) DeleteByID(ctx context.Context, id uint) error {
+	logger.FromContext(ctx).Info("deleting webhook", zap.Uint("id", id))
+
+	if _, err := u.repoWebhook.GetDetailByID(ctx, id); err != nil {
+		return err
+	}
+
+	if err := u.repoWebhook.DeleteByID(ctx, id); err != nil {
+		logger.FromContext(ctx).Error("failed to delete webhook", zap.Uint("id", id), zap.Error(err))
+		return dto.ErrFailedDeleteWebhook.Wrap(err)
+	}
+
+	logger.FromContext(ctx).Info("webhook deleted successfully", zap.Uint("id", id))
+	return nil
+}
+
+func (u *webhookUsecase) GetDeliveries(ctx context.Context, id uint, deliveryFilter *dto.DeliveryFilter) ([]domain.WebhookDelivery, int64, error) {
+	logger.FromContext(ctx).Info("getting webhook deliveries", zap.Uint("id", id), zap.String("status", deliveryFilter.Filters.Status))
+
+	if err := deliveryFilter.Validate(); err != nil {
+		return nil, 0, err
+	}
+
+	// Pastikan subscription milik tenant ini, tabel delivery tidak di scope tenant
+	if _, err := u.repoWebhook.GetDetailByID(ctx, id); err != nil {
+		return nil, 0, err
+	}
+
+	return u.repoWebhook.GetDeliveries(ctx, id, deliveryFilter)
+}
+
+// Redeliver antrikan ulang delivery yang sudah terkirim atau dead dengan jatah retry penuh
+func (u *webhookUsecase) Redeliver(ctx context.Context, id, deliveryID uint) (*domain.WebhookDelivery, error) {
+	logger.FromContext(ctx).Info("redelivering webhook", zap.Uint("id", id), zap.Uint("delivery_id", deliveryID))
+
+	if _, err := u.repoWebhook.GetDetailByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	delivery, err := u.repoWebhook.GetDelivery(ctx, id, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if delivery.Status == string(domain.DeliveryPending) {
+		return nil, dto.ErrDeliveryPending
+	}
+
+	now := time.Now()
+	delivery.Status = string(domain.DeliveryPending)
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = now
+	delivery.DeliveredAt = nil
+	delivery.UpdatedDate = now
+
+	reset, err := u.repoWebhook.ResetDelivery(ctx, delivery)
+	if err != nil {
+		logger.FromContext(ctx).Error("failed to reset webhook delivery", zap.Uint("delivery_id", deliveryID), zap.Error(err))
+		return nil, dto.ErrFailedRedeliver.Wrap(err)
+	}
+
+	// Request redeliver lain sudah lebih dulu mengantrikan delivery ini
+	if !reset {
+		return nil, dto.ErrDeliveryPending
+	}
+
+	return delivery, nil
+}
+
+func generateSecret() (string, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return "whsec_" + hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/webhook"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/netguard"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+// fakeSubscriptionRepository simpan subscription yang dibuat / diubah usecase
+type fakeSubscriptionRepository struct {
+	fakeWebhookRepository
+	saved *domain.WebhookSubscription
+}
+
+func (f *fakeSubscriptionRepository) Create(_ context.Context, subscription *domain.WebhookSubscription) error {
+	f.saved = subscription
+	return nil
+}
+
+func (f *fakeSubscriptionRepository) UpdateByID(_ context.Context, _ uint, subscription *domain.WebhookSubscription) error {
+	f.saved = subscription
+	return nil
+}
+
+func TestWebhookURLMustBePublic(t *testing.T) {
+	tests := []struct {
+		name         string
+		url          string
+		allowPrivate bool
+		wantErr      bool
+	}{
+		{name: "public address", url: "https://93.184.216.34/hooks"},
+		{name: "loopback", url: "http://127.0.0.1:8080/hooks", wantErr: true},
+		{name: "private network", url: "http://10.0.0.5/hooks", wantErr: true},
+		{name: "cloud metadata", url: "http://169.254.169.254/latest/meta-data", wantErr: true},
+		{name: "ipv6 loopback", url: "http://[::1]/hooks", wantErr: true},
+		{name: "private allowed in development", url: "http://127.0.0.1:8080/hooks", allowPrivate: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := viper.New()
+			config.Set("WEBHOOK_ALLOW_PRIVATE_NETWORKS", tt.allowPrivate)
+
+			repo := &fakeSubscriptionRepository{}
+			repo.subscription = &domain.WebhookSubscription{ID: 7, URL: "https://93.184.216.34/old", Secret: "s3cret", Active: true}
+			u := NewWebhookUsecase(repo, config, zap.NewNop())
+
+			_, createErr := u.Create(context.Background(), &dto.CreateWebhookRequest{URL: tt.url, EventTypes: []string{domain.WildcardEvent}})
+			_, updateErr := u.UpdateByID(context.Background(), 7, &dto.UpdateWebhookRequest{URL: tt.url})
+
+			for action, err := range map[string]error{"create": createErr, "update": updateErr} {
+				if !tt.wantErr {
+					if err != nil {
+						t.Fatalf("%s: %v", action, err)
+					}
+					continue
+				}
+				if !errors.Is(err, dto.ErrURLNotPublic) || !errors.Is(err, netguard.ErrBlockedAddress) {
+					t.Fatalf("%s: err = %v, want ErrURLNotPublic", action, err)
+				}
+			}
+
+			if tt.wantErr && repo.saved != nil {
+				t.Fatalf("rejected url was saved: %+v", repo.saved)
+			}
+		})
+	}
+}
@@ -0,0 +1,241 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/webhook"
+	repository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/webhook"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/backoff"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/netguard"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/events"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/tenant"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+// Potongan response body yang disimpan di last_error kalau partner membalas selain 2xx
+const maxResponseSnippet = 512
+
+type deliveryWorker struct {
+	repoWebhook  repository.WebhookRepository
+	client       *http.Client
+	pollInterval time.Duration
+	batchSize    int
+	maxAttempts  int
+	backoffBase  time.Duration
+	backoffMax   time.Duration
+	lease        time.Duration
+	cancel       context.CancelFunc
+	done         chan struct{}
+	once         sync.Once
+	log          *zap.Logger
+}
+
+func NewDeliveryWorker(repoWebhook repository.WebhookRepository, config *viper.Viper, log *zap.Logger) DeliveryWorker {
+	timeout := config.GetDuration("WEBHOOK_TIMEOUT")
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+
+	pollInterval := config.GetDuration("WEBHOOK_POLL_INTERVAL")
+	if pollInterval <= 0 {
+		pollInterval = 2 * time.Second
+	}
+
+	batchSize := config.GetInt("WEBHOOK_BATCH_SIZE")
+	if batchSize <= 0 {
+		batchSize = 50
+	}
+
+	maxAttempts := config.GetInt("WEBHOOK_MAX_ATTEMPTS")
+	if maxAttempts <= 0 {
+		maxAttempts = 8
+	}
+
+	backoffBase := config.GetDuration("WEBHOOK_BACKOFF_BASE")
+	if backoffBase <= 0 {
+		backoffBase = 10 * time.Second
+	}
+
+	backoffMax := config.GetDuration("WEBHOOK_BACKOFF_MAX")
+	if backoffMax <= 0 {
+		backoffMax = time.Hour
+	}
+
+	return &deliveryWorker{
+		repoWebhook:  repoWebhook,
+		client:       newGuard(config).Client(timeout),
+		pollInterval: pollInterval,
+		batchSize:    batchSize,
+		maxAttempts:  maxAttempts,
+		backoffBase:  backoffBase,
+		backoffMax:   backoffMax,
+		lease:        timeout + 30*time.Second,
+		done:         make(chan struct{}),
+		log:          log,
+	}
+}
+
+func (w *deliveryWorker) Start() {
+	w.once.Do(func() {
+		// Antrian berisi event semua tenant, tenant per event di set saat diproses
+		ctx, cancel := context.WithCancel(tenant.WithAllTenants(context.Background()))
+		w.cancel = cancel
+
+		go w.run(ctx)
+
+		w.log.Info("webhook delivery worker started", zap.Duration("poll_interval", w.pollInterval), zap.Int("max_attempts", w.maxAttempts))
+	})
+}
+
+func (w *deliveryWorker) Stop(ctx context.Context) error {
+	if w.cancel == nil {
+		return nil
+	}
+	w.cancel()
+
+	select {
+	case <-w.done:
+		logger.FromContext(ctx).Info("webhook delivery worker stopped")
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (w *deliveryWorker) run(ctx context.Context) {
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		for w.deliverBatch(ctx) == w.batchSize && ctx.Err() == nil {
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *deliveryWorker) deliverBatch(ctx context.Context) int {
+	due, err := w.repoWebhook.FetchDueDeliveries(ctx, time.Now(), w.batchSize)
+	if err != nil {
+		if ctx.Err() == nil {
+			logger.FromContext(ctx).Error("failed to fetch webhook deliveries", zap.Error(err))
+		}
+		return 0
+	}
+
+	for i := range due {
+		if ctx.Err() != nil {
+			break
+		}
+		w.deliver(ctx, &due[i])
+	}
+
+	return len(due)
+}
+
+func (w *deliveryWorker) deliver(ctx context.Context, delivery *domain.WebhookDelivery) {
+	claimed, err := w.repoWebhook.ClaimDelivery(ctx, delivery, time.Now().Add(w.lease))
+	if err != nil || !claimed {
+		return
+	}
+
+	// Status akhir tetap ditulis walaupun worker sedang di stop
+	writeCtx := context.WithoutCancel(ctx)
+
+	subscription, err := w.repoWebhook.GetDetailByID(tenant.WithTenant(ctx, delivery.TenantID), delivery.SubscriptionID)
+	switch {
+	case errors.Is(err, dto.ErrWebhookNotFound):
+		w.finish(writeCtx, delivery, 0, err, false)
+		return
+	case err != nil:
+		w.finish(writeCtx, delivery, 0, err, true)
+		return
+	case !subscription.Active:
+		w.finish(writeCtx, delivery, 0, fmt.Errorf("webhook is inactive"), false)
+		return
+	}
+
+	// Alamat internal tidak di retry, URL harus diubah dulu lewat update subscription
+	statusCode, err := w.send(ctx, subscription, delivery)
+	w.finish(writeCtx, delivery, statusCode, err, !errors.Is(err, netguard.ErrBlockedAddress))
+}
+
+func (w *deliveryWorker) send(ctx context.Context, subscription *domain.WebhookSubscription, delivery *domain.WebhookDelivery) (int, error) {
+	var event events.Event
+	if err := json.Unmarshal(delivery.Payload, &event); err != nil {
+		return 0, err
+	}
+
+	req, err := events.NewSignedRequest(ctx, subscription.URL, subscription.Secret, event, delivery.Payload)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("X-Webhook-Delivery", fmt.Sprint(delivery.ID))
+	req.Header.Set("X-Webhook-Attempt", fmt.Sprint(delivery.Attempts))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	snippet, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseSnippet))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(snippet)))
+	}
+
+	return resp.StatusCode, nil
+}
+
+// finish simpan hasil kirim. Gagal yang masih bisa di retry dijadwalkan ulang dengan
+// exponential backoff, setelah maxAttempts delivery jadi dead
+func (w *deliveryWorker) finish(ctx context.Context, delivery *domain.WebhookDelivery, statusCode int, err error, retryable bool) {
+	now := time.Now()
+	delivery.LastStatusCode = statusCode
+	delivery.UpdatedDate = now
+
+	switch {
+	case err == nil:
+		delivery.Status = string(domain.DeliveryDelivered)
+		delivery.LastError = ""
+		delivery.DeliveredAt = &now
+	case retryable && delivery.Attempts < w.maxAttempts:
+		delivery.LastError = err.Error()
+		delivery.NextAttemptAt = now.Add(backoff.Exponential(delivery.Attempts, w.backoffBase, w.backoffMax))
+		logger.FromContext(ctx).Warn("webhook delivery failed, will retry",
+			zap.Uint("delivery_id", delivery.ID),
+			zap.Uint("webhook_id", delivery.SubscriptionID),
+			zap.Int("attempts", delivery.Attempts),
+			zap.Time("next_attempt_at", delivery.NextAttemptAt),
+			zap.Error(err),
+		)
+	default:
+		delivery.Status = string(domain.DeliveryDead)
+		delivery.LastError = err.Error()
+		logger.FromContext(ctx).Error("webhook delivery moved to dead letter",
+			zap.Uint("delivery_id", delivery.ID),
+			zap.Uint("webhook_id", delivery.SubscriptionID),
+			zap.Int("attempts", delivery.Attempts),
+			zap.Error(err),
+		)
+	}
+
+	w.repoWebhook.UpdateDeliveryState(ctx, delivery)
+}
@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/domain"
+	dto "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/dto/webhook"
+	repository "github.com/enrichoalkalas01/test-sharing-vision-golang/internal/repository/webhook"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/events"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+// fakeWebhookRepository hanya implement method yang dipakai worker
+type fakeWebhookRepository struct {
+	repository.WebhookRepository
+	subscription *domain.WebhookSubscription
+	updates      []domain.WebhookDelivery
+}
+
+func (f *fakeWebhookRepository) ClaimDelivery(_ context.Context, delivery *domain.WebhookDelivery, leaseUntil time.Time) (bool, error) {
+	delivery.Attempts++
+	delivery.NextAttemptAt = leaseUntil
+	return true, nil
+}
+
+func (f *fakeWebhookRepository) GetDetailByID(context.Context, uint) (*domain.WebhookSubscription, error) {
+	if f.subscription == nil {
+		return nil, dto.ErrWebhookNotFound
+	}
+	return f.subscription, nil
+}
+
+func (f *fakeWebhookRepository) UpdateDeliveryState(_ context.Context, delivery *domain.WebhookDelivery) error {
+	f.updates = append(f.updates, *delivery)
+	return nil
+}
+
+func TestDeliveryWorkerRetryAndDeadLetter(t *testing.T) {
+	const maxAttempts = 3
+
+	tests := []struct {
+		name         string
+		responses    []int // status per percobaan, percobaan setelahnya memakai status terakhir
+		active       bool
+		noWebhook    bool
+		blockPrivate bool
+		wantStatus   domain.DeliveryStatus
+		wantAttempts int
+		wantRequests int
+		wantCode     int
+	}{
+		{name: "delivered on first attempt", responses: []int{200}, active: true, wantStatus: domain.DeliveryDelivered, wantAttempts: 1, wantRequests: 1, wantCode: 200},
+		{name: "retried then delivered", responses: []int{500, 503, 204}, active: true, wantStatus: domain.DeliveryDelivered, wantAttempts: 3, wantRequests: 3, wantCode: 204},
+		{name: "dead letter after max attempts", responses: []int{500}, active: true, wantStatus: domain.DeliveryDead, wantAttempts: maxAttempts, wantRequests: maxAttempts, wantCode: 500},
+		{name: "inactive webhook is dead without sending", responses: []int{200}, active: false, wantStatus: domain.DeliveryDead, wantAttempts: 1, wantRequests: 0},
+		{name: "deleted webhook is dead without sending", responses: []int{200}, noWebhook: true, wantStatus: domain.DeliveryDead, wantAttempts: 1, wantRequests: 0},
+		{name: "private address is dead without retry", responses: []int{200}, active: true, blockPrivate: true, wantStatus: domain.DeliveryDead, wantAttempts: 1, wantRequests: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var requests int
+			var signatures, timestamps []string
+			payload, _ := json.Marshal(events.Event{ID: "evt-1", Type: events.ArticleCreated})
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != string(payload) {
+					t.Errorf("body = %s, want %s", body, payload)
+				}
+				signatures = append(signatures, r.Header.Get(events.HeaderSignature))
+				timestamps = append(timestamps, r.Header.Get(events.HeaderTimestamp))
+
+				status := tt.responses[min(requests, len(tt.responses)-1)]
+				requests++
+				w.WriteHeader(status)
+			}))
+			defer server.Close()
+
+			repo := &fakeWebhookRepository{}
+			if !tt.noWebhook {
+				repo.subscription = &domain.WebhookSubscription{ID: 7, URL: server.URL, Secret: "s3cret", Active: tt.active}
+			}
+
+			config := viper.New()
+			config.Set("WEBHOOK_ALLOW_PRIVATE_NETWORKS", !tt.blockPrivate) // httptest listen di 127.0.0.1
+			config.Set("WEBHOOK_MAX_ATTEMPTS", maxAttempts)
+			config.Set("WEBHOOK_BACKOFF_BASE", time.Second)
+			config.Set("WEBHOOK_BACKOFF_MAX", time.Minute)
+			worker := NewDeliveryWorker(repo, config, zap.NewNop()).(*deliveryWorker)
+
+			delivery := &domain.WebhookDelivery{
+				ID:             1,
+				TenantID:       "default",
+				SubscriptionID: 7,
+				EventID:        "evt-1",
+				Payload:        payload,
+				Status:         string(domain.DeliveryPending),
+			}
+
+			// Setiap putaran mensimulasikan worker mengambil delivery yang sudah jatuh tempo
+			for i := 0; i < maxAttempts+2 && delivery.Status == string(domain.DeliveryPending); i++ {
+				before := time.Now()
+				worker.deliver(context.Background(), delivery)
+
+				if delivery.Status == string(domain.DeliveryPending) {
+					wantDelay := time.Second << (delivery.Attempts - 1)
+					if delivery.NextAttemptAt.Before(before.Add(wantDelay)) {
+						t.Fatalf("attempt %d: next_attempt_at %v is earlier than backoff %v", delivery.Attempts, delivery.NextAttemptAt, wantDelay)
+					}
+				}
+			}
+
+			if delivery.Status != string(tt.wantStatus) {
+				t.Errorf("status = %s, want %s (last error %q)", delivery.Status, tt.wantStatus, delivery.LastError)
+			}
+			if delivery.Attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", delivery.Attempts, tt.wantAttempts)
+			}
+			if requests != tt.wantRequests {
+				t.Errorf("requests = %d, want %d", requests, tt.wantRequests)
+			}
+			if delivery.LastStatusCode != tt.wantCode {
+				t.Errorf("last status code = %d, want %d", delivery.LastStatusCode, tt.wantCode)
+			}
+			if len(repo.updates) != tt.wantAttempts {
+				t.Errorf("state updates = %d, want %d", len(repo.updates), tt.wantAttempts)
+			}
+
+			switch tt.wantStatus {
+			case domain.DeliveryDelivered:
+				if delivery.DeliveredAt == nil || delivery.LastError != "" {
+					t.Errorf("delivered delivery must have delivered_at and no last_error, got %v %q", delivery.DeliveredAt, delivery.LastError)
+				}
+			case domain.DeliveryDead:
+				if delivery.DeliveredAt != nil || delivery.LastError == "" {
+					t.Errorf("dead delivery must keep last_error and no delivered_at, got %v %q", delivery.DeliveredAt, delivery.LastError)
+				}
+			}
+
+			for i, signature := range signatures {
+				if err := events.VerifySignature("s3cret", signature, timestamps[i], payload, time.Now(), 0); err != nil {
+					t.Errorf("request %d: %v", i, err)
+				}
+			}
+		})
+	}
+}
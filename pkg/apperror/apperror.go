@@ -0,0 +1,170 @@
+package apperror
+
+import "errors"
+
+// Kind kategori error domain, error handler memetakan kind ke HTTP status
+type Kind int
+
+const (
+	KindInternal Kind = iota
+	KindValidation
+	KindNotFound
+	KindConflict
+	KindForbidden
+	KindUnauthorized
+	KindTooLarge
+	KindUnsupported
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindValidation:
+		return "validation"
+	case KindNotFound:
+		return "not_found"
+	case KindConflict:
+		return "conflict"
+	case KindForbidden:
+		return "forbidden"
+	case KindUnauthorized:
+		return "unauthorized"
+	case KindTooLarge:
+		return "too_large"
+	case KindUnsupported:
+		return "unsupported"
+	default:
+		return "internal"
+	}
+}
+
+// Error error domain dengan kind, code yang stabil untuk client dan pesan yang aman ditampilkan.
+// Cause (Err) hanya untuk log, tidak pernah dikirim ke client di production
+type Error struct {
+	Kind    Kind
+	Code    string
+	Message string
+	Err     error
+
+	// ID id pesan untuk catalog i18n, hanya di isi kalau satu Code dipakai beberapa pesan
+	ID string
+
+	// sentinel asal error hasil Wrap, supaya errors.Is(wrapped, ErrXxx) tetap true
+	sentinel *Error
+}
+
+func New(kind Kind, code, message string) *Error {
+	return &Error{Kind: kind, Code: code, Message: message}
+}
+
+func Validation(code, message string) *Error {
+	return New(KindValidation, code, message)
+}
+
+func NotFound(code, message string) *Error {
+	return New(KindNotFound, code, message)
+}
+
+func Conflict(code, message string) *Error {
+	return New(KindConflict, code, message)
+}
+
+func Forbidden(code, message string) *Error {
+	return New(KindForbidden, code, message)
+}
+
+func Unauthorized(code, message string) *Error {
+	return New(KindUnauthorized, code, message)
+}
+
+func TooLarge(code, message string) *Error {
+	return New(KindTooLarge, code, message)
+}
+
+func Unsupported(code, message string) *Error {
+	return New(KindUnsupported, code, message)
+}
+
+func Internal(code, message string) *Error {
+	return New(KindInternal, code, message)
+}
+
+// WithID set id pesan saat deklarasi sentinel, error yang sama dikembalikan supaya errors.Is tetap berlaku
+func (e *Error) WithID(id string) *Error {
+	e.ID = id
+	return e
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is true kalau target adalah sentinel asal error ini
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && e.sentinel != nil && t == e.sentinel
+}
+
+// Wrap salin error dengan cause, kind / code / pesan tetap dan errors.Is ke sentinel tetap berlaku
+func (e *Error) Wrap(cause error) *Error {
+	sentinel := e
+	if e.sentinel != nil {
+		sentinel = e.sentinel
+	}
+
+	return &Error{
+		Kind:     e.Kind,
+		Code:     e.Code,
+		Message:  e.Message,
+		Err:      cause,
+		ID:       e.ID,
+		sentinel: sentinel,
+	}
+}
+
+// Wrap error domain dibiarkan apa adanya, error lain (driver, IO) dibungkus sebagai Internal
+func Wrap(err error, code, message string) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := As(err); ok {
+		return err
+	}
+
+	return Internal(code, message).Wrap(err)
+}
+
+func As(err error) (*Error, bool) {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr, true
+	}
+
+	return nil, false
+}
+
+// KindOf error yang bukan error domain dianggap Internal
+func KindOf(err error) Kind {
+	if appErr, ok := As(err); ok {
+		return appErr.Kind
+	}
+
+	return KindInternal
+}
+
+// CodeOf kosong kalau err bukan error domain
+func CodeOf(err error) string {
+	if appErr, ok := As(err); ok {
+		return appErr.Code
+	}
+
+	return ""
+}
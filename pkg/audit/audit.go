@@ -0,0 +1,40 @@
+package audit
+
+import "context"
+
+// Metadata siapa dan dari mana sebuah perubahan dilakukan
+type Metadata struct {
+	Actor     string
+	RequestID string
+	IP        string
+
+	// Authenticated true jika Actor berasal dari credential yang sudah diverifikasi,
+	// bukan dari header actor yang bisa di isi client
+	Authenticated bool
+}
+
+// Actor yang dipakai jika request tidak membawa identitas
+const AnonymousActor = "anonymous"
+
+type contextKey struct{}
+
+// ContextKey key metadata di context, middleware menyimpannya lewat fiber Locals
+var ContextKey = contextKey{}
+
+func WithMetadata(ctx context.Context, metadata Metadata) context.Context {
+	return context.WithValue(ctx, ContextKey, metadata)
+}
+
+// FromContext selalu mengembalikan metadata, actor kosong diganti AnonymousActor
+func FromContext(ctx context.Context) Metadata {
+	var metadata Metadata
+	if ctx != nil {
+		metadata, _ = ctx.Value(ContextKey).(Metadata)
+	}
+
+	if metadata.Actor == "" {
+		metadata.Actor = AnonymousActor
+	}
+
+	return metadata
+}
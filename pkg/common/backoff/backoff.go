@@ -0,0 +1,13 @@
+package backoff
+
+import "time"
+
+// Exponential jeda sebelum retry ke-attempt (mulai dari 1): base, 2x base, 4x base, ... sampai max
+func Exponential(attempt int, base, max time.Duration) time.Duration {
+	delay := base
+	for i := 1; i < attempt && delay < max; i++ {
+		delay *= 2
+	}
+
+	return min(delay, max)
+}
@@ -0,0 +1,256 @@
+package feed
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"time"
+)
+
+// ============ Feed Model ============
+
+// Feed model netral yang bisa di render ke RSS 2.0, Atom dan JSON Feed
+type Feed struct {
+	Title       string
+	Description string
+	Link        string // halaman html dari feed
+	FeedURL     string // url feed itu sendiri
+	Language    string
+	Updated     time.Time
+	Items       []Item
+}
+
+type Item struct {
+	ID        string // GUID yang stabil
+	Title     string
+	Link      string
+	Content   string
+	HTML      string // content yang sudah di render, opsional
+	Category  string
+	Published time.Time
+	Updated   time.Time
+}
+
+const (
+	ContentTypeRSS  = "application/rss+xml; charset=utf-8"
+	ContentTypeAtom = "application/atom+xml; charset=utf-8"
+	ContentTypeJSON = "application/feed+json; charset=utf-8"
+)
+
+// ============ RSS 2.0 ============
+
+type rssDocument struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	AtomNS  string     `xml:"xmlns:atom,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	Language      string    `xml:"language,omitempty"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	AtomLink      rssAtom   `xml:"atom:link"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssAtom struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	GUID        rssGUID `xml:"guid"`
+	Description string  `xml:"description"`
+	Category    string  `xml:"category,omitempty"`
+	PubDate     string  `xml:"pubDate"`
+}
+
+type rssGUID struct {
+	Value       string `xml:",chardata"`
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+}
+
+func (f *Feed) RSS() ([]byte, error) {
+	doc := rssDocument{
+		Version: "2.0",
+		AtomNS:  "http://www.w3.org/2005/Atom",
+		Channel: rssChannel{
+			Title:       f.Title,
+			Link:        f.Link,
+			Description: f.Description,
+			Language:    f.Language,
+			AtomLink: rssAtom{
+				Href: f.FeedURL,
+				Rel:  "self",
+				Type: "application/rss+xml",
+			},
+		},
+	}
+
+	if !f.Updated.IsZero() {
+		doc.Channel.LastBuildDate = f.Updated.UTC().Format(time.RFC1123Z)
+	}
+
+	for _, item := range f.Items {
+		doc.Channel.Items = append(doc.Channel.Items, rssItem{
+			Title:       item.Title,
+			Link:        item.Link,
+			GUID:        rssGUID{Value: item.ID, IsPermaLink: false},
+			Description: item.body(),
+			Category:    item.Category,
+			PubDate:     item.Published.UTC().Format(time.RFC1123Z),
+		})
+	}
+
+	return marshalXML(doc)
+}
+
+// ============ Atom ============
+
+type atomFeed struct {
+	XMLName  xml.Name    `xml:"feed"`
+	NS       string      `xml:"xmlns,attr"`
+	Lang     string      `xml:"xml:lang,attr,omitempty"`
+	ID       string      `xml:"id"`
+	Title    string      `xml:"title"`
+	Subtitle string      `xml:"subtitle,omitempty"`
+	Updated  string      `xml:"updated"`
+	Links    []atomLink  `xml:"link"`
+	Entries  []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomEntry struct {
+	ID        string        `xml:"id"`
+	Title     string        `xml:"title"`
+	Link      atomLink      `xml:"link"`
+	Published string        `xml:"published"`
+	Updated   string        `xml:"updated"`
+	Category  *atomCategory `xml:"category,omitempty"`
+	Content   atomContent   `xml:"content"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomContent struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+func (f *Feed) Atom() ([]byte, error) {
+	doc := atomFeed{
+		NS:       "http://www.w3.org/2005/Atom",
+		Lang:     f.Language,
+		ID:       f.FeedURL,
+		Title:    f.Title,
+		Subtitle: f.Description,
+		Updated:  f.Updated.UTC().Format(time.RFC3339),
+		Links: []atomLink{
+			{Href: f.Link, Rel: "alternate", Type: "text/html"},
+			{Href: f.FeedURL, Rel: "self", Type: "application/atom+xml"},
+		},
+	}
+
+	for _, item := range f.Items {
+		entry := atomEntry{
+			ID:        item.ID,
+			Title:     item.Title,
+			Link:      atomLink{Href: item.Link, Rel: "alternate", Type: "text/html"},
+			Published: item.Published.UTC().Format(time.RFC3339),
+			Updated:   item.Updated.UTC().Format(time.RFC3339),
+			Content:   atomContent{Type: "text", Value: item.Content},
+		}
+		if item.HTML != "" {
+			entry.Content = atomContent{Type: "html", Value: item.HTML}
+		}
+		if item.Category != "" {
+			entry.Category = &atomCategory{Term: item.Category}
+		}
+		doc.Entries = append(doc.Entries, entry)
+	}
+
+	return marshalXML(doc)
+}
+
+// ============ JSON Feed 1.1 ============
+
+type jsonFeed struct {
+	Version     string         `json:"version"`
+	Title       string         `json:"title"`
+	HomePageURL string         `json:"home_page_url,omitempty"`
+	FeedURL     string         `json:"feed_url,omitempty"`
+	Description string         `json:"description,omitempty"`
+	Language    string         `json:"language,omitempty"`
+	Items       []jsonFeedItem `json:"items"`
+}
+
+type jsonFeedItem struct {
+	ID            string   `json:"id"`
+	URL           string   `json:"url,omitempty"`
+	Title         string   `json:"title,omitempty"`
+	ContentText   string   `json:"content_text"`
+	ContentHTML   string   `json:"content_html,omitempty"`
+	DatePublished string   `json:"date_published,omitempty"`
+	DateModified  string   `json:"date_modified,omitempty"`
+	Tags          []string `json:"tags,omitempty"`
+}
+
+func (f *Feed) JSON() ([]byte, error) {
+	doc := jsonFeed{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       f.Title,
+		HomePageURL: f.Link,
+		FeedURL:     f.FeedURL,
+		Description: f.Description,
+		Language:    f.Language,
+		Items:       []jsonFeedItem{},
+	}
+
+	for _, item := range f.Items {
+		jsonItem := jsonFeedItem{
+			ID:            item.ID,
+			URL:           item.Link,
+			Title:         item.Title,
+			ContentText:   item.Content,
+			ContentHTML:   item.HTML,
+			DatePublished: item.Published.UTC().Format(time.RFC3339),
+			DateModified:  item.Updated.UTC().Format(time.RFC3339),
+		}
+		if item.Category != "" {
+			jsonItem.Tags = []string{item.Category}
+		}
+		doc.Items = append(doc.Items, jsonItem)
+	}
+
+	return json.Marshal(doc)
+}
+
+// ============ Helpers ============
+
+func (i Item) body() string {
+	if i.HTML != "" {
+		return i.HTML
+	}
+	return i.Content
+}
+
+func marshalXML(v any) ([]byte, error) {
+	body, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), body...), nil
+}
@@ -0,0 +1,242 @@
+package feed
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+)
+
+func sampleFeed() *Feed {
+	published := time.Date(2026, 1, 2, 3, 4, 5, 0, time.FixedZone("WIB", 7*3600))
+	updated := published.Add(time.Hour)
+
+	return &Feed{
+		Title:       "Blog",
+		Description: "Latest published articles",
+		Link:        "https://blog.example",
+		FeedURL:     "https://blog.example/feeds/rss.xml",
+		Language:    "id-ID",
+		Updated:     updated,
+		Items: []Item{
+			{
+				ID:        "tag:blog.example,2026-01-01:article/1",
+				Title:     "Markdown <article>",
+				Link:      "https://blog.example/article/markdown",
+				Content:   "**raw** & content",
+				HTML:      "<p><strong>raw</strong> &amp; content</p>",
+				Category:  "Tech",
+				Published: published,
+				Updated:   updated,
+			},
+			{
+				ID:        "tag:blog.example,2026-01-01:article/2",
+				Title:     "Plain",
+				Link:      "https://blog.example/article/plain",
+				Content:   "plain content",
+				Published: published,
+				Updated:   published,
+			},
+		},
+	}
+}
+
+func TestRSS(t *testing.T) {
+	body, err := sampleFeed().RSS()
+	if err != nil {
+		t.Fatalf("RSS() error = %v", err)
+	}
+	if !strings.HasPrefix(string(body), xml.Header) {
+		t.Fatalf("RSS() must start with the XML header, got %q", body[:40])
+	}
+
+	var doc struct {
+		Version string `xml:"version,attr"`
+		Channel struct {
+			Title         string `xml:"title"`
+			Language      string `xml:"language"`
+			LastBuildDate string `xml:"lastBuildDate"`
+			Self          struct {
+				Href string `xml:"href,attr"`
+				Rel  string `xml:"rel,attr"`
+			} `xml:"http://www.w3.org/2005/Atom link"`
+			Items []struct {
+				Title string `xml:"title"`
+				Link  string `xml:"link"`
+				GUID  struct {
+					Value       string `xml:",chardata"`
+					IsPermaLink string `xml:"isPermaLink,attr"`
+				} `xml:"guid"`
+				Description string `xml:"description"`
+				Category    string `xml:"category"`
+				PubDate     string `xml:"pubDate"`
+			} `xml:"item"`
+		} `xml:"channel"`
+	}
+	if err := xml.Unmarshal(body, &doc); err != nil {
+		t.Fatalf("RSS() is not valid XML: %v", err)
+	}
+
+	channel := doc.Channel
+	if doc.Version != "2.0" || channel.Title != "Blog" || channel.Language != "id-ID" {
+		t.Errorf("channel = version %q title %q language %q", doc.Version, channel.Title, channel.Language)
+	}
+	if channel.Self.Href != "https://blog.example/feeds/rss.xml" || channel.Self.Rel != "self" {
+		t.Errorf("atom:link = %+v", channel.Self)
+	}
+	if want := "Thu, 01 Jan 2026 21:04:05 +0000"; channel.LastBuildDate != want {
+		t.Errorf("lastBuildDate = %q, want %q in UTC", channel.LastBuildDate, want)
+	}
+	if len(channel.Items) != 2 {
+		t.Fatalf("items = %d, want 2", len(channel.Items))
+	}
+
+	tests := []struct {
+		name            string
+		index           int
+		wantTitle       string
+		wantDescription string
+		wantCategory    string
+	}{
+		{name: "html content is used as description", index: 0, wantTitle: "Markdown <article>", wantDescription: "<p><strong>raw</strong> &amp; content</p>", wantCategory: "Tech"},
+		{name: "raw content without html", index: 1, wantTitle: "Plain", wantDescription: "plain content"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := channel.Items[tt.index]
+			if item.Title != tt.wantTitle || item.Description != tt.wantDescription || item.Category != tt.wantCategory {
+				t.Errorf("item = %+v", item)
+			}
+			if item.GUID.IsPermaLink != "false" || !strings.HasPrefix(item.GUID.Value, "tag:blog.example") {
+				t.Errorf("guid = %+v, want a tag URI that is not a permalink", item.GUID)
+			}
+			if want := "Thu, 01 Jan 2026 20:04:05 +0000"; item.PubDate != want {
+				t.Errorf("pubDate = %q, want %q", item.PubDate, want)
+			}
+		})
+	}
+}
+
+func TestAtom(t *testing.T) {
+	body, err := sampleFeed().Atom()
+	if err != nil {
+		t.Fatalf("Atom() error = %v", err)
+	}
+
+	var doc struct {
+		XMLName xml.Name
+		Lang    string `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+		ID      string `xml:"id"`
+		Updated string `xml:"updated"`
+		Links   []struct {
+			Href string `xml:"href,attr"`
+			Rel  string `xml:"rel,attr"`
+		} `xml:"link"`
+		Entries []struct {
+			ID       string `xml:"id"`
+			Title    string `xml:"title"`
+			Updated  string `xml:"updated"`
+			Category *struct {
+				Term string `xml:"term,attr"`
+			} `xml:"category"`
+			Content struct {
+				Type  string `xml:"type,attr"`
+				Value string `xml:",chardata"`
+			} `xml:"content"`
+		} `xml:"entry"`
+	}
+	if err := xml.Unmarshal(body, &doc); err != nil {
+		t.Fatalf("Atom() is not valid XML: %v", err)
+	}
+
+	if doc.XMLName.Space != "http://www.w3.org/2005/Atom" || doc.XMLName.Local != "feed" {
+		t.Errorf("root = %+v, want atom feed", doc.XMLName)
+	}
+	if doc.ID != "https://blog.example/feeds/rss.xml" || doc.Updated != "2026-01-01T21:04:05Z" || doc.Lang != "id-ID" {
+		t.Errorf("feed = id %q updated %q lang %q", doc.ID, doc.Updated, doc.Lang)
+	}
+	if len(doc.Links) != 2 || doc.Links[0].Rel != "alternate" || doc.Links[1].Rel != "self" {
+		t.Errorf("links = %+v", doc.Links)
+	}
+	if len(doc.Entries) != 2 {
+		t.Fatalf("entries = %d, want 2", len(doc.Entries))
+	}
+
+	tests := []struct {
+		name         string
+		index        int
+		wantType     string
+		wantContent  string
+		wantCategory string
+	}{
+		{name: "html content", index: 0, wantType: "html", wantContent: "<p><strong>raw</strong> &amp; content</p>", wantCategory: "Tech"},
+		{name: "text content without category", index: 1, wantType: "text", wantContent: "plain content"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := doc.Entries[tt.index]
+			if entry.Content.Type != tt.wantType || entry.Content.Value != tt.wantContent {
+				t.Errorf("content = %+v", entry.Content)
+			}
+
+			category := ""
+			if entry.Category != nil {
+				category = entry.Category.Term
+			}
+			if category != tt.wantCategory {
+				t.Errorf("category = %q, want %q", category, tt.wantCategory)
+			}
+		})
+	}
+}
+
+func TestJSON(t *testing.T) {
+	body, err := sampleFeed().JSON()
+	if err != nil {
+		t.Fatalf("JSON() error = %v", err)
+	}
+
+	var doc map[string]any
+	if err := json.Unmarshal(body, &doc); err != nil {
+		t.Fatalf("JSON() is not valid JSON: %v", err)
+	}
+
+	if doc["version"] != "https://jsonfeed.org/version/1.1" || doc["home_page_url"] != "https://blog.example" {
+		t.Errorf("feed = %v", doc)
+	}
+
+	items := doc["items"].([]any)
+	first := items[0].(map[string]any)
+	second := items[1].(map[string]any)
+
+	if first["content_html"] != "<p><strong>raw</strong> &amp; content</p>" || first["content_text"] != "**raw** & content" {
+		t.Errorf("first item content = %v", first)
+	}
+	if tags := first["tags"].([]any); len(tags) != 1 || tags[0] != "Tech" {
+		t.Errorf("first item tags = %v", first["tags"])
+	}
+	if first["date_published"] != "2026-01-01T20:04:05Z" {
+		t.Errorf("date_published = %v, want UTC RFC3339", first["date_published"])
+	}
+	if _, ok := second["content_html"]; ok {
+		t.Errorf("item without html must omit content_html: %v", second)
+	}
+	if _, ok := second["tags"]; ok {
+		t.Errorf("item without category must omit tags: %v", second)
+	}
+}
+
+func TestEmptyJSONFeedHasItems(t *testing.T) {
+	body, err := (&Feed{Title: "Blog"}).JSON()
+	if err != nil {
+		t.Fatalf("JSON() error = %v", err)
+	}
+
+	// JSON Feed mewajibkan items, feed kosong tetap menulis array kosong
+	if !strings.Contains(string(body), `"items":[]`) {
+		t.Fatalf("JSON() = %s, want an empty items array", body)
+	}
+}
@@ -0,0 +1,92 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+
+	// Register decoder gif dan webp untuk image.Decode
+	_ "image/gif"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// ErrTooManyPixels dimensi image melebihi batas, decode penuh akan memakan memory terlalu besar
+var ErrTooManyPixels = errors.New("image dimensions exceed the maximum pixel count")
+
+type Thumbnail struct {
+	Data        []byte
+	ContentType string
+	Width       int
+	Height      int
+}
+
+// DecodeConfig ambil dimensi image tanpa decode seluruh pixel
+func DecodeConfig(r io.Reader) (width, height int, err error) {
+	cfg, _, err := image.DecodeConfig(r)
+	if err != nil {
+		return 0, 0, err
+	}
+	return cfg.Width, cfg.Height, nil
+}
+
+// CheckPixels tolak image yang width x height melebihi maxPixels, di cek dari header sebelum decode
+// supaya file kecil yang mengaku 50000x50000 pixel (decompression bomb) tidak di alokasikan
+func CheckPixels(width, height int, maxPixels int64) error {
+	if maxPixels > 0 && int64(width)*int64(height) > maxPixels {
+		return ErrTooManyPixels
+	}
+
+	return nil
+}
+
+// GenerateThumbnail resize image dengan lebar maksimal maxWidth dan menjaga aspect ratio.
+// PNG tetap PNG supaya transparansi tidak hilang, format lain di encode ke JPEG
+func GenerateThumbnail(data []byte, maxWidth int, maxPixels int64) (*Thumbnail, error) {
+	width, height, err := DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckPixels(width, height, maxPixels); err != nil {
+		return nil, err
+	}
+
+	src, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := src.Bounds()
+	width, height = bounds.Dx(), bounds.Dy()
+	if width > maxWidth {
+		height = height * maxWidth / width
+		width = maxWidth
+	}
+	if height < 1 {
+		height = 1
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, bounds, draw.Over, nil)
+
+	var buf bytes.Buffer
+	thumb := &Thumbnail{Width: width, Height: height}
+
+	if format == "png" {
+		err = png.Encode(&buf, dst)
+		thumb.ContentType = "image/png"
+	} else {
+		err = jpeg.Encode(&buf, dst, &jpeg.Options{Quality: 85})
+		thumb.ContentType = "image/jpeg"
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	thumb.Data = buf.Bytes()
+	return thumb, nil
+}
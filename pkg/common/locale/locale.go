@@ -0,0 +1,138 @@
+package locale
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	DefaultLocale    = "id"
+	DefaultSupported = "id,en"
+)
+
+// Settings locale default dan daftar locale yang didukung aplikasi
+type Settings struct {
+	Default   string
+	Supported []string
+}
+
+// FromConfig baca DEFAULT_LOCALE dan SUPPORTED_LOCALES, default locale selalu termasuk supported
+func FromConfig(config *viper.Viper) Settings {
+	settings := Settings{
+		Default:   Normalize(config.GetString("DEFAULT_LOCALE")),
+		Supported: ParseList(config.GetString("SUPPORTED_LOCALES")),
+	}
+
+	if settings.Default == "" {
+		settings.Default = DefaultLocale
+	}
+	if len(settings.Supported) == 0 {
+		settings.Supported = ParseList(DefaultSupported)
+	}
+	if !settings.IsSupported(settings.Default) {
+		settings.Supported = append([]string{settings.Default}, settings.Supported...)
+	}
+
+	return settings
+}
+
+func (s Settings) IsSupported(tag string) bool {
+	for _, supported := range s.Supported {
+		if supported == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// Negotiate pilih locale dari ?lang= lalu Accept-Language, fallback ke default locale
+func (s Settings) Negotiate(lang, acceptLanguage string) string {
+	return Negotiate(lang, acceptLanguage, s.Supported, s.Default)
+}
+
+// Normalize ubah language tag jadi primary subtag huruf kecil, contoh "en-US" -> "en"
+func Normalize(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+
+	return tag
+}
+
+// ParseList baca daftar locale dipisah koma dari config
+func ParseList(value string) []string {
+	var locales []string
+	seen := make(map[string]bool)
+
+	for _, part := range strings.Split(value, ",") {
+		tag := Normalize(part)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		locales = append(locales, tag)
+	}
+
+	return locales
+}
+
+// Negotiate pilih locale yang didukung dari parameter ?lang= lalu header Accept-Language.
+// Return fallback jika tidak ada yang cocok
+func Negotiate(lang, acceptLanguage string, supported []string, fallback string) string {
+	isSupported := func(tag string) bool {
+		for _, s := range supported {
+			if s == tag {
+				return true
+			}
+		}
+		return false
+	}
+
+	if tag := Normalize(lang); tag != "" && isSupported(tag) {
+		return tag
+	}
+
+	type weighted struct {
+		tag string
+		q   float64
+	}
+
+	var candidates []weighted
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		fields := strings.Split(part, ";")
+		tag := Normalize(fields[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if value, ok := strings.CutPrefix(param, "q="); ok {
+				if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+					q = parsed
+				}
+			}
+		}
+
+		if q > 0 {
+			candidates = append(candidates, weighted{tag: tag, q: q})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].q > candidates[j].q
+	})
+
+	for _, candidate := range candidates {
+		if isSupported(candidate.tag) {
+			return candidate.tag
+		}
+	}
+
+	return fallback
+}
@@ -0,0 +1,50 @@
+package locale
+
+import "testing"
+
+func TestNegotiate(t *testing.T) {
+	supported := []string{"id", "en"}
+
+	tests := []struct {
+		name           string
+		lang           string
+		acceptLanguage string
+		want           string
+	}{
+		{name: "nothing requested", want: "id"},
+		{name: "lang parameter wins", lang: "en", acceptLanguage: "id", want: "en"},
+		{name: "lang parameter region is dropped", lang: "en-GB", want: "en"},
+		{name: "unsupported lang falls back to header", lang: "fr", acceptLanguage: "en", want: "en"},
+		{name: "header region is dropped", acceptLanguage: "en-US", want: "en"},
+		{name: "highest quality first", acceptLanguage: "id;q=0.5, en;q=0.9", want: "en"},
+		{name: "equal quality keeps header order", acceptLanguage: "en, id", want: "en"},
+		{name: "unsupported languages are skipped", acceptLanguage: "fr, de;q=0.9, id;q=0.1", want: "id"},
+		{name: "q=0 means not acceptable", acceptLanguage: "en;q=0, fr", want: "id"},
+		{name: "wildcard is ignored", acceptLanguage: "*", want: "id"},
+		{name: "invalid quality counts as 1", acceptLanguage: "id;q=abc, en;q=0.8", want: "id"},
+		{name: "case and spaces", acceptLanguage: "  EN_us ; q=0.7 ", want: "en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Negotiate(tt.lang, tt.acceptLanguage, supported, "id"); got != tt.want {
+				t.Errorf("Negotiate(%q, %q) = %q, want %q", tt.lang, tt.acceptLanguage, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := map[string]string{
+		"en-US": "en",
+		"id_ID": "id",
+		" EN ":  "en",
+		"":      "",
+	}
+
+	for tag, want := range tests {
+		if got := Normalize(tag); got != want {
+			t.Errorf("Normalize(%q) = %q, want %q", tag, got, want)
+		}
+	}
+}
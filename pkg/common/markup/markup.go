@@ -0,0 +1,79 @@
+package markup
+
+import (
+	"bytes"
+	"html"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+)
+
+type Format string
+
+const (
+	FormatMarkdown Format = "markdown"
+	FormatHTML     Format = "html"
+	FormatPlain    Format = "plain"
+)
+
+func (f Format) IsValid() bool {
+	switch f {
+	case FormatMarkdown, FormatHTML, FormatPlain:
+		return true
+	default:
+		return false
+	}
+}
+
+var (
+	markdown = goldmark.New(
+		goldmark.WithExtensions(extension.GFM),
+	)
+
+	// Policy UGC sudah membuang script, style, event handler dan url javascript:
+	policy = newPolicy()
+)
+
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.RequireNoFollowOnLinks(true)
+	p.AddTargetBlankToFullyQualifiedLinks(true)
+	return p
+}
+
+// Render ubah content sesuai format menjadi HTML yang sudah di sanitize
+func Render(format Format, content string) (string, error) {
+	switch format {
+	case FormatHTML:
+		return policy.Sanitize(content), nil
+	case FormatPlain:
+		return renderPlain(content), nil
+	default:
+		var buf bytes.Buffer
+		if err := markdown.Convert([]byte(content), &buf); err != nil {
+			return "", err
+		}
+		return policy.Sanitize(buf.String()), nil
+	}
+}
+
+// renderPlain escape text dan pecah paragraf berdasarkan baris kosong
+func renderPlain(content string) string {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+
+	var b strings.Builder
+	for _, paragraph := range strings.Split(content, "\n\n") {
+		paragraph = strings.TrimSpace(paragraph)
+		if paragraph == "" {
+			continue
+		}
+
+		b.WriteString("<p>")
+		b.WriteString(strings.ReplaceAll(html.EscapeString(paragraph), "\n", "<br>"))
+		b.WriteString("</p>\n")
+	}
+
+	return b.String()
+}
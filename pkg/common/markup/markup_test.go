@@ -0,0 +1,171 @@
+package markup
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderRemovesUnsafeMarkup(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  Format
+		content string
+		want    []string
+		notWant []string
+	}{
+		{
+			name:    "html script",
+			format:  FormatHTML,
+			content: `<p>Hello</p><script>alert(1)</script>`,
+			want:    []string{"<p>Hello</p>"},
+			notWant: []string{"<script", "alert(1)"},
+		},
+		{
+			name:    "html javascript link",
+			format:  FormatHTML,
+			content: `<a href="javascript:alert(1)">click</a>`,
+			want:    []string{"click"},
+			notWant: []string{"javascript:", "href"},
+		},
+		{
+			name:    "html event handler",
+			format:  FormatHTML,
+			content: `<img src="https://example.com/a.png" onerror="alert(1)"><p onclick="steal()">text</p>`,
+			want:    []string{`src="https://example.com/a.png"`, "<p>text</p>"},
+			notWant: []string{"onerror", "onclick", "alert(1)", "steal()"},
+		},
+		{
+			name:    "html style and iframe",
+			format:  FormatHTML,
+			content: `<style>body{display:none}</style><iframe src="https://evil.example"></iframe><p>ok</p>`,
+			want:    []string{"<p>ok</p>"},
+			notWant: []string{"<style", "<iframe", "evil.example"},
+		},
+		{
+			name:    "markdown raw html",
+			format:  FormatMarkdown,
+			content: "# Title\n\n<script>alert(1)</script>\n\n<div onclick=\"steal()\">raw</div>",
+			want:    []string{"<h1>Title</h1>"},
+			notWant: []string{"<script", "alert(1)", "<div", "onclick", "steal()"},
+		},
+		{
+			name:    "markdown javascript link",
+			format:  FormatMarkdown,
+			content: "[click](javascript:alert(1))",
+			want:    []string{"click"},
+			notWant: []string{"javascript:"},
+		},
+		{
+			name:    "markdown inline raw html",
+			format:  FormatMarkdown,
+			content: `Hello <img src=x onerror="alert(1)"> world`,
+			want:    []string{"Hello", "world"},
+			notWant: []string{"onerror", "alert(1)"},
+		},
+		{
+			name:    "plain text is escaped",
+			format:  FormatPlain,
+			content: `<script>alert(1)</script>`,
+			want:    []string{"<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>"},
+			notWant: []string{"<script"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Render(tt.format, tt.content)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			assertHTML(t, got, tt.want, tt.notWant)
+		})
+	}
+}
+
+func TestRenderKeepsMarkdown(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{
+			name:    "gfm table",
+			content: "| Name | Value |\n|------|-------|\n| a | 1 |",
+			want:    []string{"<table>", "<th>Name</th>", "<td>a</td>", "<td>1</td>"},
+		},
+		{
+			name:    "external link gets nofollow and target blank",
+			content: "[docs](https://example.com/docs)",
+			want:    []string{`href="https://example.com/docs"`, `rel="nofollow noopener"`, `target="_blank"`, ">docs</a>"},
+		},
+		{
+			name:    "relative link",
+			content: "[next](/article/2)",
+			want:    []string{`href="/article/2"`, ">next</a>"},
+		},
+		{
+			name:    "autolink",
+			content: "see https://example.com",
+			want:    []string{`href="https://example.com"`},
+		},
+		{
+			name:    "emphasis, code and strikethrough",
+			content: "**bold** `code` ~~old~~",
+			want:    []string{"<strong>bold</strong>", "<code>code</code>", "<del>old</del>"},
+		},
+		{
+			name:    "image",
+			content: "![cover](https://example.com/cover.png)",
+			want:    []string{`<img src="https://example.com/cover.png" alt="cover"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Render(FormatMarkdown, tt.content)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			assertHTML(t, got, tt.want, nil)
+		})
+	}
+}
+
+func TestRenderPlain(t *testing.T) {
+	got, err := Render(FormatPlain, "first line\r\nsecond line\r\n\r\n\r\nnext paragraph\n\n")
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	if want := "<p>first line<br>second line</p>\n<p>next paragraph</p>\n"; got != want {
+		t.Fatalf("Render() = %q, want %q", got, want)
+	}
+}
+
+func TestFormatIsValid(t *testing.T) {
+	for _, format := range []Format{FormatMarkdown, FormatHTML, FormatPlain} {
+		if !format.IsValid() {
+			t.Errorf("%q.IsValid() = false", format)
+		}
+	}
+	for _, format := range []Format{"", "rst", "Markdown"} {
+		if format.IsValid() {
+			t.Errorf("%q.IsValid() = true", format)
+		}
+	}
+}
+
+func assertHTML(t *testing.T, got string, want, notWant []string) {
+	t.Helper()
+
+	for _, s := range want {
+		if !strings.Contains(got, s) {
+			t.Errorf("output %q does not contain %q", got, s)
+		}
+	}
+	for _, s := range notWant {
+		if strings.Contains(got, s) {
+			t.Errorf("output %q contains %q", got, s)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package netguard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// ErrBlockedAddress host mengarah ke jaringan internal (private, loopback, link-local, metadata cloud)
+var ErrBlockedAddress = errors.New("address is not allowed")
+
+// Alamat yang tidak termasuk IsPrivate / IsLoopback / IsLinkLocal tapi tetap internal
+var blockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),      // "this network"
+	netip.MustParsePrefix("100.64.0.0/10"),  // carrier-grade NAT
+	netip.MustParsePrefix("192.0.0.0/24"),   // IETF protocol assignments
+	netip.MustParsePrefix("198.18.0.0/15"),  // benchmarking
+	netip.MustParsePrefix("240.0.0.0/4"),    // reserved, termasuk broadcast
+	netip.MustParsePrefix("64:ff9b::/96"),   // NAT64, bisa menerjemahkan ke IPv4 internal
+	netip.MustParsePrefix("64:ff9b:1::/48"), // NAT64 local-use
+}
+
+// IsBlocked true kalau ip bukan alamat publik. Metadata cloud (169.254.169.254, fd00:ec2::254)
+// sudah tercakup link-local dan unique local
+func IsBlocked(ip netip.Addr) bool {
+	ip = ip.Unmap()
+
+	if !ip.IsValid() || ip.IsUnspecified() || ip.IsLoopback() || ip.IsPrivate() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return true
+	}
+
+	for _, prefix := range blockedPrefixes {
+		if prefix.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Guard cek host sebelum request keluar. AllowPrivate untuk development, misal webhook ke localhost
+type Guard struct {
+	AllowPrivate bool
+
+	// Lookup resolver DNS, nil berarti net.DefaultResolver
+	Lookup func(ctx context.Context, network, host string) ([]netip.Addr, error)
+}
+
+// CheckHost resolve host lalu tolak kalau salah satu alamatnya internal. Hanya cek awal (saat subscribe),
+// alamat DNS bisa berubah sehingga koneksi tetap di cek lagi oleh Dialer
+func (g Guard) CheckHost(ctx context.Context, host string) error {
+	if g.AllowPrivate {
+		return nil
+	}
+
+	if ip, err := netip.ParseAddr(host); err == nil {
+		return checkAddr(ip)
+	}
+
+	lookup := g.Lookup
+	if lookup == nil {
+		lookup = net.DefaultResolver.LookupNetIP
+	}
+
+	ips, err := lookup(ctx, "ip", host)
+	if err != nil {
+		return fmt.Errorf("resolve %s: %w", host, err)
+	}
+	if len(ips) == 0 {
+		return fmt.Errorf("resolve %s: no addresses", host)
+	}
+
+	for _, ip := range ips {
+		if err := checkAddr(ip); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Dialer tolak koneksi ke alamat internal. Cek dilakukan di Control, setelah DNS di resolve dan tepat
+// sebelum connect, sehingga DNS rebinding dan redirect ke alamat internal ikut tertahan
+func (g Guard) Dialer(timeout time.Duration) *net.Dialer {
+	dialer := &net.Dialer{Timeout: timeout}
+	if g.AllowPrivate {
+		return dialer
+	}
+
+	dialer.Control = func(_, address string, _ syscall.RawConn) error {
+		addrPort, err := netip.ParseAddrPort(address)
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrBlockedAddress, address)
+		}
+
+		return checkAddr(addrPort.Addr())
+	}
+
+	return dialer
+}
+
+// Client http.Client yang hanya connect ke alamat publik. Proxy dari environment tidak dipakai
+// karena koneksi ke proxy tidak melewati cek alamat tujuan
+func (g Guard) Client(timeout time.Duration) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = g.Dialer(timeout).DialContext
+
+	return &http.Client{Timeout: timeout, Transport: transport}
+}
+
+func checkAddr(ip netip.Addr) error {
+	if IsBlocked(ip) {
+		return fmt.Errorf("%w: %s", ErrBlockedAddress, ip)
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package netguard
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+func TestIsBlocked(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{ip: "127.0.0.1", want: true},
+		{ip: "10.1.2.3", want: true},
+		{ip: "172.16.0.1", want: true},
+		{ip: "192.168.1.10", want: true},
+		{ip: "169.254.169.254", want: true},
+		{ip: "100.64.0.1", want: true},
+		{ip: "0.0.0.0", want: true},
+		{ip: "255.255.255.255", want: true},
+		{ip: "224.0.0.1", want: true},
+		{ip: "::1", want: true},
+		{ip: "::", want: true},
+		{ip: "fe80::1", want: true},
+		{ip: "fd00:ec2::254", want: true},
+		{ip: "::ffff:127.0.0.1", want: true},
+		{ip: "64:ff9b::a00:1", want: true},
+		{ip: "93.184.216.34", want: false},
+		{ip: "8.8.8.8", want: false},
+		{ip: "2606:4700:4700::1111", want: false},
+		{ip: "::ffff:8.8.8.8", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			if got := IsBlocked(netip.MustParseAddr(tt.ip)); got != tt.want {
+				t.Errorf("IsBlocked(%s) = %v, want %v", tt.ip, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckHost(t *testing.T) {
+	records := map[string][]string{
+		"partner.example":  {"93.184.216.34"},
+		"internal.example": {"10.0.0.5"},
+		"mixed.example":    {"93.184.216.34", "127.0.0.1"},
+		"empty.example":    {},
+	}
+	lookup := func(_ context.Context, _, host string) ([]netip.Addr, error) {
+		addrs, ok := records[host]
+		if !ok {
+			return nil, errors.New("no such host")
+		}
+		ips := make([]netip.Addr, len(addrs))
+		for i, addr := range addrs {
+			ips[i] = netip.MustParseAddr(addr)
+		}
+		return ips, nil
+	}
+
+	tests := []struct {
+		name         string
+		host         string
+		allowPrivate bool
+		wantErr      bool
+		wantBlocked  bool
+	}{
+		{name: "public host", host: "partner.example"},
+		{name: "public ip literal", host: "93.184.216.34"},
+		{name: "private host", host: "internal.example", wantErr: true, wantBlocked: true},
+		{name: "any private address blocks", host: "mixed.example", wantErr: true, wantBlocked: true},
+		{name: "loopback literal", host: "127.0.0.1", wantErr: true, wantBlocked: true},
+		{name: "metadata literal", host: "169.254.169.254", wantErr: true, wantBlocked: true},
+		{name: "ipv6 loopback literal", host: "::1", wantErr: true, wantBlocked: true},
+		{name: "unresolvable host", host: "missing.example", wantErr: true},
+		{name: "host without addresses", host: "empty.example", wantErr: true},
+		{name: "allow private", host: "internal.example", allowPrivate: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			guard := Guard{AllowPrivate: tt.allowPrivate, Lookup: lookup}
+
+			err := guard.CheckHost(context.Background(), tt.host)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CheckHost(%q) = %v, want error %v", tt.host, err, tt.wantErr)
+			}
+			if errors.Is(err, ErrBlockedAddress) != tt.wantBlocked {
+				t.Fatalf("CheckHost(%q) = %v, want ErrBlockedAddress %v", tt.host, err, tt.wantBlocked)
+			}
+		})
+	}
+}
+
+func TestClientBlocksAtDialTime(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name         string
+		url          string
+		allowPrivate bool
+		wantBlocked  bool
+	}{
+		{name: "loopback is blocked", url: server.URL, wantBlocked: true},
+		{name: "allow private connects", url: server.URL, allowPrivate: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := Guard{AllowPrivate: tt.allowPrivate}.Client(time.Second)
+
+			resp, err := client.Get(tt.url)
+			if resp != nil {
+				resp.Body.Close()
+			}
+
+			if errors.Is(err, ErrBlockedAddress) != tt.wantBlocked {
+				t.Fatalf("Get(%s) = %v, want ErrBlockedAddress %v", tt.url, err, tt.wantBlocked)
+			}
+			if !tt.wantBlocked && (err != nil || resp.StatusCode != http.StatusNoContent) {
+				t.Fatalf("Get(%s) = %v, %v", tt.url, resp, err)
+			}
+		})
+	}
+}
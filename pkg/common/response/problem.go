@@ -0,0 +1,99 @@
+package response
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ContentTypeProblem media type RFC 7807
+const ContentTypeProblem = "application/problem+json"
+
+// problemTypeBase prefix URI type problem, kosong berarti "about:blank"
+var problemTypeBase string
+
+// SetProblemTypeBase set prefix URI dokumentasi problem, type menjadi base + error code (kebab case)
+func SetProblemTypeBase(base string) {
+	problemTypeBase = strings.TrimSpace(base)
+}
+
+// FieldError satu kegagalan validasi per field. Params nilai placeholder untuk menerjemahkan message,
+// MessageID id pesan error domain di catalog i18n
+type FieldError struct {
+	Field     string            `json:"field"`
+	Code      string            `json:"code"`
+	Message   string            `json:"message"`
+	Params    map[string]string `json:"-"`
+	MessageID string            `json:"-"`
+}
+
+// Problem error response format RFC 7807 (application/problem+json). Problem juga error
+// sehingga handler cukup me-return problem dan error handler yang menulis response
+type Problem struct {
+	Type      string         `json:"type"`
+	Title     string         `json:"title"`
+	Status    int            `json:"status"`
+	Detail    string         `json:"detail,omitempty"`
+	Instance  string         `json:"instance,omitempty"`
+	ErrorCode string         `json:"error_code,omitempty"`
+	RequestID string         `json:"request_id,omitempty"`
+	Errors    []FieldError   `json:"errors,omitempty"`
+	Details   map[string]any `json:"details,omitempty"`
+
+	// MessageID id detail di catalog i18n, kosong berarti memakai pesan umum ErrorCode
+	MessageID string `json:"-"`
+}
+
+func NewProblem(status int, errorCode, detail string) *Problem {
+	return &Problem{
+		Type:      problemType(errorCode),
+		Title:     http.StatusText(status),
+		Status:    status,
+		Detail:    detail,
+		ErrorCode: errorCode,
+	}
+}
+
+func NewValidationProblem(detail string, errors []FieldError) *Problem {
+	problem := NewProblem(fiber.StatusBadRequest, "VALIDATION_ERROR", detail)
+	problem.Errors = errors
+	return problem
+}
+
+func (p *Problem) Error() string {
+	if p.Detail != "" {
+		return p.Detail
+	}
+
+	return p.Title
+}
+
+// WithMessageID set id detail untuk catalog i18n, dipakai kalau satu ErrorCode punya beberapa pesan
+func (p *Problem) WithMessageID(id string) *Problem {
+	p.MessageID = id
+	return p
+}
+
+// WithDetails tambah informasi tambahan, misal rincian health check
+func (p *Problem) WithDetails(details map[string]any) *Problem {
+	p.Details = details
+	return p
+}
+
+// Send tulis problem ke response, instance di isi path request kalau belum di set
+func (p *Problem) Send(c *fiber.Ctx) error {
+	if p.Instance == "" {
+		p.Instance = c.OriginalURL()
+	}
+
+	return c.Status(p.Status).JSON(p, ContentTypeProblem)
+}
+
+func problemType(errorCode string) string {
+	if problemTypeBase == "" || errorCode == "" {
+		return "about:blank"
+	}
+
+	return strings.TrimSuffix(problemTypeBase, "/") + "/" + strings.ReplaceAll(strings.ToLower(errorCode), "_", "-")
+}
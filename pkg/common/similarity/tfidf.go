@@ -0,0 +1,101 @@
+package similarity
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+// Kata umum bahasa Indonesia dan Inggris yang tidak membawa makna topik
+var stopWords = map[string]bool{
+	"dan": true, "yang": true, "di": true, "ke": true, "dari": true, "untuk": true, "dengan": true,
+	"ini": true, "itu": true, "atau": true, "pada": true, "adalah": true, "dalam": true, "tidak": true,
+	"akan": true, "juga": true, "bisa": true, "ada": true, "kita": true, "kami": true, "saya": true,
+	"the": true, "and": true, "for": true, "with": true, "that": true, "this": true, "are": true,
+	"was": true, "you": true, "your": true, "from": true, "have": true, "has": true, "not": true,
+	"but": true, "can": true, "will": true, "into": true, "its": true, "our": true, "how": true,
+}
+
+// Vector term weight yang sudah di normalisasi (panjang 1)
+type Vector map[string]float64
+
+// Tokenize pecah text jadi term huruf kecil, buang stop word dan term pendek
+func Tokenize(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	terms := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if len([]rune(field)) < 3 || stopWords[field] {
+			continue
+		}
+		terms = append(terms, field)
+	}
+
+	return terms
+}
+
+// Index TF-IDF untuk sekumpulan dokumen yang di identifikasi dengan ID
+type Index struct {
+	vectors map[uint]Vector
+}
+
+// NewIndex hitung vector TF-IDF setiap dokumen dari daftar term-nya
+func NewIndex(docs map[uint][]string) *Index {
+	docFreq := make(map[string]int)
+	for _, terms := range docs {
+		seen := make(map[string]bool, len(terms))
+		for _, term := range terms {
+			if !seen[term] {
+				seen[term] = true
+				docFreq[term]++
+			}
+		}
+	}
+
+	total := float64(len(docs))
+	vectors := make(map[uint]Vector, len(docs))
+
+	for id, terms := range docs {
+		termFreq := make(map[string]int, len(terms))
+		for _, term := range terms {
+			termFreq[term]++
+		}
+
+		vector := make(Vector, len(termFreq))
+		var norm float64
+		for term, freq := range termFreq {
+			// Smoothed idf supaya term yang muncul di semua dokumen tetap > 0
+			weight := (1 + math.Log(float64(freq))) * (1 + math.Log((1+total)/(1+float64(docFreq[term]))))
+			vector[term] = weight
+			norm += weight * weight
+		}
+
+		if norm > 0 {
+			norm = math.Sqrt(norm)
+			for term := range vector {
+				vector[term] /= norm
+			}
+		}
+
+		vectors[id] = vector
+	}
+
+	return &Index{vectors: vectors}
+}
+
+// Similarity cosine similarity dua dokumen, 0 jika salah satu tidak ada di index
+func (i *Index) Similarity(a, b uint) float64 {
+	va, vb := i.vectors[a], i.vectors[b]
+	if len(va) > len(vb) {
+		va, vb = vb, va
+	}
+
+	var score float64
+	for term, weight := range va {
+		score += weight * vb[term]
+	}
+
+	return score
+}
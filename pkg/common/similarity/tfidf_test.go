@@ -0,0 +1,70 @@
+package similarity
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{name: "lowercase and split on punctuation", text: "Golang, Fiber & GORM!", want: []string{"golang", "fiber", "gorm"}},
+		{name: "stop words removed", text: "belajar golang dan fiber untuk pemula", want: []string{"belajar", "golang", "fiber", "pemula"}},
+		{name: "short terms removed", text: "go is ok but rust", want: []string{"rust"}},
+		{name: "digits kept", text: "tips 2026", want: []string{"tips", "2026"}},
+		{name: "empty", text: "", want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Tokenize(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Tokenize(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndexSimilarity(t *testing.T) {
+	index := NewIndex(map[uint][]string{
+		1: {"golang", "fiber", "gorm", "tutorial"},
+		2: {"golang", "fiber", "gorm", "tutorial"},
+		3: {"golang", "fiber", "middleware"},
+		4: {"resep", "nasi", "goreng"},
+		5: {"tutorial", "resep"},
+		6: {},
+	})
+
+	tests := []struct {
+		name string
+		a, b uint
+		want func(score float64) bool
+		desc string
+	}{
+		{name: "identical documents", a: 1, b: 2, want: func(s float64) bool { return math.Abs(s-1) < 1e-9 }, desc: "1"},
+		{name: "disjoint documents", a: 1, b: 4, want: func(s float64) bool { return s == 0 }, desc: "0"},
+		{name: "partial overlap", a: 1, b: 3, want: func(s float64) bool { return s > 0 && s < 1 }, desc: "between 0 and 1"},
+		{name: "unknown document", a: 1, b: 99, want: func(s float64) bool { return s == 0 }, desc: "0"},
+		{name: "empty document", a: 1, b: 6, want: func(s float64) bool { return s == 0 }, desc: "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score := index.Similarity(tt.a, tt.b)
+			if !tt.want(score) {
+				t.Errorf("Similarity(%d, %d) = %v, want %s", tt.a, tt.b, score, tt.desc)
+			}
+			if reverse := index.Similarity(tt.b, tt.a); math.Abs(reverse-score) > 1e-12 {
+				t.Errorf("Similarity is not symmetric: %v vs %v", score, reverse)
+			}
+		})
+	}
+
+	// Dokumen dengan lebih banyak term yang sama harus lebih mirip
+	if index.Similarity(1, 3) <= index.Similarity(1, 5) {
+		t.Errorf("Similarity(1, 3) = %v should rank above Similarity(1, 5) = %v", index.Similarity(1, 3), index.Similarity(1, 5))
+	}
+}
@@ -0,0 +1,114 @@
+package sitemap
+
+import (
+	"bufio"
+	"encoding/xml"
+	"io"
+	"time"
+)
+
+const (
+	// Batas protocol sitemaps.org per file
+	MaxURLsPerSitemap = 50000
+
+	ContentType = "application/xml; charset=utf-8"
+
+	namespace = "http://www.sitemaps.org/schemas/sitemap/0.9"
+)
+
+type URL struct {
+	XMLName xml.Name `xml:"url"`
+	Loc     string   `xml:"loc"`
+	LastMod string   `xml:"lastmod,omitempty"`
+}
+
+type IndexEntry struct {
+	XMLName xml.Name `xml:"sitemap"`
+	Loc     string   `xml:"loc"`
+	LastMod string   `xml:"lastmod,omitempty"`
+}
+
+// Writer menulis urlset secara incremental supaya tidak perlu menampung semua url di memory
+type Writer struct {
+	buf     *bufio.Writer
+	encoder *xml.Encoder
+	count   int
+	err     error
+}
+
+func NewWriter(w io.Writer) *Writer {
+	buf := bufio.NewWriter(w)
+	sw := &Writer{
+		buf:     buf,
+		encoder: xml.NewEncoder(buf),
+	}
+
+	sw.writeString(xml.Header + `<urlset xmlns="` + namespace + `">`)
+	return sw
+}
+
+func (w *Writer) Add(loc string, lastMod time.Time) error {
+	if w.err != nil {
+		return w.err
+	}
+
+	entry := URL{Loc: loc}
+	if !lastMod.IsZero() {
+		entry.LastMod = lastMod.UTC().Format(time.RFC3339)
+	}
+
+	if err := w.encoder.Encode(entry); err != nil {
+		w.err = err
+		return err
+	}
+
+	w.count++
+	return nil
+}
+
+func (w *Writer) Count() int {
+	return w.count
+}
+
+// Close menutup tag urlset dan flush buffer
+func (w *Writer) Close() error {
+	w.writeString("</urlset>")
+	if w.err != nil {
+		return w.err
+	}
+	return w.buf.Flush()
+}
+
+func (w *Writer) writeString(s string) {
+	if w.err != nil {
+		return
+	}
+	if err := w.encoder.Flush(); err != nil {
+		w.err = err
+		return
+	}
+	_, w.err = w.buf.WriteString(s)
+}
+
+// WriteIndex menulis sitemap index yang menunjuk ke beberapa file sitemap
+func WriteIndex(w io.Writer, entries []IndexEntry) error {
+	buf := bufio.NewWriter(w)
+	if _, err := buf.WriteString(xml.Header + `<sitemapindex xmlns="` + namespace + `">`); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(buf)
+	for _, entry := range entries {
+		if err := encoder.Encode(entry); err != nil {
+			return err
+		}
+	}
+	if err := encoder.Flush(); err != nil {
+		return err
+	}
+
+	if _, err := buf.WriteString("</sitemapindex>"); err != nil {
+		return err
+	}
+	return buf.Flush()
+}
@@ -0,0 +1,131 @@
+package sitemap
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+type urlSet struct {
+	XMLName xml.Name
+	URLs    []struct {
+		Loc     string `xml:"loc"`
+		LastMod string `xml:"lastmod"`
+	} `xml:"url"`
+}
+
+func TestWriter(t *testing.T) {
+	updated := time.Date(2026, 1, 2, 3, 4, 5, 0, time.FixedZone("WIB", 7*3600))
+
+	tests := []struct {
+		name        string
+		locs        []string
+		lastMod     time.Time
+		wantLastMod string
+	}{
+		{name: "empty urlset"},
+		{name: "lastmod in utc", locs: []string{"https://blog.example/article/a", "https://blog.example/article/b"}, lastMod: updated, wantLastMod: "2026-01-01T20:04:05Z"},
+		{name: "zero lastmod is omitted", locs: []string{"https://blog.example/article/a"}},
+		{name: "loc is escaped", locs: []string{"https://blog.example/search?a=1&b=<2>"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			writer := NewWriter(&buf)
+			for _, loc := range tt.locs {
+				if err := writer.Add(loc, tt.lastMod); err != nil {
+					t.Fatalf("Add(%q) error = %v", loc, err)
+				}
+			}
+			if err := writer.Close(); err != nil {
+				t.Fatalf("Close() error = %v", err)
+			}
+
+			if writer.Count() != len(tt.locs) {
+				t.Errorf("Count() = %d, want %d", writer.Count(), len(tt.locs))
+			}
+			if !strings.HasPrefix(buf.String(), xml.Header) {
+				t.Errorf("output must start with the XML header: %q", buf.String())
+			}
+
+			var doc urlSet
+			if err := xml.Unmarshal(buf.Bytes(), &doc); err != nil {
+				t.Fatalf("output is not valid XML: %v\n%s", err, buf.String())
+			}
+			if doc.XMLName.Space != namespace || doc.XMLName.Local != "urlset" {
+				t.Errorf("root = %+v", doc.XMLName)
+			}
+			if len(doc.URLs) != len(tt.locs) {
+				t.Fatalf("urls = %d, want %d", len(doc.URLs), len(tt.locs))
+			}
+			for i, url := range doc.URLs {
+				if url.Loc != tt.locs[i] || url.LastMod != tt.wantLastMod {
+					t.Errorf("url %d = %+v, want loc %q lastmod %q", i, url, tt.locs[i], tt.wantLastMod)
+				}
+			}
+			if tt.wantLastMod == "" && strings.Contains(buf.String(), "<lastmod>") {
+				t.Errorf("output contains lastmod: %s", buf.String())
+			}
+		})
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestWriterReturnsWriteError(t *testing.T) {
+	writer := NewWriter(failingWriter{})
+	for i := 0; i < 100; i++ {
+		// Error baru muncul saat buffer penuh, setelah itu Add selalu gagal
+		_ = writer.Add("https://blog.example/article/"+strings.Repeat("a", 100), time.Time{})
+	}
+
+	if err := writer.Close(); err == nil {
+		t.Fatal("Close() error = nil, want the write error")
+	}
+	if err := writer.Add("https://blog.example/article/b", time.Time{}); err == nil {
+		t.Fatal("Add() after a failed write error = nil")
+	}
+}
+
+func TestWriteIndex(t *testing.T) {
+	entries := []IndexEntry{
+		{Loc: "https://blog.example/sitemaps/sitemap-1.xml"},
+		{Loc: "https://blog.example/sitemaps/sitemap-2.xml", LastMod: "2026-01-01T00:00:00Z"},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteIndex(&buf, entries); err != nil {
+		t.Fatalf("WriteIndex() error = %v", err)
+	}
+
+	var doc struct {
+		XMLName  xml.Name
+		Sitemaps []struct {
+			Loc     string `xml:"loc"`
+			LastMod string `xml:"lastmod"`
+		} `xml:"sitemap"`
+	}
+	if err := xml.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("output is not valid XML: %v", err)
+	}
+
+	if doc.XMLName.Space != namespace || doc.XMLName.Local != "sitemapindex" {
+		t.Errorf("root = %+v", doc.XMLName)
+	}
+	if len(doc.Sitemaps) != 2 {
+		t.Fatalf("sitemaps = %d, want 2", len(doc.Sitemaps))
+	}
+	for i, entry := range doc.Sitemaps {
+		if entry.Loc != entries[i].Loc || entry.LastMod != entries[i].LastMod {
+			t.Errorf("sitemap %d = %+v, want %+v", i, entry, entries[i])
+		}
+	}
+}
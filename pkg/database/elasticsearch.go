@@ -15,12 +15,17 @@ type ElasticsearchConfig struct {
 	Password string
 }
 
-func NewElasticsearch(cfg *viper.Viper, log *zap.Logger) (*elasticsearch.Client, error) {
-	config := ElasticsearchConfig{
+func newElasticsearchConfig(cfg *viper.Viper) ElasticsearchConfig {
+	return ElasticsearchConfig{
 		URLs:     strings.Split(cfg.GetString("ELASTICSEARCH_URLS"), ","),
 		Username: cfg.GetString("ELASTICSEARCH_USERNAME"),
 		Password: cfg.GetString("ELASTICSEARCH_PASSWORD"),
 	}
+}
+
+// NewElasticsearchClient buat client tanpa ping
+func NewElasticsearchClient(cfg *viper.Viper) (*elasticsearch.Client, error) {
+	config := newElasticsearchConfig(cfg)
 
 	// Configure Elasticsearch
 	esCfg := elasticsearch.Config{
@@ -35,6 +40,17 @@ func NewElasticsearch(cfg *viper.Viper, log *zap.Logger) (*elasticsearch.Client,
 		return nil, fmt.Errorf("failed to create elasticsearch client: %w", err)
 	}
 
+	return client, nil
+}
+
+func NewElasticsearch(cfg *viper.Viper, log *zap.Logger) (*elasticsearch.Client, error) {
+	config := newElasticsearchConfig(cfg)
+
+	client, err := NewElasticsearchClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	// Ping Elasticsearch
 	res, err := client.Info()
 	if err != nil {
@@ -17,20 +17,31 @@ type RedisConfig struct {
 	DB       int
 }
 
-func NewRedis(env *viper.Viper, log *zap.Logger) (*redis.Client, error) {
-	config := RedisConfig{
+func newRedisConfig(env *viper.Viper) RedisConfig {
+	return RedisConfig{
 		Host:     env.GetString("REDIS_HOST"),
 		Port:     env.GetString("REDIS_PORT"),
 		Password: env.GetString("REDIS_PASSWORD"),
 		DB:       env.GetInt("REDIS_DB"),
 	}
+}
 
-	// Create redis client
-	client := redis.NewClient(&redis.Options{
+// NewRedisClient buat client tanpa ping, koneksi baru dibuka saat command pertama
+func NewRedisClient(env *viper.Viper) *redis.Client {
+	config := newRedisConfig(env)
+
+	return redis.NewClient(&redis.Options{
 		Addr:     fmt.Sprintf("%s:%s", config.Host, config.Port),
 		Password: config.Password,
 		DB:       config.DB,
 	})
+}
+
+func NewRedis(env *viper.Viper, log *zap.Logger) (*redis.Client, error) {
+	config := newRedisConfig(env)
+
+	// Create redis client
+	client := NewRedisClient(env)
 
 	// Ping Redis
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -0,0 +1,22 @@
+package database
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// TableName nama tabel tanpa alias, Table("outbox_events AS o") membuat Statement.Table berisi alias
+func TableName(stmt *gorm.Statement) string {
+	if stmt.TableExpr != nil {
+		if fields := strings.Fields(stmt.TableExpr.SQL); len(fields) > 0 {
+			return strings.Trim(fields[0], "`\"")
+		}
+	}
+
+	if stmt.Table != "" {
+		return stmt.Table
+	}
+
+	return "unknown"
+}
@@ -0,0 +1,54 @@
+package events
+
+import (
+	"context"
+	"sync"
+)
+
+// Bus publisher in-process, dipakai komponen lain di proses yang sama
+// (cache, stream ke client) tanpa broker eksternal
+type Bus struct {
+	mu          sync.RWMutex
+	nextID      int
+	subscribers map[int]chan Event
+}
+
+func NewBus() *Bus {
+	return &Bus{subscribers: make(map[int]chan Event)}
+}
+
+// Subscribe return channel event dan fungsi untuk berhenti subscribe.
+// Subscriber yang lambat sampai buffer penuh akan kehilangan event, bukan memblok relay
+func (b *Bus) Subscribe(buffer int) (<-chan Event, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := b.nextID
+	b.nextID++
+	ch := make(chan Event, buffer)
+	b.subscribers[id] = ch
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			delete(b.subscribers, id)
+			close(ch)
+		})
+	}
+}
+
+func (b *Bus) Publish(ctx context.Context, event Event) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, ch := range b.subscribers {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+// Tipe domain event artikel
+const (
+	ArticleCreated   = "article.created"
+	ArticleUpdated   = "article.updated"
+	ArticleDeleted   = "article.deleted"
+	ArticlePublished = "article.published"
+)
+
+// Types semua tipe event yang bisa di subscribe
+var Types = []string{ArticleCreated, ArticleUpdated, ArticleDeleted, ArticlePublished}
+
+const AggregateArticle = "article"
+
+// Event domain event yang dikirim ke consumer. Delivery at-least-once,
+// consumer harus dedupe berdasarkan ID
+type Event struct {
+	ID            string          `json:"id"`
+	Type          string          `json:"type"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   uint            `json:"aggregate_id"`
+	TenantID      string          `json:"tenant_id"`
+	Payload       json.RawMessage `json:"payload"`
+	OccurredAt    time.Time       `json:"occurred_at"`
+}
+
+// Publisher tujuan pengiriman event dari outbox relay
+type Publisher interface {
+	Publish(ctx context.Context, event Event) error
+}
+
+const (
+	PublisherLog     = "log"
+	PublisherBus     = "bus"
+	PublisherWebhook = "webhook"
+	PublisherNone    = "none"
+)
+
+// NewPublisher pilih publisher dari OUTBOX_PUBLISHERS (dipisah koma), default log dan bus
+func NewPublisher(config *viper.Viper, log *zap.Logger, bus *Bus) (Publisher, error) {
+	names := config.GetString("OUTBOX_PUBLISHERS")
+	if names == "" {
+		names = PublisherLog + "," + PublisherBus
+	}
+
+	var publishers Multi
+	for _, name := range strings.Split(names, ",") {
+		switch strings.TrimSpace(name) {
+		case PublisherLog:
+			publishers = append(publishers, NewLogPublisher(log))
+		case PublisherBus:
+			publishers = append(publishers, bus)
+		case PublisherWebhook:
+			webhook, err := NewWebhookPublisher(config)
+			if err != nil {
+				return nil, err
+			}
+			publishers = append(publishers, webhook)
+		case PublisherNone, "":
+			continue
+		default:
+			return nil, fmt.Errorf("unsupported outbox publisher: %s", name)
+		}
+	}
+
+	return publishers, nil
+}
+
+// Includes true kalau target adalah p atau salah satu publisher di dalam Multi, misal untuk cek
+// apakah relay mengirim event ke bus
+func Includes(p, target Publisher) bool {
+	if multi, ok := p.(Multi); ok {
+		for _, publisher := range multi {
+			if Includes(publisher, target) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return p != nil && p == target
+}
+
+// Multi kirim event ke semua publisher, error dikumpulkan supaya satu publisher gagal tidak menghentikan yang lain
+type Multi []Publisher
+
+func (m Multi) Publish(ctx context.Context, event Event) error {
+	var errs []error
+	for _, p := range m {
+		if err := p.Publish(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// LogPublisher tulis event ke log, cocok untuk development
+type LogPublisher struct {
+	log *zap.Logger
+}
+
+func NewLogPublisher(log *zap.Logger) *LogPublisher {
+	return &LogPublisher{log: log}
+}
+
+func (p *LogPublisher) Publish(ctx context.Context, event Event) error {
+	p.log.Info("domain event",
+		zap.String("event_id", event.ID),
+		zap.String("event", event.Type),
+		zap.String("aggregate_type", event.AggregateType),
+		zap.Uint("aggregate_id", event.AggregateID),
+		zap.String("tenant_id", event.TenantID),
+		zap.Time("occurred_at", event.OccurredAt),
+	)
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package events
+
+import "testing"
+
+func TestIncludes(t *testing.T) {
+	bus := NewBus()
+	other := NewBus()
+	log := NewLogPublisher(nil)
+
+	tests := []struct {
+		name      string
+		publisher Publisher
+		want      bool
+	}{
+		{name: "same publisher", publisher: bus, want: true},
+		{name: "other bus", publisher: other},
+		{name: "inside multi", publisher: Multi{log, bus}, want: true},
+		{name: "inside nested multi", publisher: Multi{log, Multi{bus}}, want: true},
+		{name: "multi without bus", publisher: Multi{log, other}},
+		{name: "empty multi", publisher: Multi{}},
+		{name: "nil publisher", publisher: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Includes(tt.publisher, bus); got != tt.want {
+				t.Errorf("Includes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package events
+
+import (
+	"sync"
+)
+
+// Stream simpan event terakhir dari Bus dalam replay buffer berukuran tetap dan
+// membagikannya ke subscriber, dipakai untuk resume koneksi Server-Sent Events
+type Stream struct {
+	mu      sync.Mutex
+	size    int
+	buffer  []Event
+	clients map[chan Event]struct{}
+	closed  bool
+
+	unsubscribe func()
+	done        chan struct{}
+}
+
+// Buffer channel per client, client yang tertinggal lebih dari ini diputus supaya reconnect dan replay
+const streamClientBuffer = 64
+
+func NewStream(bus *Bus, size int) *Stream {
+	if size <= 0 {
+		size = 500
+	}
+
+	events, unsubscribe := bus.Subscribe(size)
+	s := &Stream{
+		size:        size,
+		clients:     make(map[chan Event]struct{}),
+		unsubscribe: unsubscribe,
+		done:        make(chan struct{}),
+	}
+
+	go s.run(events)
+
+	return s
+}
+
+func (s *Stream) run(events <-chan Event) {
+	defer close(s.done)
+
+	for event := range events {
+		s.broadcast(event)
+	}
+}
+
+func (s *Stream) broadcast(event Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.buffer) == s.size {
+		copy(s.buffer, s.buffer[1:])
+		s.buffer = s.buffer[:s.size-1]
+	}
+	s.buffer = append(s.buffer, event)
+
+	for ch := range s.clients {
+		select {
+		case ch <- event:
+		default:
+			// Client terlalu lambat, putus supaya tidak ada event yang hilang diam-diam
+			delete(s.clients, ch)
+			close(ch)
+		}
+	}
+}
+
+// Subscribe daftarkan client baru. Kalau lastEventID di isi, event setelah ID tersebut di
+// kembalikan sebagai backlog; found false berarti ID sudah keluar dari buffer (atau tidak dikenal)
+// sehingga client perlu memuat ulang data. Channel ditutup saat client di putus atau stream di Close
+func (s *Stream) Subscribe(lastEventID string) (backlog []Event, found bool, events <-chan Event, cancel func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ch := make(chan Event, streamClientBuffer)
+	if s.closed {
+		close(ch)
+		return nil, lastEventID == "", ch, func() {}
+	}
+	s.clients[ch] = struct{}{}
+
+	found = lastEventID == ""
+	if !found {
+		for i := len(s.buffer) - 1; i >= 0; i-- {
+			if s.buffer[i].ID == lastEventID {
+				backlog = append(backlog, s.buffer[i+1:]...)
+				found = true
+				break
+			}
+		}
+	}
+
+	return backlog, found, ch, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if _, ok := s.clients[ch]; ok {
+			delete(s.clients, ch)
+			close(ch)
+		}
+	}
+}
+
+// Close putus semua client, dipanggil sebelum server shutdown supaya koneksi SSE tidak menahan shutdown
+func (s *Stream) Close() {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return
+	}
+	s.closed = true
+
+	for ch := range s.clients {
+		delete(s.clients, ch)
+		close(ch)
+	}
+	s.mu.Unlock()
+
+	s.unsubscribe()
+	<-s.done
+}
@@ -0,0 +1,229 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// publishAndWait publish event ke bus lalu tunggu sampai stream memasukkannya ke buffer
+func publishAndWait(t *testing.T, bus *Bus, stream *Stream, ids ...string) {
+	t.Helper()
+
+	for _, id := range ids {
+		if err := bus.Publish(context.Background(), Event{ID: id}); err != nil {
+			t.Fatalf("Publish() error = %v", err)
+		}
+
+		deadline := time.Now().Add(time.Second)
+		for {
+			stream.mu.Lock()
+			last := ""
+			if len(stream.buffer) > 0 {
+				last = stream.buffer[len(stream.buffer)-1].ID
+			}
+			stream.mu.Unlock()
+
+			if last == id {
+				break
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("event %s was not buffered", id)
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+func eventIDs(events []Event) []string {
+	ids := make([]string, len(events))
+	for i, event := range events {
+		ids[i] = event.ID
+	}
+	return ids
+}
+
+// receive baca satu event, false kalau channel sudah ditutup
+func receive(t *testing.T, ch <-chan Event) (Event, bool) {
+	t.Helper()
+
+	select {
+	case event, ok := <-ch:
+		return event, ok
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for event")
+		return Event{}, false
+	}
+}
+
+func TestStreamReplay(t *testing.T) {
+	tests := []struct {
+		name        string
+		lastEventID string
+		wantFound   bool
+		wantBacklog []string
+	}{
+		{name: "new client", wantFound: true},
+		{name: "resume from the middle", lastEventID: "e3", wantFound: true, wantBacklog: []string{"e4", "e5"}},
+		{name: "already up to date", lastEventID: "e5", wantFound: true},
+		{name: "oldest buffered event", lastEventID: "e2", wantFound: true, wantBacklog: []string{"e3", "e4", "e5"}},
+		{name: "evicted from buffer", lastEventID: "e1"},
+		{name: "unknown id", lastEventID: "other"},
+	}
+
+	// Buffer 4 event, e1 sudah tergeser
+	bus := NewBus()
+	stream := NewStream(bus, 4)
+	defer stream.Close()
+	publishAndWait(t, bus, stream, "e1", "e2", "e3", "e4", "e5")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backlog, found, _, cancel := stream.Subscribe(tt.lastEventID)
+			defer cancel()
+
+			if found != tt.wantFound {
+				t.Errorf("found = %v, want %v", found, tt.wantFound)
+			}
+			if got := eventIDs(backlog); len(got) != len(tt.wantBacklog) || (len(got) > 0 && !reflect.DeepEqual(got, tt.wantBacklog)) {
+				t.Errorf("backlog = %v, want %v", got, tt.wantBacklog)
+			}
+		})
+	}
+}
+
+func TestStreamDeliversLiveEvents(t *testing.T) {
+	bus := NewBus()
+	stream := NewStream(bus, 10)
+	defer stream.Close()
+
+	_, _, first, cancelFirst := stream.Subscribe("")
+	defer cancelFirst()
+	_, _, second, cancelSecond := stream.Subscribe("")
+	defer cancelSecond()
+
+	publishAndWait(t, bus, stream, "e1", "e2")
+
+	for _, ch := range []<-chan Event{first, second} {
+		for _, want := range []string{"e1", "e2"} {
+			if event, ok := receive(t, ch); !ok || event.ID != want {
+				t.Fatalf("received %q (open %v), want %q", event.ID, ok, want)
+			}
+		}
+	}
+}
+
+func TestStreamDisconnectsSlowClient(t *testing.T) {
+	bus := NewBus()
+	// Buffer bus cukup besar supaya yang di uji hanya buffer per client
+	stream := NewStream(bus, streamClientBuffer*2)
+	defer stream.Close()
+
+	_, _, slow, cancelSlow := stream.Subscribe("")
+	defer cancelSlow()
+
+	_, _, fast, cancelFast := stream.Subscribe("")
+	defer cancelFast()
+	received := make(chan int)
+	go func() {
+		count := 0
+		for range fast {
+			count++
+			if count == streamClientBuffer+1 {
+				received <- count
+			}
+		}
+	}()
+
+	for i := 0; i <= streamClientBuffer; i++ {
+		publishAndWait(t, bus, stream, fmt.Sprintf("e%d", i))
+	}
+
+	select {
+	case <-received:
+	case <-time.After(time.Second):
+		t.Fatal("fast client did not receive every event")
+	}
+
+	// Client lambat tetap menerima isi buffernya lalu channel ditutup, bukan kehilangan event diam-diam
+	for i := 0; i < streamClientBuffer; i++ {
+		if event, ok := receive(t, slow); !ok || event.ID != fmt.Sprintf("e%d", i) {
+			t.Fatalf("slow client event %d = %q (open %v)", i, event.ID, ok)
+		}
+	}
+	if _, ok := receive(t, slow); ok {
+		t.Fatal("slow client channel must be closed after its buffer overflowed")
+	}
+
+	// Client yang reconnect dengan ID terakhir yang diterima mendapat sisa event dari replay buffer
+	backlog, found, _, cancel := stream.Subscribe(fmt.Sprintf("e%d", streamClientBuffer-1))
+	defer cancel()
+	if want := []string{fmt.Sprintf("e%d", streamClientBuffer)}; !found || !reflect.DeepEqual(eventIDs(backlog), want) {
+		t.Fatalf("backlog = %v (found %v), want %v", eventIDs(backlog), found, want)
+	}
+}
+
+func TestStreamCancel(t *testing.T) {
+	bus := NewBus()
+	stream := NewStream(bus, 10)
+	defer stream.Close()
+
+	_, _, ch, cancel := stream.Subscribe("")
+	cancel()
+	cancel()
+
+	if _, ok := receive(t, ch); ok {
+		t.Fatal("channel must be closed after cancel")
+	}
+
+	// Event berikutnya tidak dikirim ke client yang sudah cancel
+	publishAndWait(t, bus, stream, "e1")
+	stream.mu.Lock()
+	clients := len(stream.clients)
+	stream.mu.Unlock()
+	if clients != 0 {
+		t.Fatalf("clients = %d, want 0", clients)
+	}
+}
+
+func TestStreamClose(t *testing.T) {
+	bus := NewBus()
+	stream := NewStream(bus, 10)
+
+	_, _, ch, cancel := stream.Subscribe("")
+	publishAndWait(t, bus, stream, "e1")
+
+	stream.Close()
+	stream.Close()
+	cancel()
+
+	if event, ok := receive(t, ch); !ok || event.ID != "e1" {
+		t.Fatalf("received %q (open %v), want buffered e1", event.ID, ok)
+	}
+	if _, ok := receive(t, ch); ok {
+		t.Fatal("channel must be closed after Close")
+	}
+
+	// Subscribe setelah Close langsung mendapat channel tertutup
+	_, found, closed, cancelClosed := stream.Subscribe("")
+	defer cancelClosed()
+	if _, ok := receive(t, closed); ok || !found {
+		t.Fatalf("Subscribe() after Close found = %v, channel open = %v", found, ok)
+	}
+	if _, found, _, _ := stream.Subscribe("e1"); found {
+		t.Fatal("Subscribe() after Close with last event ID must not report found")
+	}
+
+	// Stream sudah unsubscribe dari bus
+	if err := bus.Publish(context.Background(), Event{ID: "e2"}); err != nil {
+		t.Fatalf("Publish() after Close error = %v", err)
+	}
+	bus.mu.RLock()
+	subscribers := len(bus.subscribers)
+	bus.mu.RUnlock()
+	if subscribers != 0 {
+		t.Fatalf("bus subscribers = %d, want 0", subscribers)
+	}
+}
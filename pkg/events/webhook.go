@@ -0,0 +1,157 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	HeaderEventID   = "X-Event-ID"
+	HeaderEventType = "X-Event-Type"
+	HeaderSignature = "X-Event-Signature"
+	HeaderTimestamp = "X-Event-Timestamp"
+
+	// SignatureTolerance selisih maksimal timestamp request dengan jam receiver. Request di luar
+	// window ini ditolak VerifySignature supaya request lama yang di rekam tidak bisa di kirim ulang
+	SignatureTolerance = 5 * time.Minute
+)
+
+var (
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrStaleTimestamp   = errors.New("webhook timestamp is outside the tolerance window")
+)
+
+// WebhookPublisher POST event sebagai JSON ke setiap URL di OUTBOX_WEBHOOK_URLS.
+// Response selain 2xx dianggap gagal sehingga event di retry oleh relay
+type WebhookPublisher struct {
+	urls   []string
+	secret string
+	client *http.Client
+}
+
+func NewWebhookPublisher(config *viper.Viper) (*WebhookPublisher, error) {
+	var urls []string
+	for _, url := range strings.Split(config.GetString("OUTBOX_WEBHOOK_URLS"), ",") {
+		if url = strings.TrimSpace(url); url != "" {
+			urls = append(urls, url)
+		}
+	}
+
+	if len(urls) == 0 {
+		return nil, errors.New("OUTBOX_WEBHOOK_URLS is required for webhook publisher")
+	}
+
+	timeout := config.GetDuration("OUTBOX_WEBHOOK_TIMEOUT")
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+
+	return &WebhookPublisher{
+		urls:   urls,
+		secret: config.GetString("OUTBOX_WEBHOOK_SECRET"),
+		client: &http.Client{Timeout: timeout},
+	}, nil
+}
+
+func (p *WebhookPublisher) Publish(ctx context.Context, event Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, url := range p.urls {
+		if err := p.send(ctx, url, event, body); err != nil {
+			errs = append(errs, fmt.Errorf("webhook %s: %w", url, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (p *WebhookPublisher) send(ctx context.Context, url string, event Event, body []byte) error {
+	req, err := NewSignedRequest(ctx, url, p.secret, event, body)
+	if err != nil {
+		return err
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// NewSignedRequest buat POST berisi body event. Timestamp (unix detik) selalu di kirim, signature
+// hanya di set kalau secret tidak kosong. Setiap retry membuat request baru dengan timestamp baru
+func NewSignedRequest(ctx context.Context, url, secret string, event Event, body []byte) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	timestamp := time.Now().Unix()
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEventID, event.ID)
+	req.Header.Set(HeaderEventType, event.Type)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	if secret != "" {
+		req.Header.Set(HeaderSignature, Sign(secret, timestamp, body))
+	}
+
+	return req, nil
+}
+
+// Sign HMAC-SHA256 dari "<timestamp>.<body>" dengan format "sha256=<hex>". Timestamp ikut di sign
+// sehingga receiver bisa menolak request lama tanpa signature-nya tetap valid
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature cek signature dan timestamp dari header request, cara yang sama dipakai receiver.
+// tolerance <= 0 berarti SignatureTolerance
+func VerifySignature(secret, signature, timestamp string, body []byte, now time.Time, tolerance time.Duration) error {
+	if tolerance <= 0 {
+		tolerance = SignatureTolerance
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(Sign(secret, unix, body))) {
+		return ErrInvalidSignature
+	}
+
+	if age := now.Sub(time.Unix(unix, 0)); age > tolerance || age < -tolerance {
+		return ErrStaleTimestamp
+	}
+
+	return nil
+}
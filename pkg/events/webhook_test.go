@@ -0,0 +1,164 @@
+package events
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSign(t *testing.T) {
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp int64
+		body      string
+	}{
+		{name: "json body", secret: "s3cret", timestamp: 1700000000, body: `{"id":"1","type":"article.created"}`},
+		{name: "empty body", secret: "s3cret", timestamp: 1700000000, body: ""},
+		{name: "unicode secret", secret: "rahasia-ü", timestamp: 1700000001, body: `{"title":"Halo"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mac := hmac.New(sha256.New, []byte(tt.secret))
+			mac.Write([]byte(fmt.Sprintf("%d.%s", tt.timestamp, tt.body)))
+			want := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+			if got := Sign(tt.secret, tt.timestamp, []byte(tt.body)); got != want {
+				t.Fatalf("Sign() = %q, want %q", got, want)
+			}
+		})
+	}
+
+	if Sign("a", 1, []byte("body")) == Sign("b", 1, []byte("body")) {
+		t.Fatal("Sign() must depend on the secret")
+	}
+	if Sign("a", 1, []byte("body")) == Sign("a", 2, []byte("body")) {
+		t.Fatal("Sign() must depend on the timestamp")
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	body := []byte(`{"id":"evt-1"}`)
+	signed := func(at time.Time) (string, string) {
+		return Sign("s3cret", at.Unix(), body), strconv.FormatInt(at.Unix(), 10)
+	}
+
+	tests := []struct {
+		name      string
+		age       time.Duration
+		signature string
+		timestamp string
+		body      []byte
+		wantErr   error
+	}{
+		{name: "valid", wantErr: nil},
+		{name: "tampered body", body: []byte(`{"id":"evt-2"}`), wantErr: ErrInvalidSignature},
+		{name: "timestamp swapped without resigning", timestamp: strconv.FormatInt(now.Unix()+1, 10), wantErr: ErrInvalidSignature},
+		{name: "wrong secret", signature: Sign("other-secret", now.Unix(), body), wantErr: ErrInvalidSignature},
+		{name: "timestamp not a number", timestamp: "yesterday", wantErr: ErrInvalidSignature},
+		{name: "replayed after tolerance", age: SignatureTolerance + time.Second, wantErr: ErrStaleTimestamp},
+		{name: "timestamp too far in the future", age: -SignatureTolerance - time.Second, wantErr: ErrStaleTimestamp},
+		{name: "within tolerance", age: SignatureTolerance - time.Second, wantErr: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signature, timestamp := signed(now.Add(-tt.age))
+			if tt.signature != "" {
+				signature = tt.signature
+			}
+			if tt.timestamp != "" {
+				timestamp = tt.timestamp
+			}
+			payload := body
+			if tt.body != nil {
+				payload = tt.body
+			}
+
+			err := VerifySignature("s3cret", signature, timestamp, payload, now, 0)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("VerifySignature() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewSignedRequest(t *testing.T) {
+	event := Event{ID: "evt-1", Type: ArticleCreated}
+	body := []byte(`{"id":"evt-1"}`)
+
+	tests := []struct {
+		name          string
+		secret        string
+		wantSignature string
+	}{
+		{name: "with secret", secret: "s3cret", wantSignature: "sha256="},
+		{name: "without secret", secret: "", wantSignature: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *http.Request
+			var gotBody []byte
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = r
+				gotBody, _ = io.ReadAll(r.Body)
+			}))
+			defer server.Close()
+
+			req, err := NewSignedRequest(context.Background(), server.URL, tt.secret, event, body)
+			if err != nil {
+				t.Fatalf("NewSignedRequest() error = %v", err)
+			}
+
+			resp, err := server.Client().Do(req)
+			if err != nil {
+				t.Fatalf("Do() error = %v", err)
+			}
+			resp.Body.Close()
+
+			if got.Method != http.MethodPost {
+				t.Errorf("method = %s, want POST", got.Method)
+			}
+			if string(gotBody) != string(body) {
+				t.Errorf("body = %s, want %s", gotBody, body)
+			}
+			if ct := got.Header.Get("Content-Type"); ct != "application/json" {
+				t.Errorf("Content-Type = %q, want application/json", ct)
+			}
+			if id := got.Header.Get(HeaderEventID); id != event.ID {
+				t.Errorf("%s = %q, want %q", HeaderEventID, id, event.ID)
+			}
+			if typ := got.Header.Get(HeaderEventType); typ != event.Type {
+				t.Errorf("%s = %q, want %q", HeaderEventType, typ, event.Type)
+			}
+
+			timestamp := got.Header.Get(HeaderTimestamp)
+			if _, err := strconv.ParseInt(timestamp, 10, 64); err != nil {
+				t.Errorf("%s = %q, want unix seconds", HeaderTimestamp, timestamp)
+			}
+
+			sig := got.Header.Get(HeaderSignature)
+			if tt.wantSignature == "" {
+				if sig != "" {
+					t.Errorf("%s = %q, want none without secret", HeaderSignature, sig)
+				}
+				return
+			}
+			if err := VerifySignature(tt.secret, sig, timestamp, gotBody, time.Now(), 0); err != nil {
+				t.Errorf("VerifySignature(%s) = %v", HeaderSignature, err)
+			}
+		})
+	}
+}
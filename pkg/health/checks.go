@@ -0,0 +1,44 @@
+package health
+
+import (
+	"context"
+	"fmt"
+
+	elasticsearch "github.com/elastic/go-elasticsearch/v8"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+// SQLCheck ping database lewat sql.DB milik gorm
+func SQLCheck(db *gorm.DB) CheckFunc {
+	return func(ctx context.Context) error {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return err
+		}
+
+		return sqlDB.PingContext(ctx)
+	}
+}
+
+func RedisCheck(client *redis.Client) CheckFunc {
+	return func(ctx context.Context) error {
+		return client.Ping(ctx).Err()
+	}
+}
+
+func ElasticsearchCheck(client *elasticsearch.Client) CheckFunc {
+	return func(ctx context.Context) error {
+		res, err := client.Ping(client.Ping.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+		defer res.Body.Close()
+
+		if res.IsError() {
+			return fmt.Errorf("elasticsearch returned %s", res.Status())
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,158 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Status hasil satu dependency atau keseluruhan
+const (
+	StatusOK           = "ok"
+	StatusFailed       = "failed"
+	StatusShuttingDown = "shutting_down"
+)
+
+// Timeout default per dependency kalau tidak di set
+const DefaultTimeout = 2 * time.Second
+
+// CheckFunc cek satu dependency, harus berhenti saat ctx selesai
+type CheckFunc func(ctx context.Context) error
+
+type check struct {
+	name    string
+	timeout time.Duration
+	fn      CheckFunc
+}
+
+// Result hasil cek satu dependency
+type Result struct {
+	Status    string `json:"status"`
+	LatencyMS int64  `json:"latency_ms"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Report hasil readiness dengan rincian per dependency
+type Report struct {
+	Status string            `json:"status"`
+	Checks map[string]Result `json:"checks"`
+}
+
+// Checker kumpulan cek dependency untuk readiness
+type Checker struct {
+	mu           sync.RWMutex
+	checks       []check
+	closers      []func() error
+	shuttingDown atomic.Bool
+}
+
+func NewChecker() *Checker {
+	return &Checker{}
+}
+
+// Register tambah dependency, timeout <= 0 memakai DefaultTimeout
+func (c *Checker) Register(name string, timeout time.Duration, fn CheckFunc) {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.checks = append(c.checks, check{name: name, timeout: timeout, fn: fn})
+}
+
+// OnClose daftarkan client yang dibuat khusus untuk health check supaya ditutup saat shutdown
+func (c *Checker) OnClose(fn func() error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.closers = append(c.closers, fn)
+}
+
+// MarkShuttingDown buat readiness gagal supaya load balancer berhenti mengirim traffic
+func (c *Checker) MarkShuttingDown() {
+	c.shuttingDown.Store(true)
+}
+
+func (c *Checker) ShuttingDown() bool {
+	return c.shuttingDown.Load()
+}
+
+// Ready jalankan semua cek secara paralel, masing-masing dengan timeout sendiri
+func (c *Checker) Ready(ctx context.Context) Report {
+	c.mu.RLock()
+	checks := append([]check(nil), c.checks...)
+	c.mu.RUnlock()
+
+	report := Report{Status: StatusOK, Checks: make(map[string]Result, len(checks))}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, chk := range checks {
+		wg.Add(1)
+		go func(chk check) {
+			defer wg.Done()
+
+			result := run(ctx, chk)
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Checks[chk.name] = result
+			if result.Status != StatusOK {
+				report.Status = StatusFailed
+			}
+		}(chk)
+	}
+	wg.Wait()
+
+	// Dependency tetap di cek supaya rinciannya terlihat, tapi status tetap gagal selama shutdown
+	if c.ShuttingDown() {
+		report.Status = StatusShuttingDown
+	}
+
+	return report
+}
+
+func run(ctx context.Context, chk check) Result {
+	ctx, cancel := context.WithTimeout(ctx, chk.timeout)
+	defer cancel()
+
+	start := time.Now()
+	errCh := make(chan error, 1)
+	go func() { errCh <- chk.fn(ctx) }()
+
+	// Client yang tidak menghormati ctx tetap dibatasi timeout
+	var err error
+	select {
+	case err = <-errCh:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	result := Result{Status: StatusOK, LatencyMS: time.Since(start).Milliseconds()}
+	if err != nil {
+		result.Status = StatusFailed
+		result.Error = err.Error()
+	}
+
+	return result
+}
+
+// Close tutup client milik health check
+func (c *Checker) Close() error {
+	c.mu.Lock()
+	closers := c.closers
+	c.closers = nil
+	c.mu.Unlock()
+
+	var firstErr error
+	for _, closer := range closers {
+		if err := closer(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
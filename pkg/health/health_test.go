@@ -0,0 +1,123 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestReady(t *testing.T) {
+	ok := func(ctx context.Context) error { return nil }
+	failing := func(ctx context.Context) error { return errors.New("connection refused") }
+	// blocking tidak menghormati ctx, harus tetap dibatasi timeout
+	blocking := func(ctx context.Context) error { time.Sleep(time.Second); return nil }
+
+	type registration struct {
+		name    string
+		timeout time.Duration
+		fn      CheckFunc
+	}
+
+	tests := []struct {
+		name         string
+		checks       []registration
+		shuttingDown bool
+		wantStatus   string
+		wantChecks   map[string]string
+		wantErrors   map[string]string
+	}{
+		{name: "no dependency", wantStatus: StatusOK, wantChecks: map[string]string{}},
+		{
+			name:       "all dependency ok",
+			checks:     []registration{{name: "mysql", fn: ok}, {name: "redis", fn: ok}},
+			wantStatus: StatusOK,
+			wantChecks: map[string]string{"mysql": StatusOK, "redis": StatusOK},
+		},
+		{
+			name:       "one dependency failed",
+			checks:     []registration{{name: "mysql", fn: ok}, {name: "redis", fn: failing}},
+			wantStatus: StatusFailed,
+			wantChecks: map[string]string{"mysql": StatusOK, "redis": StatusFailed},
+			wantErrors: map[string]string{"redis": "connection refused"},
+		},
+		{
+			name:       "dependency timed out",
+			checks:     []registration{{name: "mysql", fn: ok}, {name: "elasticsearch", timeout: 20 * time.Millisecond, fn: blocking}},
+			wantStatus: StatusFailed,
+			wantChecks: map[string]string{"mysql": StatusOK, "elasticsearch": StatusFailed},
+			wantErrors: map[string]string{"elasticsearch": context.DeadlineExceeded.Error()},
+		},
+		{
+			name:         "shutting down still reports dependency",
+			checks:       []registration{{name: "mysql", fn: ok}},
+			shuttingDown: true,
+			wantStatus:   StatusShuttingDown,
+			wantChecks:   map[string]string{"mysql": StatusOK},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := NewChecker()
+			for _, chk := range tt.checks {
+				checker.Register(chk.name, chk.timeout, chk.fn)
+			}
+			if tt.shuttingDown {
+				checker.MarkShuttingDown()
+			}
+
+			start := time.Now()
+			report := checker.Ready(context.Background())
+			if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+				t.Fatalf("Ready() took %v, want bounded by check timeout", elapsed)
+			}
+
+			if report.Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s", report.Status, tt.wantStatus)
+			}
+			if len(report.Checks) != len(tt.wantChecks) {
+				t.Fatalf("checks = %+v, want %v", report.Checks, tt.wantChecks)
+			}
+			for name, want := range tt.wantChecks {
+				if got := report.Checks[name]; got.Status != want || got.Error != tt.wantErrors[name] {
+					t.Errorf("checks[%s] = %+v, want status %s error %q", name, got, want, tt.wantErrors[name])
+				}
+			}
+		})
+	}
+}
+
+func TestRegisterDefaultTimeout(t *testing.T) {
+	checker := NewChecker()
+	checker.Register("mysql", 0, func(ctx context.Context) error { return nil })
+	checker.Register("redis", time.Second, func(ctx context.Context) error { return nil })
+
+	if got := checker.checks[0].timeout; got != DefaultTimeout {
+		t.Errorf("timeout = %v, want DefaultTimeout", got)
+	}
+	if got := checker.checks[1].timeout; got != time.Second {
+		t.Errorf("timeout = %v, want 1s", got)
+	}
+}
+
+func TestClose(t *testing.T) {
+	checker := NewChecker()
+
+	var closed []string
+	first := errors.New("redis close failed")
+	checker.OnClose(func() error { closed = append(closed, "redis"); return first })
+	checker.OnClose(func() error { closed = append(closed, "elasticsearch"); return errors.New("second") })
+
+	// Semua client tetap ditutup walaupun ada yang gagal, error pertama yang dikembalikan
+	if err := checker.Close(); !errors.Is(err, first) {
+		t.Fatalf("Close() error = %v, want %v", err, first)
+	}
+	if len(closed) != 2 {
+		t.Fatalf("closed = %v, want all clients", closed)
+	}
+
+	if err := checker.Close(); err != nil || len(closed) != 2 {
+		t.Fatalf("second Close() = %v, closed = %v, want no-op", err, closed)
+	}
+}
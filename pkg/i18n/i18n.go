@@ -0,0 +1,195 @@
+package i18n
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"path"
+	"strings"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/locale"
+)
+
+const (
+	English    = "en-US"
+	Indonesian = "id-ID"
+
+	// Fallback dipakai kalau Accept-Language tidak didukung atau pesan belum ada di catalog bahasa tersebut
+	Fallback = English
+)
+
+//go:embed locales/*.json
+var localeFiles embed.FS
+
+// GenericMessage key pesan umum satu error code, dipakai kalau error tidak punya id pesan
+// atau id tersebut belum diterjemahkan
+const GenericMessage = "*"
+
+// Catalog pesan satu bahasa. Errors di key dengan ErrorCode (nilai error_code tidak pernah berubah)
+// lalu dengan id pesan (apperror.Error.ID / Problem.MessageID), Fields di key dengan code validasi
+// per field, Units satuan yang dipakai template Fields, Messages di key dengan id pesan sukses.
+// Catalog Fallback tidak perlu Errors, Fields dan Units karena pesan asalnya sudah English
+type Catalog struct {
+	Errors   map[string]map[string]string `json:"errors"`
+	Fields   map[string]string            `json:"fields"`
+	Units    map[string]string            `json:"units"`
+	Messages map[string]string            `json:"messages"`
+}
+
+var catalogs = mustLoad()
+
+func mustLoad() map[string]*Catalog {
+	entries, err := localeFiles.ReadDir("locales")
+	if err != nil {
+		panic("i18n: " + err.Error())
+	}
+
+	loaded := make(map[string]*Catalog, len(entries))
+	for _, entry := range entries {
+		data, err := localeFiles.ReadFile(path.Join("locales", entry.Name()))
+		if err != nil {
+			panic("i18n: " + err.Error())
+		}
+
+		var catalog Catalog
+		if err := json.Unmarshal(data, &catalog); err != nil {
+			panic("i18n: invalid catalog " + entry.Name() + ": " + err.Error())
+		}
+
+		loaded[strings.TrimSuffix(entry.Name(), ".json")] = &catalog
+	}
+
+	if loaded[Fallback] == nil {
+		panic("i18n: missing fallback catalog " + Fallback)
+	}
+
+	return loaded
+}
+
+// Negotiate pilih bahasa dari header Accept-Language, "id" dan "id-ID" sama-sama menjadi id-ID
+func Negotiate(acceptLanguage string) string {
+	primary := make(map[string]string, len(catalogs))
+	supported := make([]string, 0, len(catalogs))
+	for tag := range catalogs {
+		subtag := locale.Normalize(tag)
+		primary[subtag] = tag
+		supported = append(supported, subtag)
+	}
+
+	return primary[locale.Negotiate("", acceptLanguage, supported, locale.Normalize(Fallback))]
+}
+
+type contextKey struct{}
+
+// ContextKey key bahasa request di context. Middleware menyimpannya lewat fiber Locals
+// sehingga ikut terbaca dari ctx.Context()
+var ContextKey = contextKey{}
+
+func WithLanguage(ctx context.Context, language string) context.Context {
+	return context.WithValue(ctx, ContextKey, language)
+}
+
+// FromContext bahasa hasil negosiasi, di luar request selalu Fallback
+func FromContext(ctx context.Context) string {
+	if ctx != nil {
+		if language, ok := ctx.Value(ContextKey).(string); ok && catalogs[language] != nil {
+			return language
+		}
+	}
+
+	return Fallback
+}
+
+// Message pesan sukses untuk bahasa request, id pesan dikembalikan apa adanya kalau tidak ada di catalog manapun
+func Message(ctx context.Context, id string) string {
+	if message, ok := lookup(FromContext(ctx), id, func(c *Catalog) map[string]string { return c.Messages }); ok {
+		return message
+	}
+
+	return id
+}
+
+// Error terjemahan pesan error. Urutannya pesan dengan id tersebut, lalu pesan umum code di bahasa
+// tersebut. Tidak ada fallback ke catalog lain karena pesan asal sudah English, ok false berarti
+// pemanggil tetap memakai pesan asal
+func Error(language, code, id string) (string, bool) {
+	catalog := catalogs[language]
+	if catalog == nil {
+		return "", false
+	}
+
+	messages := catalog.Errors[code]
+	if id != "" {
+		if translated, ok := messages[id]; ok && translated != "" {
+			return translated, true
+		}
+	}
+	if generic, ok := messages[GenericMessage]; ok && generic != "" {
+		return generic, true
+	}
+
+	return "", false
+}
+
+// Field pesan error validasi satu field. Placeholder {name} di isi dari params, param unit
+// ikut diterjemahkan. ok false kalau template tidak ada atau butuh param yang tidak tersedia
+func Field(language, code string, params map[string]string) (string, bool) {
+	template, ok := lookup(language, code, func(c *Catalog) map[string]string { return c.Fields })
+	if !ok {
+		return "", false
+	}
+
+	if unit, ok := params["unit"]; ok {
+		if translated, ok := lookup(language, unit, func(c *Catalog) map[string]string { return c.Units }); ok {
+			localized := make(map[string]string, len(params))
+			for k, v := range params {
+				localized[k] = v
+			}
+			localized["unit"] = translated
+			params = localized
+		}
+	}
+
+	return render(template, params)
+}
+
+func lookup(language, key string, section func(*Catalog) map[string]string) (string, bool) {
+	for _, tag := range []string{language, Fallback} {
+		catalog := catalogs[tag]
+		if catalog == nil {
+			continue
+		}
+
+		if message, ok := section(catalog)[key]; ok && message != "" {
+			return message, true
+		}
+	}
+
+	return "", false
+}
+
+func render(template string, params map[string]string) (string, bool) {
+	var b strings.Builder
+	for {
+		start := strings.IndexByte(template, '{')
+		if start < 0 {
+			b.WriteString(template)
+			return b.String(), true
+		}
+
+		end := strings.IndexByte(template[start:], '}')
+		if end < 0 {
+			b.WriteString(template)
+			return b.String(), true
+		}
+
+		value, ok := params[template[start+1:start+end]]
+		if !ok {
+			return "", false
+		}
+
+		b.WriteString(template[:start])
+		b.WriteString(value)
+		template = template[start+end+1:]
+	}
+}
@@ -0,0 +1,154 @@
+package i18n
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		want           string
+	}{
+		{name: "empty header uses fallback", want: English},
+		{name: "primary subtag", acceptLanguage: "id", want: Indonesian},
+		{name: "full tag", acceptLanguage: "id-ID", want: Indonesian},
+		{name: "other region of a supported language", acceptLanguage: "en-GB", want: English},
+		{name: "highest quality first", acceptLanguage: "en;q=0.4, id;q=0.8", want: Indonesian},
+		{name: "unsupported languages are skipped", acceptLanguage: "fr, de;q=0.9, id;q=0.1", want: Indonesian},
+		{name: "unsupported only uses fallback", acceptLanguage: "fr, de", want: English},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Negotiate(tt.acceptLanguage); got != tt.want {
+				t.Errorf("Negotiate(%q) = %q, want %q", tt.acceptLanguage, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestError(t *testing.T) {
+	tests := []struct {
+		name     string
+		language string
+		code     string
+		id       string
+		want     string
+		wantOK   bool
+	}{
+		{name: "message id", language: Indonesian, code: "NOT_FOUND", id: "article", want: "artikel tidak ditemukan", wantOK: true},
+		{name: "same id under another code", language: Indonesian, code: "CREATE_FAILED", id: "article", want: "gagal membuat artikel", wantOK: true},
+		{name: "code without id uses generic message", language: Indonesian, code: "NOT_FOUND", want: "Data tidak ditemukan", wantOK: true},
+		{name: "unknown id uses generic message", language: Indonesian, code: "NOT_FOUND", id: "unknown", want: "Data tidak ditemukan", wantOK: true},
+		{name: "single message code", language: Indonesian, code: "TITLE_REQUIRED", want: "judul wajib diisi", wantOK: true},
+		{name: "unknown code keeps original", language: Indonesian, code: "SOMETHING_NEW", id: "article"},
+		{name: "fallback language keeps original", language: English, code: "NOT_FOUND", id: "article"},
+		{name: "unsupported language keeps original", language: "fr-FR", code: "NOT_FOUND", id: "article"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Error(tt.language, tt.code, tt.id)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("Error(%q, %q, %q) = %q, %v, want %q, %v", tt.language, tt.code, tt.id, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestField(t *testing.T) {
+	tests := []struct {
+		name     string
+		language string
+		code     string
+		params   map[string]string
+		want     string
+		wantOK   bool
+	}{
+		{
+			name:     "placeholders are filled",
+			language: Indonesian,
+			code:     "REQUIRED",
+			params:   map[string]string{"field": "title"},
+			want:     "title wajib diisi",
+			wantOK:   true,
+		},
+		{
+			name:     "unit is translated",
+			language: Indonesian,
+			code:     "TOO_LONG",
+			params:   map[string]string{"field": "title", "param": "200", "unit": "characters"},
+			want:     "title maksimal 200 karakter",
+			wantOK:   true,
+		},
+		{
+			name:     "unknown unit is kept",
+			language: Indonesian,
+			code:     "TOO_SHORT",
+			params:   map[string]string{"field": "tags", "param": "1", "unit": "pieces"},
+			want:     "tags minimal 1 pieces",
+			wantOK:   true,
+		},
+		{
+			name:     "missing param keeps original",
+			language: Indonesian,
+			code:     "TOO_LONG",
+			params:   map[string]string{"field": "title"},
+		},
+		{
+			name:     "domain code has no template",
+			language: Indonesian,
+			code:     "TITLE_REQUIRED",
+			params:   map[string]string{"field": "title"},
+		},
+		{
+			name:     "fallback language keeps original",
+			language: English,
+			code:     "REQUIRED",
+			params:   map[string]string{"field": "title"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Field(tt.language, tt.code, tt.params)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("Field(%q, %q, %v) = %q, %v, want %q, %v", tt.language, tt.code, tt.params, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestFieldDoesNotChangeParams(t *testing.T) {
+	params := map[string]string{"field": "title", "param": "200", "unit": "characters"}
+	if _, ok := Field(Indonesian, "TOO_LONG", params); !ok {
+		t.Fatal("Field() returned no message")
+	}
+	if params["unit"] != "characters" {
+		t.Fatalf("params unit changed to %q", params["unit"])
+	}
+}
+
+func TestMessage(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		id   string
+		want string
+	}{
+		{name: "request language", ctx: WithLanguage(context.Background(), Indonesian), id: "article.created", want: "Artikel berhasil dibuat"},
+		{name: "no language uses fallback", ctx: context.Background(), id: "article.created", want: "Article created successfully"},
+		{name: "unsupported language uses fallback", ctx: WithLanguage(context.Background(), "fr-FR"), id: "article.created", want: "Article created successfully"},
+		{name: "unknown id is returned as is", ctx: WithLanguage(context.Background(), Indonesian), id: "article.unknown", want: "article.unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Message(tt.ctx, tt.id); got != tt.want {
+				t.Errorf("Message(%q) = %q, want %q", tt.id, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package logger
+
+import (
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// ConfigFromViper baca konfigurasi logger dari LOG_*, sampling default aktif di production
+func ConfigFromViper(config *viper.Viper) Config {
+	environment := config.GetString("APP_ENV")
+	if environment == "" {
+		environment = "development"
+	}
+
+	compress := !config.IsSet("LOG_FILE_COMPRESS") || config.GetBool("LOG_FILE_COMPRESS")
+
+	sampling := environment == "production"
+	if config.IsSet("LOG_SAMPLING_ENABLED") {
+		sampling = config.GetBool("LOG_SAMPLING_ENABLED")
+	}
+
+	var redactKeys []string
+	for _, key := range strings.Split(config.GetString("LOG_REDACT_KEYS"), ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			redactKeys = append(redactKeys, key)
+		}
+	}
+
+	return Config{
+		Environtment: environment,
+		LogLevel:     config.GetString("LOG_LEVEL"),
+		OutputPath:   config.GetString("LOG_OUTPUT"),
+		Format:       config.GetString("LOG_FORMAT"),
+		File: FileConfig{
+			Path:           config.GetString("LOG_FILE_PATH"),
+			MaxSizeMB:      config.GetInt("LOG_FILE_MAX_SIZE_MB"),
+			MaxBackups:     config.GetInt("LOG_FILE_MAX_BACKUPS"),
+			MaxAgeDays:     config.GetInt("LOG_FILE_MAX_AGE_DAYS"),
+			Compress:       compress,
+			RotateInterval: config.GetDuration("LOG_FILE_ROTATE_INTERVAL"),
+		},
+		Sampling: SamplingConfig{
+			Enabled:    sampling,
+			Tick:       config.GetDuration("LOG_SAMPLING_TICK"),
+			Initial:    config.GetInt("LOG_SAMPLING_INITIAL"),
+			Thereafter: config.GetInt("LOG_SAMPLING_THEREAFTER"),
+		},
+		RedactKeys: redactKeys,
+	}
+}
@@ -0,0 +1,29 @@
+package logger
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+type contextKey struct{}
+
+// ContextKey key logger request di context. Middleware menyimpannya lewat fiber Locals
+// sehingga ikut terbaca dari ctx.Context() yang diteruskan handler ke usecase dan repository
+var ContextKey = contextKey{}
+
+func WithLogger(ctx context.Context, log *zap.Logger) context.Context {
+	return context.WithValue(ctx, ContextKey, log)
+}
+
+// FromContext ambil logger request (sudah membawa request_id, tenant, user dan trace id).
+// Di luar request, misal worker background, dipakai logger global dari zap.L()
+func FromContext(ctx context.Context) *zap.Logger {
+	if ctx != nil {
+		if log, ok := ctx.Value(ContextKey).(*zap.Logger); ok && log != nil {
+			return log
+		}
+	}
+
+	return zap.L()
+}
@@ -0,0 +1,36 @@
+package logger
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+type otherKey struct{}
+
+func TestFromContext(t *testing.T) {
+	requestLog := zap.NewExample()
+	var nilLog *zap.Logger
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want *zap.Logger
+	}{
+		{name: "request logger", ctx: WithLogger(context.Background(), requestLog), want: requestLog},
+		{name: "child context keeps logger", ctx: context.WithValue(WithLogger(context.Background(), requestLog), otherKey{}, "value"), want: requestLog},
+		// Di luar request (worker background) dipakai logger global
+		{name: "without logger", ctx: context.Background(), want: zap.L()},
+		{name: "nil logger", ctx: WithLogger(context.Background(), nilLog), want: zap.L()},
+		{name: "nil context", want: zap.L()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromContext(tt.ctx); got != tt.want {
+				t.Errorf("FromContext() = %p, want %p", got, tt.want)
+			}
+		})
+	}
+}
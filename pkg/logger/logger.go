@@ -1,7 +1,9 @@
 package logger
 
 import (
+	"errors"
 	"os"
+	"strings"
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -9,70 +11,155 @@ import (
 
 type Logger struct {
 	*zap.Logger
+
+	// Level bisa di ubah saat runtime, dipakai endpoint admin log level
+	Level zap.AtomicLevel
+
+	closers []func() error
 }
 
 type Config struct {
 	Environtment string // "development" or "production"
 	LogLevel     string // "debug", "info", "warn", "error"
-	OutputPath   string // "stdout" or file path
+	OutputPath   string // "stdout", "stderr", "none" or file path
+	Format       string // "json" or "console", default mengikuti environment
+
+	File       FileConfig     // sink file tambahan dengan rotasi, aktif kalau Path di isi
+	Sampling   SamplingConfig // sampling log Debug/Info yang volumenya tinggi
+	RedactKeys []string       // key tambahan yang di sensor selain DefaultRedactKeys
 }
 
 // ===== Init Function =====
 func NewLogger(config Config) (*Logger, error) {
-	var zapConfig zap.Config
+	production := config.Environtment == "production"
+
+	format := config.Format
+	if format == "" {
+		format = "console"
+		if production {
+			format = "json"
+		}
+	}
+
+	// OutputPath berupa file (format lama) diperlakukan sebagai sink file dengan rotasi
+	output := config.OutputPath
+	switch output {
+	case "", "stdout", "stderr", "none":
+	default:
+		if config.File.Path == "" {
+			config.File.Path = output
+		}
+		output = "none"
+	}
+
+	level := zap.NewAtomicLevelAt(ParseLevel(config.LogLevel))
+	redactKeys := append(append([]string(nil), DefaultRedactKeys...), config.RedactKeys...)
+
+	var closers []func() error
+	var lowCores, highCores []zapcore.Core
+	addSink := func(encoder zapcore.Encoder, sink zapcore.WriteSyncer) {
+		lowCores = append(lowCores, newRedactCore(zapcore.NewCore(encoder, sink, lowLevel(level)), redactKeys))
+		highCores = append(highCores, newRedactCore(zapcore.NewCore(encoder, sink, highLevel(level)), redactKeys))
+	}
+
+	switch output {
+	case "", "stdout":
+		addSink(newEncoder(format, production), zapcore.Lock(os.Stdout))
+	case "stderr":
+		addSink(newEncoder(format, production), zapcore.Lock(os.Stderr))
+	}
+
+	// File selalu JSON supaya mudah di kirim ke log shipper
+	if config.File.Path != "" {
+		sink, err := newFileSink(config.File)
+		if err != nil {
+			return nil, err
+		}
+		addSink(newEncoder("json", production), zapcore.AddSync(sink))
+		closers = append(closers, sink.Close)
+	}
+
+	// Hanya Debug/Info yang di sampling, Warn ke atas selalu ditulis
+	lowCore := zapcore.NewTee(lowCores...)
+	if config.Sampling.Enabled {
+		lowCore = newSampler(lowCore, config.Sampling)
+	}
+
+	options := []zap.Option{
+		zap.AddCaller(),
+		zap.AddCallerSkip(1),
+		zap.AddStacktrace(zapcore.ErrorLevel),
+		zap.ErrorOutput(zapcore.Lock(os.Stderr)),
+	}
+	if !production {
+		options = append(options, zap.Development())
+	}
+
+	log := zap.New(zapcore.NewTee(lowCore, zapcore.NewTee(highCores...)), options...)
 
-	// Set base config based on environment
-	if config.Environtment == "production" {
-		zapConfig = zap.NewProductionConfig()
-		zapConfig.Encoding = "json"
-	} else {
-		zapConfig = zap.NewDevelopmentConfig()
-		zapConfig.Encoding = "console"
-		zapConfig.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	return &Logger{Logger: log, Level: level, closers: closers}, nil
+}
+
+// ParseLevel ubah nama level ke zapcore.Level, nama yang tidak dikenal dianggap info
+func ParseLevel(name string) zapcore.Level {
+	level, ok := LookupLevel(name)
+	if !ok {
+		return zapcore.InfoLevel
 	}
 
-	// Set Log Level
-	level := zapcore.InfoLevel
-	switch config.LogLevel {
+	return level
+}
+
+// LookupLevel sama seperti ParseLevel tapi memberi tahu apakah nama level valid
+func LookupLevel(name string) (zapcore.Level, bool) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
 	case "debug":
-		level = zapcore.DebugLevel
+		return zapcore.DebugLevel, true
 	case "info":
-		level = zapcore.InfoLevel
+		return zapcore.InfoLevel, true
 	case "warn":
-		level = zapcore.WarnLevel
+		return zapcore.WarnLevel, true
 	case "error":
-		level = zapcore.ErrorLevel
+		return zapcore.ErrorLevel, true
 	}
 
-	zapConfig.Level = zap.NewAtomicLevelAt(level)
-
-	// Configure time format
-	zapConfig.EncoderConfig.TimeKey = "timestamp"
-	zapConfig.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
-	zapConfig.EncoderConfig.CallerKey = "caller"
-	zapConfig.EncoderConfig.EncodeCaller = zapcore.ShortCallerEncoder
+	return zapcore.InfoLevel, false
+}
 
-	// Set Output Path
-	if config.OutputPath == "" || config.OutputPath == "stdout" {
-		zapConfig.OutputPaths = []string{"stdout"}
-	} else {
-		zapConfig.OutputPaths = []string{config.OutputPath}
+func newEncoder(format string, production bool) zapcore.Encoder {
+	encoderConfig := zap.NewDevelopmentEncoderConfig()
+	if production {
+		encoderConfig = zap.NewProductionEncoderConfig()
 	}
 
-	zapConfig.ErrorOutputPaths = []string{"stderr"}
+	// Configure time format
+	encoderConfig.TimeKey = "timestamp"
+	encoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+	encoderConfig.CallerKey = "caller"
+	encoderConfig.EncodeCaller = zapcore.ShortCallerEncoder
+
+	if format == "json" {
+		encoderConfig.EncodeLevel = zapcore.LowercaseLevelEncoder
+		return zapcore.NewJSONEncoder(encoderConfig)
+	}
 
-	// Build Logger
-	log, err := zapConfig.Build(
-		zap.AddCaller(),
-		zap.AddCallerSkip(1),
-		zap.AddStacktrace(zapcore.ErrorLevel),
-	)
+	if !production {
+		encoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	}
+	return zapcore.NewConsoleEncoder(encoderConfig)
+}
 
-	if err != nil {
-		return nil, err
+// lowLevel dan highLevel membagi Debug/Info dan Warn ke atas, keduanya mengikuti level runtime
+func lowLevel(level zap.AtomicLevel) zap.LevelEnablerFunc {
+	return func(l zapcore.Level) bool {
+		return l < zapcore.WarnLevel && level.Enabled(l)
 	}
+}
 
-	return &Logger{Logger: log}, nil
+func highLevel(level zap.AtomicLevel) zap.LevelEnablerFunc {
+	return func(l zapcore.Level) bool {
+		return l >= zapcore.WarnLevel && level.Enabled(l)
+	}
 }
 
 // ===== Helper Functions =====
@@ -102,7 +189,7 @@ func (l *Logger) Panic(msg string, fields ...zap.Field) {
 
 // ===== WithFields returns a logger with pre-set fields =====
 func (l *Logger) WithFields(fields ...zap.Field) *Logger {
-	return &Logger{Logger: l.Logger.With(fields...)}
+	return &Logger{Logger: l.Logger.With(fields...), Level: l.Level}
 }
 
 // ===== Sync flushes any buffered log entries =====
@@ -110,6 +197,19 @@ func (l *Logger) Sync() error {
 	return l.Logger.Sync()
 }
 
+// ===== Close flushes log lalu menutup file sink =====
+func (l *Logger) Close() error {
+	// Sync ke stdout bisa gagal di beberapa OS (invalid argument), tidak perlu di laporkan
+	_ = l.Logger.Sync()
+
+	var errs []error
+	for _, closer := range l.closers {
+		errs = append(errs, closer())
+	}
+
+	return errors.Join(errs...)
+}
+
 // NewDefaultLogger creates a logger with default settings
 func NewDefaultLogger() (*Logger, error) {
 	env := os.Getenv("APP_ENV")
@@ -0,0 +1,126 @@
+package logger
+
+import (
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Redacted pengganti value field sensitif
+const Redacted = "[REDACTED]"
+
+// DefaultRedactKeys key field yang selalu di sensor. Dicocokkan sebagai bagian dari key setelah
+// huruf kecil dan tanpa "-", "_", "." sehingga "mysql_password", "X-Api-Key" dan "token_id" ikut tersensor
+var DefaultRedactKeys = []string{"password", "passwd", "secret", "token", "authorization", "cookie", "apikey"}
+
+// redactCore sensor field sensitif sebelum ditulis, termasuk header di dalam map. Hanya key field dan
+// key map satu level (http.Header, map[string]string) yang di cek, isi struct, zap.Object dan map
+// bersarang tidak di sensor sehingga data sensitif jangan di log sebagai object
+type redactCore struct {
+	zapcore.Core
+	keys []string
+}
+
+func newRedactCore(core zapcore.Core, keys []string) zapcore.Core {
+	normalized := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if key = normalizeKey(key); key != "" {
+			normalized = append(normalized, key)
+		}
+	}
+
+	return &redactCore{Core: core, keys: normalized}
+}
+
+func (c *redactCore) With(fields []zapcore.Field) zapcore.Core {
+	return &redactCore{Core: c.Core.With(c.redact(fields)), keys: c.keys}
+}
+
+func (c *redactCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+
+	return checked
+}
+
+func (c *redactCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	return c.Core.Write(entry, c.redact(fields))
+}
+
+// redact hanya menyalin slice kalau ada field yang berubah
+func (c *redactCore) redact(fields []zapcore.Field) []zapcore.Field {
+	var out []zapcore.Field
+	for i, field := range fields {
+		replaced, ok := c.redactField(field)
+		if !ok {
+			continue
+		}
+
+		if out == nil {
+			out = append([]zapcore.Field(nil), fields...)
+		}
+		out[i] = replaced
+	}
+
+	if out == nil {
+		return fields
+	}
+
+	return out
+}
+
+func (c *redactCore) redactField(field zapcore.Field) (zapcore.Field, bool) {
+	if c.sensitive(field.Key) {
+		return zap.String(field.Key, Redacted), true
+	}
+
+	switch value := field.Interface.(type) {
+	case http.Header:
+		return zap.Any(field.Key, c.redactHeader(value)), true
+	case map[string][]string:
+		return zap.Any(field.Key, c.redactHeader(value)), true
+	case map[string]string:
+		redacted := make(map[string]string, len(value))
+		for key, v := range value {
+			if c.sensitive(key) {
+				v = Redacted
+			}
+			redacted[key] = v
+		}
+		return zap.Any(field.Key, redacted), true
+	}
+
+	return field, false
+}
+
+func (c *redactCore) redactHeader(header map[string][]string) map[string][]string {
+	redacted := make(map[string][]string, len(header))
+	for key, values := range header {
+		if c.sensitive(key) {
+			values = []string{Redacted}
+		}
+		redacted[key] = values
+	}
+
+	return redacted
+}
+
+func (c *redactCore) sensitive(key string) bool {
+	key = normalizeKey(key)
+	for _, sensitive := range c.keys {
+		if strings.Contains(key, sensitive) {
+			return true
+		}
+	}
+
+	return false
+}
+
+var keySeparators = strings.NewReplacer("-", "", "_", "", ".", "")
+
+func normalizeKey(key string) string {
+	return keySeparators.Replace(strings.ToLower(strings.TrimSpace(key)))
+}
@@ -0,0 +1,150 @@
+package logger
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func newObservedLogger(keys ...string) (*zap.Logger, *observer.ObservedLogs) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	return zap.New(newRedactCore(core, append(append([]string(nil), DefaultRedactKeys...), keys...))), logs
+}
+
+func TestRedactKeys(t *testing.T) {
+	tests := []struct {
+		key       string
+		extraKeys []string
+		want      bool
+	}{
+		{key: "password", want: true},
+		{key: "mysql_password", want: true},
+		{key: "DB.Password", want: true},
+		{key: "X-Api-Key", want: true},
+		{key: "api_key", want: true},
+		{key: "Authorization", want: true},
+		{key: "Set-Cookie", want: true},
+		{key: "webhook_secret", want: true},
+		{key: "access_token", want: true},
+		{key: "token_id", want: true},
+		{key: "refreshTokenExpiry", want: true},
+		{key: "SECRET_KEY_BASE", want: true},
+		{key: "passwd_hash", want: true},
+		{key: "user_id", want: false},
+		{key: "url", want: false},
+		{key: "key", want: false},
+		{key: "author", want: false},
+		{key: "ssn", want: false},
+		{key: "customer_ssn", extraKeys: []string{"SSN"}, want: true},
+		{key: "card_number", extraKeys: []string{" card-number "}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			log, logs := newObservedLogger(tt.extraKeys...)
+			log.Info("test", zap.String(tt.key, "value"))
+
+			got := logs.All()[0].ContextMap()[tt.key]
+			if redacted := got == Redacted; redacted != tt.want {
+				t.Errorf("field %q = %v, want redacted %v", tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedactMaps(t *testing.T) {
+	header := http.Header{}
+	header.Set("Authorization", "Bearer abc")
+	header.Set("X-Tenant-Token", "tenant-jwt")
+	header.Set("Content-Type", "application/json")
+
+	tests := []struct {
+		name  string
+		field zap.Field
+		want  any
+	}{
+		{
+			name:  "http.Header",
+			field: zap.Any("headers", header),
+			want: map[string][]string{
+				"Authorization":  {Redacted},
+				"X-Tenant-Token": {Redacted},
+				"Content-Type":   {"application/json"},
+			},
+		},
+		{
+			name:  "map[string][]string",
+			field: zap.Any("headers", map[string][]string{"Cookie": {"session=1"}, "Accept": {"*/*"}}),
+			want:  map[string][]string{"Cookie": {Redacted}, "Accept": {"*/*"}},
+		},
+		{
+			name:  "map[string]string",
+			field: zap.Any("params", map[string]string{"api_key": "k", "page": "2"}),
+			want:  map[string]string{"api_key": Redacted, "page": "2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log, logs := newObservedLogger()
+			log.Info("request", tt.field)
+
+			got := logs.All()[0].Context[0].Interface
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("field = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+
+	if header.Get("Authorization") != "Bearer abc" {
+		t.Fatal("redaction must not modify the original header")
+	}
+}
+
+func TestRedactWithFields(t *testing.T) {
+	log, logs := newObservedLogger()
+
+	child := log.With(zap.String("token", "abc"), zap.String("request_id", "req-1"))
+	child.Info("first", zap.String("password", "p"))
+	child.With(zap.String("client_secret", "s")).Info("second")
+
+	entries := logs.All()
+	if len(entries) != 2 {
+		t.Fatalf("got %d entries, want 2", len(entries))
+	}
+
+	want := []map[string]any{
+		{"token": Redacted, "request_id": "req-1", "password": Redacted},
+		{"token": Redacted, "request_id": "req-1", "client_secret": Redacted},
+	}
+	for i, entry := range entries {
+		if got := entry.ContextMap(); !reflect.DeepEqual(got, want[i]) {
+			t.Errorf("entry %d fields = %v, want %v", i, got, want[i])
+		}
+	}
+}
+
+// Nested object tidak di sensor, perilaku ini di dokumentasikan di README
+func TestRedactDoesNotInspectNestedValues(t *testing.T) {
+	type credentials struct {
+		Password string
+	}
+
+	log, logs := newObservedLogger()
+	log.Info("nested",
+		zap.Any("user", credentials{Password: "p"}),
+		zap.Any("config", map[string]any{"database": map[string]string{"password": "p"}}),
+	)
+
+	fields := logs.All()[0].Context
+	if got := fields[0].Interface; got != (credentials{Password: "p"}) {
+		t.Errorf("struct field = %#v, want it written as is", got)
+	}
+	if got := fields[1].Interface.(map[string]any)["database"].(map[string]string)["password"]; got != "p" {
+		t.Errorf("nested map value = %q, want it written as is", got)
+	}
+}
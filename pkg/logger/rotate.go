@@ -0,0 +1,79 @@
+package logger
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// FileConfig sink file dengan rotasi berdasarkan ukuran dan/atau interval waktu
+type FileConfig struct {
+	Path           string
+	MaxSizeMB      int           // rotasi saat file melewati ukuran ini, default 100MB
+	MaxBackups     int           // jumlah file lama yang disimpan, 0 berarti semua
+	MaxAgeDays     int           // file lama dihapus setelah sekian hari, 0 berarti tidak dihapus
+	Compress       bool          // file lama di gzip
+	RotateInterval time.Duration // rotasi tiap interval (misal 24h), 0 berarti hanya berdasarkan ukuran
+}
+
+// fileSink lumberjack ditambah rotasi berkala
+type fileSink struct {
+	*lumberjack.Logger
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+func newFileSink(config FileConfig) (*fileSink, error) {
+	if err := os.MkdirAll(filepath.Dir(config.Path), 0o755); err != nil {
+		return nil, err
+	}
+
+	maxSize := config.MaxSizeMB
+	if maxSize <= 0 {
+		maxSize = 100
+	}
+
+	sink := &fileSink{
+		Logger: &lumberjack.Logger{
+			Filename:   config.Path,
+			MaxSize:    maxSize,
+			MaxBackups: config.MaxBackups,
+			MaxAge:     config.MaxAgeDays,
+			Compress:   config.Compress,
+			LocalTime:  true,
+		},
+		stop: make(chan struct{}),
+	}
+
+	if config.RotateInterval > 0 {
+		go sink.rotateEvery(config.RotateInterval)
+	}
+
+	return sink, nil
+}
+
+// rotateEvery rotasi di setiap kelipatan interval (24h berarti tiap tengah malam UTC), bukan sejak start
+func (s *fileSink) rotateEvery(interval time.Duration) {
+	timer := time.NewTimer(time.Until(time.Now().Truncate(interval).Add(interval)))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-timer.C:
+			// Error rotasi tidak bisa di log ke logger ini sendiri, file lama tetap dipakai
+			_ = s.Rotate()
+			timer.Reset(time.Until(time.Now().Truncate(interval).Add(interval)))
+		}
+	}
+}
+
+func (s *fileSink) Close() error {
+	s.stopOnce.Do(func() { close(s.stop) })
+	return s.Logger.Close()
+}
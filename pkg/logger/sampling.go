@@ -0,0 +1,35 @@
+package logger
+
+import (
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// SamplingConfig per Tick, Initial log pertama dengan level dan message yang sama ditulis,
+// setelah itu hanya setiap Thereafter log
+type SamplingConfig struct {
+	Enabled    bool
+	Tick       time.Duration // default 1s
+	Initial    int           // default 100
+	Thereafter int           // default 100
+}
+
+func newSampler(core zapcore.Core, config SamplingConfig) zapcore.Core {
+	tick := config.Tick
+	if tick <= 0 {
+		tick = time.Second
+	}
+
+	initial := config.Initial
+	if initial <= 0 {
+		initial = 100
+	}
+
+	thereafter := config.Thereafter
+	if thereafter <= 0 {
+		thereafter = 100
+	}
+
+	return zapcore.NewSamplerWithOptions(core, tick, initial, thereafter)
+}
@@ -0,0 +1,72 @@
+package metrics
+
+import (
+	"errors"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/database"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"gorm.io/gorm"
+)
+
+// Key di statement gorm untuk menyimpan waktu mulai query
+const startKey = "metrics:start"
+
+// RegisterGorm pasang callback durasi query dan statistik pool sql.DB, name dipakai sebagai label db_name
+func RegisterGorm(db *gorm.DB, name string) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+
+	if err := Registry.Register(collectors.NewDBStatsCollector(sqlDB, name)); err != nil {
+		return err
+	}
+
+	cb := db.Callback()
+	for _, err := range []error{
+		cb.Create().Before("gorm:create").Register("metrics:before_create", startTimer),
+		cb.Create().After("gorm:create").Register("metrics:after_create", observeQuery("create")),
+		cb.Query().Before("gorm:query").Register("metrics:before_query", startTimer),
+		cb.Query().After("gorm:query").Register("metrics:after_query", observeQuery("query")),
+		cb.Update().Before("gorm:update").Register("metrics:before_update", startTimer),
+		cb.Update().After("gorm:update").Register("metrics:after_update", observeQuery("update")),
+		cb.Delete().Before("gorm:delete").Register("metrics:before_delete", startTimer),
+		cb.Delete().After("gorm:delete").Register("metrics:after_delete", observeQuery("delete")),
+		cb.Row().Before("gorm:row").Register("metrics:before_row", startTimer),
+		cb.Row().After("gorm:row").Register("metrics:after_row", observeQuery("row")),
+		cb.Raw().Before("gorm:raw").Register("metrics:before_raw", startTimer),
+		cb.Raw().After("gorm:raw").Register("metrics:after_raw", observeQuery("raw")),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func startTimer(db *gorm.DB) {
+	db.InstanceSet(startKey, time.Now())
+}
+
+func observeQuery(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		value, ok := db.InstanceGet(startKey)
+		if !ok {
+			return
+		}
+		start, ok := value.(time.Time)
+		if !ok {
+			return
+		}
+
+		// Record not found bukan kegagalan database
+		outcome := OutcomeSuccess
+		if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+			outcome = OutcomeError
+		}
+
+		dbQueryDuration.WithLabelValues(operation, database.TableName(db.Statement), outcome).Observe(time.Since(start).Seconds())
+	}
+}
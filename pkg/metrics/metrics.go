@@ -0,0 +1,84 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Namespace prefix semua metric milik service ini
+const namespace = "blog"
+
+// Outcome operasi usecase
+const (
+	OutcomeSuccess  = "success"
+	OutcomeRejected = "rejected" // ditolak karena input / state, misal validasi, not found, conflict
+	OutcomeError    = "error"    // gagal karena sistem, misal database error
+)
+
+// Registry terpisah dari default registry supaya isi /metrics hanya yang didaftarkan di sini
+var Registry = prometheus.NewRegistry()
+
+var (
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Total HTTP requests by route template, method and status.",
+	}, []string{"route", "method", "status"})
+
+	httpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "HTTP request latency by route template, method and status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"route", "method", "status"})
+
+	usecaseOperations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "usecase",
+		Name:      "operations_total",
+		Help:      "Total usecase operations by usecase, operation and outcome.",
+	}, []string{"usecase", "operation", "outcome"})
+
+	dbQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "query_duration_seconds",
+		Help:      "GORM query duration by operation and table.",
+		Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"operation", "table", "outcome"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequests,
+		httpDuration,
+		usecaseOperations,
+		dbQueryDuration,
+	)
+}
+
+// Handler handler HTTP untuk di scrape Prometheus
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// ObserveHTTP catat satu request, route harus berupa template (misal /api/v1/article/:article_id)
+func ObserveHTTP(route, method string, status int, duration time.Duration) {
+	labels := []string{route, method, strconv.Itoa(status)}
+	httpRequests.WithLabelValues(labels...).Inc()
+	httpDuration.WithLabelValues(labels...).Observe(duration.Seconds())
+}
+
+// ObserveOperation catat hasil satu operasi usecase
+func ObserveOperation(usecase, operation, outcome string) {
+	usecaseOperations.WithLabelValues(usecase, operation, outcome).Inc()
+}
@@ -0,0 +1,128 @@
+package metrics
+
+import (
+	"errors"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+// histogramCount jumlah observasi histogram dengan label tersebut
+func histogramCount(t *testing.T, histogram *prometheus.HistogramVec, labels ...string) uint64 {
+	t.Helper()
+
+	var metric dto.Metric
+	if err := histogram.WithLabelValues(labels...).(prometheus.Histogram).Write(&metric); err != nil {
+		t.Fatalf("failed to read histogram: %v", err)
+	}
+	return metric.GetHistogram().GetSampleCount()
+}
+
+func TestObserveHTTP(t *testing.T) {
+	labels := []string{"/api/v1/article/:article_id", "GET", "404"}
+	requests := testutil.ToFloat64(httpRequests.WithLabelValues(labels...))
+	observations := histogramCount(t, httpDuration, labels...)
+
+	ObserveHTTP("/api/v1/article/:article_id", "GET", 404, 30*time.Millisecond)
+	ObserveHTTP("/api/v1/article/:article_id", "GET", 404, 10*time.Millisecond)
+
+	if got := testutil.ToFloat64(httpRequests.WithLabelValues(labels...)) - requests; got != 2 {
+		t.Errorf("requests_total increased by %v, want 2", got)
+	}
+	if got := histogramCount(t, httpDuration, labels...) - observations; got != 2 {
+		t.Errorf("request_duration_seconds observed %d times, want 2", got)
+	}
+}
+
+func TestObserveOperation(t *testing.T) {
+	tests := []struct {
+		operation string
+		outcome   string
+	}{
+		{operation: "create", outcome: OutcomeSuccess},
+		{operation: "create", outcome: OutcomeRejected},
+		{operation: "update", outcome: OutcomeError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.operation+"/"+tt.outcome, func(t *testing.T) {
+			counter := usecaseOperations.WithLabelValues("article", tt.operation, tt.outcome)
+			before := testutil.ToFloat64(counter)
+
+			ObserveOperation("article", tt.operation, tt.outcome)
+
+			if got := testutil.ToFloat64(counter) - before; got != 1 {
+				t.Errorf("operations_total increased by %v, want 1", got)
+			}
+		})
+	}
+}
+
+func TestObserveQuery(t *testing.T) {
+	db, err := gorm.Open(mysql.New(mysql.Config{DSN: "user:pass@tcp(127.0.0.1:1)/test", SkipInitializeWithVersion: true}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to open dry run db: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		err         error
+		started     bool
+		wantOutcome string
+	}{
+		{name: "success", started: true, wantOutcome: OutcomeSuccess},
+		{name: "record not found is not a database failure", err: gorm.ErrRecordNotFound, started: true, wantOutcome: OutcomeSuccess},
+		{name: "database error", err: errors.New("connection reset"), started: true, wantOutcome: OutcomeError},
+		{name: "without start time", wantOutcome: OutcomeSuccess},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := db.Table("posts")
+			if tt.started {
+				startTimer(tx)
+			}
+			tx.Error = tt.err
+
+			before := histogramCount(t, dbQueryDuration, "query", "posts", tt.wantOutcome)
+			observeQuery("query")(tx)
+
+			want := uint64(0)
+			if tt.started {
+				want = 1
+			}
+			if got := histogramCount(t, dbQueryDuration, "query", "posts", tt.wantOutcome) - before; got != want {
+				t.Errorf("query_duration_seconds{outcome=%q} observed %d times, want %d", tt.wantOutcome, got, want)
+			}
+		})
+	}
+}
+
+func TestHandler(t *testing.T) {
+	ObserveHTTP("/health", "GET", 200, time.Millisecond)
+
+	recorder := httptest.NewRecorder()
+	Handler().ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+
+	body, _ := io.ReadAll(recorder.Body)
+	for _, want := range []string{
+		`blog_http_requests_total{method="GET",route="/health",status="200"}`,
+		"blog_http_request_duration_seconds_bucket",
+		"go_goroutines",
+	} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("/metrics does not contain %q", want)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package middlewares
+
+import (
+	"strings"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/audit"
+	"github.com/gofiber/fiber/v2"
+)
+
+// NewAuditMiddleware simpan actor, request ID dan IP di Locals supaya usecase bisa mencatat audit log.
+// Actor dibaca dari header (default X-Actor) yang seharusnya di set oleh auth gateway
+func NewAuditMiddleware(actorHeader string) fiber.Handler {
+	if actorHeader == "" {
+		actorHeader = "X-Actor"
+	}
+
+	return func(c *fiber.Ctx) error {
+		requestID, _ := c.Locals("request_id").(string)
+
+		c.Locals(audit.ContextKey, audit.Metadata{
+			Actor:     strings.TrimSpace(c.Get(actorHeader)),
+			RequestID: requestID,
+			IP:        c.IP(),
+		})
+
+		return c.Next()
+	}
+}
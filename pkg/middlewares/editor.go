@@ -0,0 +1,60 @@
+package middlewares
+
+import (
+	"strings"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/audit"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/response"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/zap"
+)
+
+// Claim default yang berisi identitas editor
+const DefaultEditorClaim = "sub"
+
+// NewEditorMiddleware proteksi submit dan keputusan review dengan JWT per editor di
+// "Authorization: Bearer <jwt>", di tanda tangani EDITOR_JWT_SECRET dan wajib punya exp.
+// Identitas dari claim menggantikan actor audit, sehingga author dan reviewer tidak pernah
+// diambil dari header actor. Jika secret kosong semua request ditolak
+func NewEditorMiddleware(secret, claim string, log *zap.Logger) fiber.Handler {
+	if secret == "" {
+		log.Warn("EDITOR_JWT_SECRET is not set, review submit and decision endpoints are disabled")
+	}
+	if claim == "" {
+		claim = DefaultEditorClaim
+	}
+
+	return func(c *fiber.Ctx) error {
+		actor, ok := editorFromToken(c.Get(fiber.HeaderAuthorization), secret, claim)
+		if !ok {
+			return response.NewProblem(fiber.StatusUnauthorized, "UNAUTHORIZED", "Unauthorized")
+		}
+
+		metadata, _ := c.Locals(audit.ContextKey).(audit.Metadata)
+		metadata.Actor = actor
+		metadata.Authenticated = true
+		c.Locals(audit.ContextKey, metadata)
+
+		return c.Next()
+	}
+}
+
+func editorFromToken(header, secret, claim string) (string, bool) {
+	raw := strings.TrimSpace(strings.TrimPrefix(header, "Bearer "))
+	if secret == "" || raw == "" {
+		return "", false
+	}
+
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(raw, claims, func(*jwt.Token) (any, error) {
+		return []byte(secret), nil
+	}, jwt.WithValidMethods([]string{"HS256", "HS384", "HS512"}), jwt.WithExpirationRequired())
+	if err != nil {
+		return "", false
+	}
+
+	actor, _ := claims[claim].(string)
+	actor = strings.TrimSpace(actor)
+	return actor, actor != ""
+}
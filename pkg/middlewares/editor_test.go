@@ -0,0 +1,80 @@
+package middlewares
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/audit"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/zap"
+)
+
+func TestEditorMiddleware(t *testing.T) {
+	const secret = "editor-secret"
+
+	sign := func(method jwt.SigningMethod, key any, claims jwt.MapClaims) string {
+		token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+		if err != nil {
+			t.Fatalf("sign token: %v", err)
+		}
+		return token
+	}
+	exp := time.Now().Add(time.Hour).Unix()
+
+	tests := []struct {
+		name      string
+		secret    string
+		token     string
+		wantCode  int
+		wantActor string
+	}{
+		{name: "valid token", secret: secret, token: sign(jwt.SigningMethodHS256, []byte(secret), jwt.MapClaims{"sub": "alice", "exp": exp}), wantCode: fiber.StatusOK, wantActor: "alice"},
+		{name: "missing token", secret: secret, wantCode: fiber.StatusUnauthorized},
+		{name: "wrong secret", secret: secret, token: sign(jwt.SigningMethodHS256, []byte("other"), jwt.MapClaims{"sub": "alice", "exp": exp}), wantCode: fiber.StatusUnauthorized},
+		{name: "missing exp", secret: secret, token: sign(jwt.SigningMethodHS256, []byte(secret), jwt.MapClaims{"sub": "alice"}), wantCode: fiber.StatusUnauthorized},
+		{name: "expired", secret: secret, token: sign(jwt.SigningMethodHS256, []byte(secret), jwt.MapClaims{"sub": "alice", "exp": time.Now().Add(-time.Minute).Unix()}), wantCode: fiber.StatusUnauthorized},
+		{name: "missing claim", secret: secret, token: sign(jwt.SigningMethodHS256, []byte(secret), jwt.MapClaims{"exp": exp}), wantCode: fiber.StatusUnauthorized},
+		{name: "alg none", secret: secret, token: sign(jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, jwt.MapClaims{"sub": "alice", "exp": exp}), wantCode: fiber.StatusUnauthorized},
+		{name: "disabled without secret", token: sign(jwt.SigningMethodHS256, []byte(""), jwt.MapClaims{"sub": "alice", "exp": exp}), wantCode: fiber.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New(fiber.Config{ErrorHandler: HandleError})
+			app.Use(NewAuditMiddleware(""))
+			app.Post("/review", NewEditorMiddleware(tt.secret, "", zap.NewNop()), func(c *fiber.Ctx) error {
+				metadata := audit.FromContext(c.Context())
+				if !metadata.Authenticated {
+					t.Error("actor is not marked as authenticated")
+				}
+				return c.SendString(metadata.Actor)
+			})
+
+			req := httptest.NewRequest(fiber.MethodPost, "/review", nil)
+			// Header actor dari client tidak boleh menggantikan identitas dari token
+			req.Header.Set("X-Actor", "mallory")
+			if tt.token != "" {
+				req.Header.Set(fiber.HeaderAuthorization, "Bearer "+tt.token)
+			}
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("request: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.wantCode {
+				t.Fatalf("status = %d, want %d", resp.StatusCode, tt.wantCode)
+			}
+			if tt.wantActor != "" {
+				body, _ := io.ReadAll(resp.Body)
+				if string(body) != tt.wantActor {
+					t.Fatalf("actor = %q, want %q", body, tt.wantActor)
+				}
+			}
+		})
+	}
+}
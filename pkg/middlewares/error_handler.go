@@ -0,0 +1,146 @@
+package middlewares
+
+import (
+	"errors"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/apperror"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/response"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/tracing"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/validation"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// exposeInternalErrors tampilkan pesan error asli (termasuk cause) di response 5xx, hanya untuk development
+var exposeInternalErrors bool
+
+// SetExposeInternalErrors di set false di production supaya detail error internal tidak bocor ke client
+func SetExposeInternalErrors(enabled bool) {
+	exposeInternalErrors = enabled
+}
+
+// HandleError fiber.ErrorHandler, semua error dari handler dan middleware di tulis sebagai application/problem+json.
+// Error yang tidak dikenal menjadi 500 tanpa membocorkan pesan aslinya
+func HandleError(c *fiber.Ctx, err error) error {
+	problem := toProblem(err)
+	localize(problem, languageOf(c))
+
+	if problem.Status >= fiber.StatusInternalServerError {
+		logger.FromContext(c.Context()).Error("unhandled request error", zap.Error(err), zap.Int("status", problem.Status))
+		tracing.SpanFromContext(c.Context()).RecordError(err)
+	}
+
+	if requestID, ok := c.Locals("request_id").(string); ok {
+		problem.RequestID = requestID
+	}
+
+	return problem.Send(c)
+}
+
+// NewErrorMiddleware render error di dalam chain, supaya middleware di luarnya (tracing, metrics, logger)
+// membaca status response yang sebenarnya. Harus dipasang setelah middleware tersebut
+func NewErrorMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if err := c.Next(); err != nil {
+			return HandleError(c, err)
+		}
+
+		return nil
+	}
+}
+
+func toProblem(err error) *response.Problem {
+	var problem *response.Problem
+	if errors.As(err, &problem) {
+		// Di copy karena problem yang sama bisa di return berulang kali (misal variabel package)
+		copied := *problem
+		return &copied
+	}
+
+	var fieldErrs validation.Errors
+	if errors.As(err, &fieldErrs) {
+		// Error domain pertama menjadi error_code dan detail, client lama tetap membaca code yang sama
+		if code := fieldErrs.Code(); code != validation.CodeValidation {
+			problem := response.NewValidationProblem(fieldErrs[0].Message, fieldErrs)
+			problem.ErrorCode = code
+			problem.MessageID = fieldErrs[0].MessageID
+			return problem
+		}
+		return response.NewValidationProblem("Request validation failed", fieldErrs).WithMessageID("fields")
+	}
+
+	// Error domain, status ditentukan dari kind dan pesan tanpa cause
+	if appErr, ok := apperror.As(err); ok {
+		status := StatusOf(appErr.Kind)
+		detail := appErr.Message
+		if status >= fiber.StatusInternalServerError && exposeInternalErrors {
+			detail = err.Error()
+		}
+
+		return response.NewProblem(status, appErr.Code, detail).WithMessageID(appErr.ID)
+	}
+
+	// Error bawaan fiber, misal 404 route tidak ada, 405, 413 body terlalu besar
+	var fiberErr *fiber.Error
+	if errors.As(err, &fiberErr) {
+		return response.NewProblem(fiberErr.Code, fiberErrorCode(fiberErr.Code), fiberErr.Message)
+	}
+
+	detail := "Something went wrong"
+	if exposeInternalErrors {
+		detail = err.Error()
+	}
+
+	return response.NewProblem(fiber.StatusInternalServerError, "INTERNAL_SERVER_ERROR", detail)
+}
+
+// StatusOf HTTP status untuk setiap kind error domain
+func StatusOf(kind apperror.Kind) int {
+	switch kind {
+	case apperror.KindValidation:
+		return fiber.StatusBadRequest
+	case apperror.KindUnauthorized:
+		return fiber.StatusUnauthorized
+	case apperror.KindForbidden:
+		return fiber.StatusForbidden
+	case apperror.KindNotFound:
+		return fiber.StatusNotFound
+	case apperror.KindConflict:
+		return fiber.StatusConflict
+	case apperror.KindTooLarge:
+		return fiber.StatusRequestEntityTooLarge
+	case apperror.KindUnsupported:
+		return fiber.StatusUnsupportedMediaType
+	default:
+		return fiber.StatusInternalServerError
+	}
+}
+
+func fiberErrorCode(status int) string {
+	switch status {
+	case fiber.StatusBadRequest:
+		return "BAD_REQUEST"
+	case fiber.StatusUnauthorized:
+		return "UNAUTHORIZED"
+	case fiber.StatusForbidden:
+		return "FORBIDDEN"
+	case fiber.StatusNotFound:
+		return "NOT_FOUND"
+	case fiber.StatusMethodNotAllowed:
+		return "METHOD_NOT_ALLOWED"
+	case fiber.StatusRequestEntityTooLarge:
+		return "PAYLOAD_TOO_LARGE"
+	case fiber.StatusUnsupportedMediaType:
+		return "UNSUPPORTED_MEDIA_TYPE"
+	case fiber.StatusTooManyRequests:
+		return "TOO_MANY_REQUESTS"
+	case fiber.StatusServiceUnavailable:
+		return "SERVICE_UNAVAILABLE"
+	}
+
+	if status >= fiber.StatusInternalServerError {
+		return "INTERNAL_SERVER_ERROR"
+	}
+	return "BAD_REQUEST"
+}
@@ -0,0 +1,154 @@
+package middlewares
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/apperror"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/response"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/i18n"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/validation"
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestStatusOf(t *testing.T) {
+	tests := []struct {
+		kind apperror.Kind
+		want int
+	}{
+		{kind: apperror.KindValidation, want: fiber.StatusBadRequest},
+		{kind: apperror.KindUnauthorized, want: fiber.StatusUnauthorized},
+		{kind: apperror.KindForbidden, want: fiber.StatusForbidden},
+		{kind: apperror.KindNotFound, want: fiber.StatusNotFound},
+		{kind: apperror.KindConflict, want: fiber.StatusConflict},
+		{kind: apperror.KindTooLarge, want: fiber.StatusRequestEntityTooLarge},
+		{kind: apperror.KindUnsupported, want: fiber.StatusUnsupportedMediaType},
+		{kind: apperror.KindInternal, want: fiber.StatusInternalServerError},
+		{kind: apperror.Kind(99), want: fiber.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.kind.String(), func(t *testing.T) {
+			if got := StatusOf(tt.kind); got != tt.want {
+				t.Errorf("StatusOf(%v) = %d, want %d", tt.kind, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToProblem(t *testing.T) {
+	notFound := apperror.NotFound("NOT_FOUND", "article not found")
+	failed := apperror.Internal("UPDATE_FAILED", "failed to update article")
+	cause := errors.New("dial tcp: connection refused")
+
+	var fieldErrs validation.Errors
+	fieldErrs.Add("title", validation.CodeRequired, "title is required")
+
+	var domainFieldErrs validation.Errors
+	domainFieldErrs.AddError("title", apperror.Validation("TITLE_REQUIRED", "title is required"))
+	domainFieldErrs.Add("status", validation.CodeNotAllowed, "status must be one of: Publish, Draft")
+
+	tests := []struct {
+		name       string
+		err        error
+		expose     bool
+		wantStatus int
+		wantCode   string
+		wantDetail string
+		wantFields int
+	}{
+		{name: "problem is returned as is", err: response.NewProblem(fiber.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body"), wantStatus: 400, wantCode: "VALIDATION_ERROR", wantDetail: "Invalid request body"},
+		{name: "field errors", err: fieldErrs, wantStatus: 400, wantCode: "VALIDATION_ERROR", wantDetail: "Request validation failed", wantFields: 1},
+		{name: "wrapped field errors", err: fmt.Errorf("create: %w", fieldErrs), wantStatus: 400, wantCode: "VALIDATION_ERROR", wantDetail: "Request validation failed", wantFields: 1},
+		{name: "field errors keep the domain code of the first field", err: domainFieldErrs, wantStatus: 400, wantCode: "TITLE_REQUIRED", wantDetail: "title is required", wantFields: 2},
+		{name: "domain error", err: notFound, wantStatus: 404, wantCode: "NOT_FOUND", wantDetail: "article not found"},
+		{name: "wrapped domain error", err: fmt.Errorf("usecase: %w", notFound), wantStatus: 404, wantCode: "NOT_FOUND", wantDetail: "article not found"},
+		{name: "internal error hides cause", err: failed.Wrap(cause), wantStatus: 500, wantCode: "UPDATE_FAILED", wantDetail: "failed to update article"},
+		{name: "internal error exposes cause in development", err: failed.Wrap(cause), expose: true, wantStatus: 500, wantCode: "UPDATE_FAILED", wantDetail: failed.Wrap(cause).Error()},
+		{name: "client error never exposes cause", err: notFound.Wrap(cause), expose: true, wantStatus: 404, wantCode: "NOT_FOUND", wantDetail: "article not found"},
+		{name: "fiber error", err: fiber.ErrMethodNotAllowed, wantStatus: 405, wantCode: "METHOD_NOT_ALLOWED", wantDetail: "Method Not Allowed"},
+		{name: "unknown error", err: cause, wantStatus: 500, wantCode: "INTERNAL_SERVER_ERROR", wantDetail: "Something went wrong"},
+		{name: "unknown error in development", err: cause, expose: true, wantStatus: 500, wantCode: "INTERNAL_SERVER_ERROR", wantDetail: cause.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetExposeInternalErrors(tt.expose)
+			t.Cleanup(func() { SetExposeInternalErrors(false) })
+
+			problem := toProblem(tt.err)
+			if problem.Status != tt.wantStatus {
+				t.Errorf("status = %d, want %d", problem.Status, tt.wantStatus)
+			}
+			if problem.ErrorCode != tt.wantCode {
+				t.Errorf("error code = %q, want %q", problem.ErrorCode, tt.wantCode)
+			}
+			if problem.Detail != tt.wantDetail {
+				t.Errorf("detail = %q, want %q", problem.Detail, tt.wantDetail)
+			}
+			if len(problem.Errors) != tt.wantFields {
+				t.Errorf("field errors = %d, want %d", len(problem.Errors), tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestToProblemCopiesSharedProblem(t *testing.T) {
+	shared := response.NewProblem(fiber.StatusUnauthorized, "UNAUTHORIZED", "Unauthorized")
+
+	problem := toProblem(shared)
+	problem.RequestID = "req-1"
+	problem.Detail = "Tidak terautentikasi"
+
+	if problem == shared {
+		t.Fatal("toProblem() must return a copy of a shared problem")
+	}
+	if shared.RequestID != "" || shared.Detail != "Unauthorized" {
+		t.Errorf("shared problem was modified: %+v", shared)
+	}
+}
+
+func TestLocalize(t *testing.T) {
+	notFound := apperror.NotFound("NOT_FOUND", "article not found").WithID("article")
+
+	var fieldErrs validation.Errors
+	fieldErrs.AddError("locale", apperror.Validation("LOCALE_INVALID", "unsupported locale").WithID("unsupported"))
+	fieldErrs.Add("title", validation.CodeRequired, "title is required")
+
+	tests := []struct {
+		name       string
+		err        error
+		language   string
+		wantDetail string
+		wantFields []string
+	}{
+		{name: "message id", err: notFound, language: i18n.Indonesian, wantDetail: "artikel tidak ditemukan"},
+		{name: "wrapped error keeps message id", err: notFound.Wrap(errors.New("record not found")), language: i18n.Indonesian, wantDetail: "artikel tidak ditemukan"},
+		{name: "problem message id", err: response.NewProblem(fiber.StatusBadRequest, "VALIDATION_ERROR", "Invalid request body").WithMessageID("body"), language: i18n.Indonesian, wantDetail: "Body request tidak valid"},
+		{name: "fallback language keeps original", err: notFound, language: i18n.English, wantDetail: "article not found"},
+		{
+			name:       "field errors",
+			err:        fieldErrs,
+			language:   i18n.Indonesian,
+			wantDetail: "locale tidak didukung",
+			wantFields: []string{"locale tidak didukung", "title wajib diisi"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problem := toProblem(tt.err)
+			localize(problem, tt.language)
+
+			if problem.Detail != tt.wantDetail {
+				t.Errorf("detail = %q, want %q", problem.Detail, tt.wantDetail)
+			}
+			for i, want := range tt.wantFields {
+				if got := problem.Errors[i].Message; got != want {
+					t.Errorf("errors[%d].message = %q, want %q", i, got, want)
+				}
+			}
+		})
+	}
+}
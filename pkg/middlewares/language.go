@@ -0,0 +1,60 @@
+package middlewares
+
+import (
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/response"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/i18n"
+	"github.com/gofiber/fiber/v2"
+)
+
+// NewLanguageMiddleware negosiasi bahasa dari Accept-Language lalu simpan di Locals,
+// handler dan error handler membaca bahasa dari ctx.Context() lewat i18n.FromContext
+func NewLanguageMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		language := i18n.Negotiate(c.Get(fiber.HeaderAcceptLanguage))
+
+		// Content-Language tidak di set di sini, header itu milik locale konten artikel
+		c.Locals(i18n.ContextKey, language)
+		c.Vary(fiber.HeaderAcceptLanguage)
+
+		return c.Next()
+	}
+}
+
+// languageOf bahasa request, error sebelum middleware bahasa jalan tetap di negosiasi dari header
+func languageOf(c *fiber.Ctx) string {
+	if language, ok := c.Locals(i18n.ContextKey).(string); ok {
+		return language
+	}
+
+	return i18n.Negotiate(c.Get(fiber.HeaderAcceptLanguage))
+}
+
+// localize terjemahkan detail dan pesan per field. Detail 5xx yang sengaja menampilkan error asli
+// (development) tidak di terjemahkan, error_code tidak pernah berubah
+func localize(problem *response.Problem, language string) {
+	if problem.Status >= fiber.StatusInternalServerError && exposeInternalErrors {
+		return
+	}
+
+	if detail, ok := i18n.Error(language, problem.ErrorCode, problem.MessageID); ok {
+		problem.Detail = detail
+	}
+
+	// Pesan per field asalnya sudah English, pesan khusus dari Errors.Add tidak di ganti template
+	if len(problem.Errors) == 0 || language == i18n.Fallback {
+		return
+	}
+
+	// Di copy karena slice errors bisa milik problem yang di pakai ulang
+	fieldErrs := make([]response.FieldError, len(problem.Errors))
+	for i, fieldErr := range problem.Errors {
+		if message, ok := i18n.Field(language, fieldErr.Code, fieldErr.Params); ok {
+			fieldErr.Message = message
+		} else if message, ok := i18n.Error(language, fieldErr.Code, fieldErr.MessageID); ok {
+			// Field dengan error domain diterjemahkan seperti error_code biasa
+			fieldErr.Message = message
+		}
+		fieldErrs[i] = fieldErr
+	}
+	problem.Errors = fieldErrs
+}
@@ -0,0 +1,32 @@
+package middlewares
+
+import (
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/audit"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/tenant"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/tracing"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"go.uber.org/zap"
+)
+
+// NewContextLoggerMiddleware simpan logger per request di Locals, dibaca lewat logger.FromContext(ctx).
+// Harus dipasang setelah middleware request ID, tracing, tenant dan audit supaya field-nya sudah tersedia
+func NewContextLoggerMiddleware(log *zap.Logger) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		requestID, _ := c.Locals("request_id").(string)
+		tenantID, _ := tenant.FromContext(c.Context())
+
+		// Route template baru diketahui setelah routing, jadi yang dicatat method dan path request
+		fields := []zap.Field{
+			zap.String("request_id", requestID),
+			zap.String("method", utils.CopyString(c.Method())),
+			zap.String("path", utils.CopyString(c.Path())),
+			zap.String("tenant_id", tenantID),
+			zap.String("user", audit.FromContext(c.Context()).Actor),
+		}
+
+		c.Locals(logger.ContextKey, log.With(append(fields, tracing.LogFields(c.Context())...)...))
+		return c.Next()
+	}
+}
@@ -0,0 +1,134 @@
+package middlewares
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/audit"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/logger"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/tenant"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/tracing"
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestContextLoggerMiddleware(t *testing.T) {
+	const (
+		traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+		spanID  = "00f067aa0ba902b7"
+	)
+
+	tests := []struct {
+		name       string
+		requestID  string
+		actor      string
+		tenantID   string
+		traced     bool
+		wantFields map[string]string
+		wantAbsent []string
+	}{
+		{
+			name:       "all request fields",
+			requestID:  "req-1",
+			actor:      "alice",
+			tenantID:   "brand-a",
+			traced:     true,
+			wantFields: map[string]string{"request_id": "req-1", "user": "alice", "tenant_id": "brand-a", "method": "GET", "path": "/article/7", "trace_id": traceID, "span_id": spanID},
+		},
+		{
+			name:       "anonymous request without span",
+			requestID:  "req-2",
+			wantFields: map[string]string{"request_id": "req-2", "user": audit.AnonymousActor, "tenant_id": "", "method": "GET", "path": "/article/7"},
+			wantAbsent: []string{"trace_id", "span_id"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			core, logs := observer.New(zap.InfoLevel)
+
+			app := fiber.New(fiber.Config{ErrorHandler: HandleError})
+			app.Use(NewRequestIDMiddleware().Handle())
+			app.Use(func(c *fiber.Ctx) error {
+				if tt.tenantID != "" {
+					c.Locals(tenant.ContextKey, tt.tenantID)
+				}
+				if tt.traced {
+					tid, _ := trace.TraceIDFromHex(traceID)
+					sid, _ := trace.SpanIDFromHex(spanID)
+					spanContext := trace.NewSpanContext(trace.SpanContextConfig{TraceID: tid, SpanID: sid, TraceFlags: trace.FlagsSampled})
+					c.Locals(tracing.SpanKey, trace.SpanFromContext(trace.ContextWithSpanContext(context.Background(), spanContext)))
+				}
+				return c.Next()
+			})
+			app.Use(NewAuditMiddleware(""))
+			app.Use(NewContextLoggerMiddleware(zap.New(core)))
+			// Usecase dan repository menerima ctx.Context() dari handler
+			app.Get("/article/:id", func(c *fiber.Ctx) error {
+				logger.FromContext(c.Context()).Info("article loaded")
+				return c.SendStatus(fiber.StatusOK)
+			})
+
+			req := httptest.NewRequest(fiber.MethodGet, "/article/7", nil)
+			req.Header.Set(fiber.HeaderXRequestID, tt.requestID)
+			if tt.actor != "" {
+				req.Header.Set("X-Actor", tt.actor)
+			}
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("app.Test() error = %v", err)
+			}
+			resp.Body.Close()
+
+			entries := logs.FilterMessage("article loaded").All()
+			if len(entries) != 1 {
+				t.Fatalf("logged %d entries, want 1", len(entries))
+			}
+
+			fields := entries[0].ContextMap()
+			for key, want := range tt.wantFields {
+				if got, ok := fields[key]; !ok || got != want {
+					t.Errorf("field %s = %v (present %v), want %q", key, got, ok, want)
+				}
+			}
+			for _, key := range tt.wantAbsent {
+				if _, ok := fields[key]; ok {
+					t.Errorf("field %s present, want absent", key)
+				}
+			}
+		})
+	}
+}
+
+func TestContextLoggerMiddlewareIsPerRequest(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+
+	app := fiber.New()
+	app.Use(NewRequestIDMiddleware().Handle())
+	app.Use(NewContextLoggerMiddleware(zap.New(core)))
+	app.Get("/", func(c *fiber.Ctx) error {
+		logger.FromContext(c.Context()).Info("handled")
+		return nil
+	})
+
+	// Request tanpa X-Request-ID mendapat id baru, logger tidak bocor antar request
+	for i := 0; i < 2; i++ {
+		resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, "/", nil))
+		if err != nil {
+			t.Fatalf("app.Test() error = %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	entries := logs.All()
+	if len(entries) != 2 {
+		t.Fatalf("logged %d entries, want 2", len(entries))
+	}
+	first, second := entries[0].ContextMap()["request_id"], entries[1].ContextMap()["request_id"]
+	if first == "" || first == second {
+		t.Fatalf("request_id = %v and %v, want distinct generated ids", first, second)
+	}
+}
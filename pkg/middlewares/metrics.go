@@ -0,0 +1,62 @@
+package middlewares
+
+import (
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/metrics"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+)
+
+// Label route untuk request yang tidak cocok dengan route manapun, supaya path acak tidak jadi label
+const unmatchedRoute = "unmatched"
+
+// NewMetricsMiddleware catat jumlah dan latency request per route template.
+// Request ke skipPaths (endpoint /metrics sendiri, probe) tidak di catat
+func NewMetricsMiddleware(skipPaths ...string) fiber.Handler {
+	skip := skipSet(skipPaths)
+
+	return func(c *fiber.Ctx) error {
+		if _, ok := skip[c.Path()]; ok {
+			return c.Next()
+		}
+
+		start := time.Now()
+		err := c.Next()
+
+		// Error yang belum di handle akan di ubah jadi response oleh error handler fiber
+		status := c.Response().StatusCode()
+		if err != nil {
+			status = fiber.StatusInternalServerError
+			if fiberErr, ok := err.(*fiber.Error); ok {
+				status = fiberErr.Code
+			}
+		}
+
+		// Method di copy karena string dari fiber memakai buffer yang dipakai ulang request berikutnya
+		metrics.ObserveHTTP(routeTemplate(c), utils.CopyString(c.Method()), status, time.Since(start))
+
+		return err
+	}
+}
+
+// routeTemplate route yang terakhir dijalankan. Kalau tidak ada route yang cocok fiber
+// menyisakan route middleware global ("/"), request seperti itu di label unmatched
+func routeTemplate(c *fiber.Ctx) string {
+	route := c.Route().Path
+	if route == "/" && c.Path() != "/" {
+		return unmatchedRoute
+	}
+
+	return route
+}
+
+func skipSet(paths []string) map[string]struct{} {
+	skip := make(map[string]struct{}, len(paths))
+	for _, path := range paths {
+		if path != "" {
+			skip[path] = struct{}{}
+		}
+	}
+	return skip
+}
@@ -0,0 +1,83 @@
+package middlewares
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/metrics"
+	"github.com/gofiber/fiber/v2"
+)
+
+// requestCount nilai blog_http_requests_total untuk label tersebut, dibaca dari registry
+func requestCount(t *testing.T, route, method, status string) float64 {
+	t.Helper()
+
+	families, err := metrics.Registry.Gather()
+	if err != nil {
+		t.Fatalf("Gather() error = %v", err)
+	}
+
+	for _, family := range families {
+		if family.GetName() != "blog_http_requests_total" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			labels := map[string]string{}
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			if labels["route"] == route && labels["method"] == method && labels["status"] == status {
+				return metric.GetCounter().GetValue()
+			}
+		}
+	}
+
+	return 0
+}
+
+func TestMetricsMiddleware(t *testing.T) {
+	app := fiber.New(fiber.Config{ErrorHandler: HandleError})
+	app.Use(NewMetricsMiddleware("/metrics", ""))
+	app.Get("/metrics", func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusOK) })
+	app.Get("/metrics-test/article/:article_id", func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusOK) })
+	app.Get("/metrics-test/missing", func(c *fiber.Ctx) error { return fiber.ErrNotFound })
+	app.Get("/metrics-test/broken", func(c *fiber.Ctx) error { return errors.New("boom") })
+	app.Post("/metrics-test/created", func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusCreated) })
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		wantRoute  string
+		wantStatus string
+		skipped    bool
+	}{
+		{name: "route template instead of path", method: fiber.MethodGet, path: "/metrics-test/article/42", wantRoute: "/metrics-test/article/:article_id", wantStatus: "200"},
+		{name: "status from response", method: fiber.MethodPost, path: "/metrics-test/created", wantRoute: "/metrics-test/created", wantStatus: "201"},
+		{name: "fiber error status", method: fiber.MethodGet, path: "/metrics-test/missing", wantRoute: "/metrics-test/missing", wantStatus: "404"},
+		{name: "unknown error is 500", method: fiber.MethodGet, path: "/metrics-test/broken", wantRoute: "/metrics-test/broken", wantStatus: "500"},
+		{name: "unmatched route", method: fiber.MethodGet, path: "/metrics-test/random/path", wantRoute: unmatchedRoute, wantStatus: "404"},
+		{name: "skipped path", method: fiber.MethodGet, path: "/metrics", wantRoute: "/metrics", wantStatus: "200", skipped: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := requestCount(t, tt.wantRoute, tt.method, tt.wantStatus)
+
+			resp, err := app.Test(httptest.NewRequest(tt.method, tt.path, nil))
+			if err != nil {
+				t.Fatalf("app.Test() error = %v", err)
+			}
+			resp.Body.Close()
+
+			want := 1.0
+			if tt.skipped {
+				want = 0
+			}
+			if got := requestCount(t, tt.wantRoute, tt.method, tt.wantStatus) - before; got != want {
+				t.Errorf("requests_total{route=%q,status=%q} increased by %v, want %v", tt.wantRoute, tt.wantStatus, got, want)
+			}
+		})
+	}
+}
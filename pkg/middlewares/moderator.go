@@ -0,0 +1,41 @@
+package middlewares
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/response"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// NewModeratorMiddleware proteksi endpoint moderasi dengan header
+// "Authorization: Bearer <token>". Jika token kosong semua request ditolak.
+func NewModeratorMiddleware(token string, log *zap.Logger) fiber.Handler {
+	if token == "" {
+		log.Warn("MODERATOR_TOKEN is not set, moderation endpoints are disabled")
+	}
+
+	return newBearerTokenMiddleware(token)
+}
+
+// NewAdminMiddleware sama seperti moderator tapi memakai ADMIN_TOKEN, untuk endpoint operasional
+func NewAdminMiddleware(token string, log *zap.Logger) fiber.Handler {
+	if token == "" {
+		log.Warn("ADMIN_TOKEN is not set, admin endpoints are disabled")
+	}
+
+	return newBearerTokenMiddleware(token)
+}
+
+func newBearerTokenMiddleware(token string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		given := strings.TrimPrefix(c.Get(fiber.HeaderAuthorization), "Bearer ")
+
+		if token == "" || subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+			return response.NewProblem(fiber.StatusUnauthorized, "UNAUTHORIZED", "Unauthorized")
+		}
+
+		return c.Next()
+	}
+}
@@ -0,0 +1,50 @@
+package middlewares
+
+import (
+	"strings"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/tenant"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+)
+
+// NewTenantMiddleware resolve tenant tiap request lalu simpan di Locals,
+// repository membaca tenant dari ctx.Context() sehingga semua query otomatis ter-scope.
+// GET / HEAD ke publicPaths (prefix) tanpa token tenant di resolve dari subdomain atau default,
+// supaya feed dan sitemap tetap bisa dibaca tanpa JWT tenant
+func NewTenantMiddleware(resolver *tenant.Resolver, log *zap.Logger, publicPaths ...string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var id string
+		var err error
+
+		token := c.Get(resolver.TokenHeader)
+		if token == "" && isPublicRead(c, publicPaths) {
+			id, err = resolver.ResolvePublic(c.Get(resolver.Header), c.Hostname())
+		} else {
+			id, err = resolver.Resolve(c.Get(resolver.Header), c.Hostname(), token)
+		}
+		if err != nil {
+			log.Warn("failed to resolve tenant", zap.Error(err), zap.String("host", c.Hostname()))
+
+			// Status dan code berasal dari error domain tenant
+			return err
+		}
+
+		c.Locals(tenant.ContextKey, id)
+		return c.Next()
+	}
+}
+
+func isPublicRead(c *fiber.Ctx, publicPaths []string) bool {
+	if c.Method() != fiber.MethodGet && c.Method() != fiber.MethodHead {
+		return false
+	}
+
+	for _, prefix := range publicPaths {
+		if strings.HasPrefix(c.Path(), prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,87 @@
+package middlewares
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/tenant"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/zap"
+)
+
+func TestTenantMiddleware(t *testing.T) {
+	const secret = "tenant-secret"
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"tenant_id": "brand-a"}).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+
+	resolver := &tenant.Resolver{
+		Header:      tenant.DefaultHeader,
+		TokenHeader: tenant.DefaultTokenHeader,
+		BaseDomain:  "example.com",
+		JWTSecret:   []byte(secret),
+		JWTClaim:    tenant.DefaultJWTClaim,
+		Default:     tenant.DefaultTenant,
+	}
+
+	app := fiber.New(fiber.Config{ErrorHandler: HandleError})
+	app.Use(NewTenantMiddleware(resolver, zap.NewNop(), "/feeds/", "/sitemap.xml"))
+	app.All("/*", func(c *fiber.Ctx) error {
+		id, _ := tenant.FromContext(c.Context())
+		return c.SendString(id)
+	})
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		host       string
+		token      string
+		moderator  bool
+		wantCode   int
+		wantTenant string
+	}{
+		{name: "tenant token next to moderator bearer", method: fiber.MethodPost, path: "/api/v1/comments/1/approve", token: token, moderator: true, wantCode: fiber.StatusOK, wantTenant: "brand-a"},
+		{name: "moderator bearer without tenant token", method: fiber.MethodPost, path: "/api/v1/comments/1/approve", moderator: true, wantCode: fiber.StatusUnauthorized},
+		{name: "api read without tenant token", method: fiber.MethodGet, path: "/api/v1/article", wantCode: fiber.StatusUnauthorized},
+		{name: "public feed uses default tenant", method: fiber.MethodGet, path: "/feeds/rss.xml", wantCode: fiber.StatusOK, wantTenant: tenant.DefaultTenant},
+		{name: "public sitemap uses subdomain", method: fiber.MethodGet, path: "/sitemap.xml", host: "brand-b.example.com", wantCode: fiber.StatusOK, wantTenant: "brand-b"},
+		{name: "public feed with token uses claim", method: fiber.MethodGet, path: "/feeds/rss.xml", token: token, wantCode: fiber.StatusOK, wantTenant: "brand-a"},
+		{name: "write to public prefix still needs token", method: fiber.MethodPost, path: "/feeds/rss.xml", wantCode: fiber.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.host != "" {
+				req.Host = tt.host
+			}
+			if tt.token != "" {
+				req.Header.Set(tenant.DefaultTokenHeader, tt.token)
+			}
+			if tt.moderator {
+				req.Header.Set(fiber.HeaderAuthorization, "Bearer moderator-token")
+			}
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("request: %v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != tt.wantCode {
+				t.Fatalf("status = %d, want %d", resp.StatusCode, tt.wantCode)
+			}
+			if tt.wantTenant != "" {
+				body, _ := io.ReadAll(resp.Body)
+				if string(body) != tt.wantTenant {
+					t.Fatalf("tenant = %q, want %q", body, tt.wantTenant)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package middlewares
+
+import (
+	"context"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/tracing"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NewTracingMiddleware buat server span per request. Parent di ambil dari header traceparent
+// (W3C), span disimpan di Locals supaya usecase & repository bisa membuat child span dari ctx.Context()
+func NewTracingMiddleware(skipPaths ...string) fiber.Handler {
+	skip := skipSet(skipPaths)
+
+	return func(c *fiber.Ctx) error {
+		if _, ok := skip[c.Path()]; ok {
+			return c.Next()
+		}
+
+		// String dari fiber di copy karena buffer-nya dipakai ulang setelah request selesai
+		method := utils.CopyString(c.Method())
+		parent := otel.GetTextMapPropagator().Extract(context.Background(), tracing.HeaderCarrier{Header: &c.Request().Header})
+
+		ctx, span := tracing.Start(parent, method,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(method),
+				semconv.URLPath(utils.CopyString(c.Path())),
+				semconv.ClientAddress(c.IP()),
+				semconv.UserAgentOriginal(utils.CopyString(c.Get(fiber.HeaderUserAgent))),
+			),
+		)
+		defer span.End()
+
+		c.Locals(tracing.SpanKey, trace.SpanFromContext(ctx))
+
+		err := c.Next()
+
+		status := c.Response().StatusCode()
+		if err != nil {
+			status = fiber.StatusInternalServerError
+			if fiberErr, ok := err.(*fiber.Error); ok {
+				status = fiberErr.Code
+			}
+			span.RecordError(err)
+		}
+
+		route := routeTemplate(c)
+		span.SetName(method + " " + route)
+		span.SetAttributes(
+			semconv.HTTPRoute(route),
+			semconv.HTTPResponseStatusCode(status),
+			attribute.String("http.request_id", utils.CopyString(c.GetRespHeader(fiber.HeaderXRequestID))),
+		)
+		if status >= fiber.StatusInternalServerError {
+			span.SetStatus(codes.Error, utils.StatusMessage(status))
+		}
+
+		return err
+	}
+}
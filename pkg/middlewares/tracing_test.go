@@ -0,0 +1,121 @@
+package middlewares
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/tracing"
+	"github.com/gofiber/fiber/v2"
+	"github.com/spf13/viper"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracingMiddlewareParentsSpans(t *testing.T) {
+	const (
+		traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+		spanID  = "00f067aa0ba902b7"
+	)
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := tracing.NewProvider(viper.New(), exporter)
+
+	previousProvider, previousPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		provider.Shutdown(context.Background())
+		otel.SetTracerProvider(previousProvider)
+		otel.SetTextMapPropagator(previousPropagator)
+	})
+
+	app := fiber.New()
+	app.Use(NewTracingMiddleware("/health"))
+	handler := func(c *fiber.Ctx) error {
+		// Usecase membuat child span dari ctx.Context() handler
+		_, span := tracing.Start(c.Context(), "ArticleUsecase.GetDetailByID")
+		span.End()
+		return c.SendStatus(fiber.StatusOK)
+	}
+	app.Get("/article/:id", handler)
+	app.Get("/health", handler)
+
+	tests := []struct {
+		name         string
+		path         string
+		traceparent  string
+		wantSpans    int
+		wantRemote   bool // server span harus anak dari span di traceparent
+		wantServerAs string
+	}{
+		{name: "sampled traceparent", path: "/article/1", traceparent: "00-" + traceID + "-" + spanID + "-01", wantSpans: 2, wantRemote: true, wantServerAs: "GET /article/:id"},
+		{name: "no traceparent starts a new trace", path: "/article/1", wantSpans: 2, wantServerAs: "GET /article/:id"},
+		{name: "invalid traceparent starts a new trace", path: "/article/1", traceparent: "00-" + traceID + "-" + spanID, wantSpans: 2, wantServerAs: "GET /article/:id"},
+		{name: "unsampled traceparent is not recorded", path: "/article/1", traceparent: "00-" + traceID + "-" + spanID + "-00", wantSpans: 0},
+		{name: "skipped path", path: "/health", traceparent: "00-" + traceID + "-" + spanID + "-01", wantSpans: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter.Reset()
+
+			req := httptest.NewRequest(fiber.MethodGet, tt.path, nil)
+			if tt.traceparent != "" {
+				req.Header.Set("traceparent", tt.traceparent)
+			}
+
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("app.Test() error = %v", err)
+			}
+			resp.Body.Close()
+
+			if err := provider.ForceFlush(context.Background()); err != nil {
+				t.Fatalf("ForceFlush() error = %v", err)
+			}
+
+			spans := exporter.GetSpans()
+			if len(spans) != tt.wantSpans {
+				t.Fatalf("spans = %d, want %d", len(spans), tt.wantSpans)
+			}
+			if tt.wantServerAs == "" {
+				return
+			}
+
+			// Child span selesai lebih dulu sehingga di export sebelum server span
+			child, server := spans[0], spans[1]
+			if server.Name != tt.wantServerAs {
+				t.Errorf("server span name = %q, want %q", server.Name, tt.wantServerAs)
+			}
+			if server.SpanKind != trace.SpanKindServer {
+				t.Errorf("server span kind = %v, want server", server.SpanKind)
+			}
+			if child.Parent.SpanID() != server.SpanContext.SpanID() {
+				t.Errorf("child parent = %s, want server span %s", child.Parent.SpanID(), server.SpanContext.SpanID())
+			}
+			if child.SpanContext.TraceID() != server.SpanContext.TraceID() {
+				t.Errorf("child trace = %s, want %s", child.SpanContext.TraceID(), server.SpanContext.TraceID())
+			}
+
+			if tt.wantRemote {
+				if got := server.SpanContext.TraceID().String(); got != traceID {
+					t.Errorf("server trace = %s, want %s", got, traceID)
+				}
+				if got := server.Parent.SpanID().String(); got != spanID || !server.Parent.IsRemote() {
+					t.Errorf("server parent = %s (remote %v), want remote %s", got, server.Parent.IsRemote(), spanID)
+				}
+				return
+			}
+
+			if server.Parent.IsValid() {
+				t.Errorf("server span must be a root span, got parent %s", server.Parent.SpanID())
+			}
+			if server.SpanContext.TraceID().String() == traceID {
+				t.Errorf("server span must not reuse the trace id of an invalid traceparent")
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+// Tipe event review artikel
+const (
+	EventReviewRequested        = "review.requested"
+	EventReviewApproved         = "review.approved"
+	EventReviewChangesRequested = "review.changes_requested"
+	EventArticleApproved        = "article.approved"
+)
+
+type Event struct {
+	Type         string
+	ArticleID    uint
+	ArticleTitle string
+	ReviewID     uint
+	Recipient    string // reviewer atau author yang perlu diberi tahu
+	Actor        string
+	Comment      string
+	OccurredAt   time.Time
+}
+
+// Notifier abstraksi pengiriman notifikasi, implementasi bisa log, email, chat, dsb
+type Notifier interface {
+	Notify(ctx context.Context, event Event) error
+}
+
+const (
+	DriverLog  = "log"
+	DriverNone = "none"
+)
+
+// NewNotifier pilih driver dari NOTIFIER_DRIVERS (dipisah koma), default log
+func NewNotifier(config *viper.Viper, log *zap.Logger) (Notifier, error) {
+	drivers := config.GetString("NOTIFIER_DRIVERS")
+	if drivers == "" {
+		drivers = DriverLog
+	}
+
+	var notifiers Multi
+	for _, driver := range strings.Split(drivers, ",") {
+		switch strings.TrimSpace(driver) {
+		case DriverLog:
+			notifiers = append(notifiers, NewLogNotifier(log))
+		case DriverNone, "":
+			continue
+		default:
+			return nil, fmt.Errorf("unsupported notifier driver: %s", driver)
+		}
+	}
+
+	return notifiers, nil
+}
+
+// Multi kirim event ke semua notifier, error dikumpulkan supaya satu driver gagal tidak menghentikan yang lain
+type Multi []Notifier
+
+func (m Multi) Notify(ctx context.Context, event Event) error {
+	var errs []error
+	for _, n := range m {
+		if err := n.Notify(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// LogNotifier tulis event ke log, cocok untuk development
+type LogNotifier struct {
+	log *zap.Logger
+}
+
+func NewLogNotifier(log *zap.Logger) *LogNotifier {
+	return &LogNotifier{log: log}
+}
+
+func (n *LogNotifier) Notify(ctx context.Context, event Event) error {
+	n.log.Info("notification",
+		zap.String("event", event.Type),
+		zap.Uint("article_id", event.ArticleID),
+		zap.String("article_title", event.ArticleTitle),
+		zap.Uint("review_id", event.ReviewID),
+		zap.String("recipient", event.Recipient),
+		zap.String("actor", event.Actor),
+		zap.String("comment", event.Comment),
+		zap.Time("occurred_at", event.OccurredAt),
+	)
+
+	return nil
+}
@@ -0,0 +1,491 @@
+package presence
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/tenant"
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+// Buffer message per client, client yang tertinggal lebih dari ini diputus
+const clientBuffer = 32
+
+// Client satu koneksi websocket yang terdaftar di hub node ini
+type Client struct {
+	Room      string
+	ArticleID uint
+
+	mu      sync.Mutex
+	member  Member
+	editing bool // hanya untuk deteksi perubahan, mode di presence di ambil dari lock
+
+	send chan Message
+	once sync.Once
+}
+
+func (c *Client) SessionID() string {
+	return c.member.SessionID
+}
+
+// Messages channel message untuk client, ditutup saat client diputus hub
+func (c *Client) Messages() <-chan Message {
+	return c.send
+}
+
+func (c *Client) snapshot() Member {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.member
+}
+
+// setEditing return true kalau status berubah
+func (c *Client) setEditing(editing bool) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.editing == editing {
+		return false
+	}
+	c.editing = editing
+	return true
+}
+
+func (c *Client) touch() Member {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.member.SeenAt = time.Now()
+	return c.member
+}
+
+func (c *Client) disconnect() {
+	c.once.Do(func() { close(c.send) })
+}
+
+// Hub kelola client lokal per room (tenant + artikel), state bersama ada di Backend
+type Hub struct {
+	backend   Backend
+	lockTTL   time.Duration
+	memberTTL time.Duration
+	log       *zap.Logger
+
+	mu     sync.Mutex
+	rooms  map[string]map[string]*Client
+	locks  map[string]Lock // lock terakhir yang diketahui per room lokal, untuk deteksi expired
+	closed bool
+
+	unsubscribe func()
+	stop        chan struct{}
+	done        chan struct{}
+}
+
+func NewHub(backend Backend, config *viper.Viper, log *zap.Logger) (*Hub, error) {
+	lockTTL := config.GetDuration("PRESENCE_LOCK_TTL")
+	if lockTTL <= 0 {
+		lockTTL = defaultLockTTL
+	}
+
+	memberTTL := config.GetDuration("PRESENCE_MEMBER_TTL")
+	if memberTTL <= 0 {
+		memberTTL = defaultMemberTTL
+	}
+
+	h := &Hub{
+		backend:   backend,
+		lockTTL:   lockTTL,
+		memberTTL: memberTTL,
+		log:       log,
+		rooms:     make(map[string]map[string]*Client),
+		locks:     make(map[string]Lock),
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+
+	unsubscribe, err := backend.Subscribe(h.deliver)
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe presence backend: %w", err)
+	}
+	h.unsubscribe = unsubscribe
+
+	go h.run()
+
+	return h, nil
+}
+
+// Room key room untuk artikel, artikel dengan id sama di tenant lain tidak saling melihat
+func Room(tenantID string, articleID uint) string {
+	return fmt.Sprintf("%s:%d", tenantID, articleID)
+}
+
+// Join daftarkan koneksi baru lalu kirim member dan lock saat ini ke seluruh room
+func (h *Hub) Join(ctx context.Context, tenantID string, articleID uint, user string) (*Client, error) {
+	now := time.Now()
+	client := &Client{
+		Room:      Room(tenantID, articleID),
+		ArticleID: articleID,
+		member: Member{
+			SessionID: uuid.NewString(),
+			User:      user,
+			Mode:      ModeViewing,
+			JoinedAt:  now,
+			SeenAt:    now,
+		},
+		send: make(chan Message, clientBuffer),
+	}
+
+	h.mu.Lock()
+	if h.closed {
+		h.mu.Unlock()
+		client.disconnect()
+		return client, nil
+	}
+	if h.rooms[client.Room] == nil {
+		h.rooms[client.Room] = make(map[string]*Client)
+	}
+	h.rooms[client.Room][client.SessionID()] = client
+	h.mu.Unlock()
+
+	if err := h.backend.Join(ctx, client.Room, client.snapshot()); err != nil {
+		h.remove(client)
+		return nil, err
+	}
+
+	// Lock saat ini hanya untuk client baru, member lain cukup dapat update presence
+	lock, err := h.backend.CurrentLock(ctx, client.Room)
+	if err != nil {
+		h.log.Warn("presence: failed to get current lock", zap.String("room", client.Room), zap.Error(err))
+	}
+	h.rememberLock(client.Room, lock)
+	h.send(client, Message{Type: MessageLock, ArticleID: articleID, Lock: lock})
+
+	h.publishPresence(ctx, client.Room, articleID)
+
+	return client, nil
+}
+
+// Leave lepas client dari room, lock yang masih dipegang ikut dilepas
+func (h *Hub) Leave(ctx context.Context, client *Client) {
+	h.remove(client)
+	client.disconnect()
+
+	if err := h.backend.Leave(ctx, client.Room, client.SessionID()); err != nil {
+		h.log.Warn("presence: failed to leave room", zap.String("room", client.Room), zap.Error(err))
+	}
+
+	// Presence di publish sekali di bawah, release cukup mengirim lock yang dilepas
+	client.setEditing(false)
+	h.release(ctx, client)
+	h.publishPresence(ctx, client.Room, client.ArticleID)
+}
+
+// Handle proses message dari client
+func (h *Hub) Handle(ctx context.Context, client *Client, messageType string) {
+	switch messageType {
+	case ClientEdit:
+		h.edit(ctx, client)
+	case ClientRelease:
+		h.release(ctx, client)
+	case ClientPing:
+		if err := h.backend.Join(ctx, client.Room, client.touch()); err != nil {
+			h.log.Warn("presence: failed to refresh member", zap.String("room", client.Room), zap.Error(err))
+		}
+	default:
+		h.send(client, Message{Type: MessageError, Error: "unknown message type"})
+	}
+}
+
+// ArticleChanged beri tahu semua yang membuka artikel bahwa artikel sudah di update
+func (h *Hub) ArticleChanged(ctx context.Context, articleID uint, actor string, updatedAt time.Time) {
+	tenantID, _ := tenant.FromContext(ctx)
+	message := Message{
+		Type:      MessageArticleChanged,
+		ArticleID: articleID,
+		Actor:     actor,
+		UpdatedAt: &updatedAt,
+	}
+
+	if err := h.backend.Publish(ctx, Room(tenantID, articleID), message); err != nil {
+		h.log.Warn("presence: failed to publish article changed", zap.Uint("article_id", articleID), zap.Error(err))
+	}
+}
+
+// Close putus semua client dan berhenti menerima message dari backend
+func (h *Hub) Close() error {
+	h.mu.Lock()
+	if h.closed {
+		h.mu.Unlock()
+		return nil
+	}
+	h.closed = true
+
+	for _, clients := range h.rooms {
+		for _, client := range clients {
+			client.disconnect()
+		}
+	}
+	h.mu.Unlock()
+
+	close(h.stop)
+	<-h.done
+	h.unsubscribe()
+
+	if closer, ok := h.backend.(io.Closer); ok {
+		return closer.Close()
+	}
+
+	return nil
+}
+
+func (h *Hub) edit(ctx context.Context, client *Client) {
+	member := client.snapshot()
+	lock, ok, err := h.backend.AcquireLock(ctx, client.Room, Lock{
+		SessionID: member.SessionID,
+		User:      member.User,
+		ExpiresAt: time.Now().Add(h.lockTTL),
+	})
+	if err != nil {
+		h.log.Warn("presence: failed to acquire lock", zap.String("room", client.Room), zap.Error(err))
+		h.send(client, Message{Type: MessageError, Error: "failed to acquire lock"})
+		return
+	}
+
+	if !ok {
+		h.send(client, Message{Type: MessageLockDenied, ArticleID: client.ArticleID, Lock: lock})
+		return
+	}
+
+	h.publish(ctx, client.Room, Message{Type: MessageLock, ArticleID: client.ArticleID, Lock: lock})
+
+	if client.setEditing(true) {
+		h.publishPresence(ctx, client.Room, client.ArticleID)
+	}
+}
+
+func (h *Hub) release(ctx context.Context, client *Client) {
+	released, err := h.backend.ReleaseLock(ctx, client.Room, client.SessionID())
+	if err != nil {
+		h.log.Warn("presence: failed to release lock", zap.String("room", client.Room), zap.Error(err))
+		return
+	}
+
+	if released {
+		h.publish(ctx, client.Room, Message{Type: MessageLock, ArticleID: client.ArticleID})
+	}
+
+	if client.setEditing(false) {
+		h.publishPresence(ctx, client.Room, client.ArticleID)
+	}
+}
+
+func (h *Hub) publishPresence(ctx context.Context, room string, articleID uint) {
+	if message, ok := h.presenceMessage(ctx, room, articleID); ok {
+		h.publish(ctx, room, message)
+	}
+}
+
+// presenceMessage daftar member room, mode editing di ambil dari pemegang lock saat ini
+// sehingga lock yang expired otomatis kembali ke viewing
+func (h *Hub) presenceMessage(ctx context.Context, room string, articleID uint) (Message, bool) {
+	members, err := h.backend.Members(ctx, room)
+	if err != nil {
+		h.log.Warn("presence: failed to get members", zap.String("room", room), zap.Error(err))
+		return Message{}, false
+	}
+
+	lock, err := h.backend.CurrentLock(ctx, room)
+	if err != nil {
+		h.log.Warn("presence: failed to get current lock", zap.String("room", room), zap.Error(err))
+	}
+
+	for i := range members {
+		members[i].Mode = ModeViewing
+		if lock != nil && lock.SessionID == members[i].SessionID {
+			members[i].Mode = ModeEditing
+		}
+	}
+
+	return Message{Type: MessagePresence, ArticleID: articleID, Members: members}, true
+}
+
+func (h *Hub) publish(ctx context.Context, room string, message Message) {
+	if err := h.backend.Publish(ctx, room, message); err != nil {
+		h.log.Warn("presence: failed to publish message", zap.String("room", room), zap.String("type", message.Type), zap.Error(err))
+	}
+}
+
+// deliver terima message dari backend dan teruskan ke client lokal di room tersebut
+func (h *Hub) deliver(room string, message Message) {
+	if message.Type == MessageLock {
+		h.rememberLock(room, message.Lock)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sessionID, client := range h.rooms[room] {
+		if message.SessionID != "" && message.SessionID != sessionID {
+			continue
+		}
+		h.sendLocked(client, message)
+	}
+}
+
+func (h *Hub) send(client *Client, message Message) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.sendLocked(client, message)
+}
+
+func (h *Hub) sendLocked(client *Client, message Message) {
+	if _, ok := h.rooms[client.Room][client.SessionID()]; !ok {
+		return
+	}
+
+	select {
+	case client.send <- message:
+	default:
+		// Client terlalu lambat, putus supaya reconnect dan dapat state terbaru
+		h.log.Warn("presence: client too slow, disconnecting", zap.String("room", client.Room))
+		h.removeLocked(client)
+		client.disconnect()
+	}
+}
+
+func (h *Hub) remove(client *Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.removeLocked(client)
+}
+
+func (h *Hub) removeLocked(client *Client) {
+	clients := h.rooms[client.Room]
+	if clients == nil {
+		return
+	}
+
+	delete(clients, client.SessionID())
+	if len(clients) == 0 {
+		delete(h.rooms, client.Room)
+		delete(h.locks, client.Room)
+	}
+}
+
+func (h *Hub) rememberLock(room string, lock *Lock) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.rooms[room]; !ok {
+		return
+	}
+
+	if lock == nil {
+		delete(h.locks, room)
+		return
+	}
+	h.locks[room] = *lock
+}
+
+// run cek lock yang expired dan perpanjang member lokal di backend secara berkala
+func (h *Hub) run() {
+	defer close(h.done)
+
+	lockTicker := time.NewTicker(time.Second)
+	defer lockTicker.Stop()
+
+	memberTicker := time.NewTicker(h.memberTTL / 3)
+	defer memberTicker.Stop()
+
+	for {
+		select {
+		case <-h.stop:
+			return
+		case <-lockTicker.C:
+			h.expireLocks()
+		case <-memberTicker.C:
+			h.refreshMembers()
+		}
+	}
+}
+
+// expireLocks kabari client lokal saat lock kadaluarsa tanpa di release, setiap node cek room miliknya sendiri
+func (h *Hub) expireLocks() {
+	now := time.Now()
+
+	h.mu.Lock()
+	expired := make(map[string]Lock)
+	for room, lock := range h.locks {
+		if !now.Before(lock.ExpiresAt) {
+			expired[room] = lock
+		}
+	}
+	h.mu.Unlock()
+
+	for room, previous := range expired {
+		h.expireLock(room, previous)
+	}
+}
+
+func (h *Hub) expireLock(room string, previous Lock) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	lock, err := h.backend.CurrentLock(ctx, room)
+	if err != nil {
+		h.log.Warn("presence: failed to check lock", zap.String("room", room), zap.Error(err))
+		return
+	}
+	h.rememberLock(room, lock)
+
+	h.mu.Lock()
+	var articleID uint
+	for sessionID, client := range h.rooms[room] {
+		articleID = client.ArticleID
+		if sessionID == previous.SessionID && (lock == nil || lock.SessionID != sessionID) {
+			client.setEditing(false)
+		}
+	}
+	h.mu.Unlock()
+
+	h.deliverLocal(room, Message{Type: MessageLock, ArticleID: articleID, Lock: lock})
+	if message, ok := h.presenceMessage(ctx, room, articleID); ok {
+		h.deliverLocal(room, message)
+	}
+}
+
+func (h *Hub) deliverLocal(room string, message Message) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, client := range h.rooms[room] {
+		h.sendLocked(client, message)
+	}
+}
+
+// refreshMembers perpanjang seen_at member lokal supaya tidak dianggap stale oleh node lain
+func (h *Hub) refreshMembers() {
+	h.mu.Lock()
+	var clients []*Client
+	for _, room := range h.rooms {
+		for _, client := range room {
+			clients = append(clients, client)
+		}
+	}
+	h.mu.Unlock()
+
+	for _, client := range clients {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		if err := h.backend.Join(ctx, client.Room, client.touch()); err != nil {
+			h.log.Warn("presence: failed to refresh member", zap.String("room", client.Room), zap.Error(err))
+		}
+		cancel()
+	}
+}
@@ -0,0 +1,364 @@
+package presence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/tenant"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+func newTestHub(t *testing.T, lockTTL time.Duration) *Hub {
+	t.Helper()
+
+	config := viper.New()
+	config.Set("PRESENCE_LOCK_TTL", lockTTL)
+
+	hub, err := NewHub(NewMemoryBackend(), config, zap.NewNop())
+	if err != nil {
+		t.Fatalf("NewHub() error = %v", err)
+	}
+	t.Cleanup(func() { hub.Close() })
+
+	return hub
+}
+
+func join(t *testing.T, hub *Hub, tenantID string, articleID uint, user string) *Client {
+	t.Helper()
+
+	client, err := hub.Join(context.Background(), tenantID, articleID, user)
+	if err != nil {
+		t.Fatalf("Join() error = %v", err)
+	}
+	return client
+}
+
+// next baca message berikutnya, ok false kalau channel sudah ditutup hub
+func next(t *testing.T, client *Client) (Message, bool) {
+	t.Helper()
+
+	select {
+	case message, ok := <-client.Messages():
+		return message, ok
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for presence message")
+		return Message{}, false
+	}
+}
+
+func expect(t *testing.T, client *Client, messageType string) Message {
+	t.Helper()
+
+	message, ok := next(t, client)
+	if !ok {
+		t.Fatalf("channel closed, want %s message", messageType)
+	}
+	if message.Type != messageType {
+		t.Fatalf("message = %+v, want type %s", message, messageType)
+	}
+	return message
+}
+
+func expectNothing(t *testing.T, client *Client) {
+	t.Helper()
+
+	select {
+	case message, ok := <-client.Messages():
+		t.Fatalf("unexpected message %+v (open %v)", message, ok)
+	default:
+	}
+}
+
+// users daftar user dan mode di message presence, urut sesuai waktu join
+func users(message Message) []string {
+	var users []string
+	for _, member := range message.Members {
+		users = append(users, member.User+":"+member.Mode)
+	}
+	return users
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestJoinBroadcastsPresence(t *testing.T) {
+	hub := newTestHub(t, time.Minute)
+
+	alice := join(t, hub, "brand-a", 1, "alice")
+	if lock := expect(t, alice, MessageLock); lock.Lock != nil || lock.ArticleID != 1 {
+		t.Fatalf("initial lock = %+v, want no lock for article 1", lock)
+	}
+	if got := users(expect(t, alice, MessagePresence)); !equal(got, []string{"alice:viewing"}) {
+		t.Fatalf("presence = %v", got)
+	}
+
+	bob := join(t, hub, "brand-a", 1, "bob")
+	expect(t, bob, MessageLock)
+	want := []string{"alice:viewing", "bob:viewing"}
+	if got := users(expect(t, bob, MessagePresence)); !equal(got, want) {
+		t.Fatalf("bob presence = %v, want %v", got, want)
+	}
+	if got := users(expect(t, alice, MessagePresence)); !equal(got, want) {
+		t.Fatalf("alice presence = %v, want %v", got, want)
+	}
+
+	// Artikel dengan ID sama di tenant lain dan artikel lain di tenant sama adalah room terpisah
+	carol := join(t, hub, "brand-b", 1, "carol")
+	dave := join(t, hub, "brand-a", 2, "dave")
+	expect(t, carol, MessageLock)
+	if got := users(expect(t, carol, MessagePresence)); !equal(got, []string{"carol:viewing"}) {
+		t.Fatalf("carol presence = %v", got)
+	}
+	expect(t, dave, MessageLock)
+	if got := users(expect(t, dave, MessagePresence)); !equal(got, []string{"dave:viewing"}) {
+		t.Fatalf("dave presence = %v", got)
+	}
+	expectNothing(t, alice)
+	expectNothing(t, bob)
+}
+
+func TestLeave(t *testing.T) {
+	tests := []struct {
+		name        string
+		holdingLock bool
+	}{
+		{name: "viewer leaves"},
+		{name: "editor leaves and releases the lock", holdingLock: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hub := newTestHub(t, time.Minute)
+			ctx := context.Background()
+
+			alice := join(t, hub, "brand-a", 1, "alice")
+			bob := join(t, hub, "brand-a", 1, "bob")
+			expect(t, alice, MessageLock)
+			expect(t, alice, MessagePresence)
+			expect(t, alice, MessagePresence)
+			expect(t, bob, MessageLock)
+			expect(t, bob, MessagePresence)
+
+			if tt.holdingLock {
+				hub.Handle(ctx, alice, ClientEdit)
+				expect(t, bob, MessageLock)
+				expect(t, bob, MessagePresence)
+			}
+
+			hub.Leave(ctx, alice)
+			hub.Leave(ctx, alice)
+
+			if tt.holdingLock {
+				if lock := expect(t, bob, MessageLock); lock.Lock != nil {
+					t.Fatalf("lock after leave = %+v, want released", lock.Lock)
+				}
+			}
+			if got := users(expect(t, bob, MessagePresence)); !equal(got, []string{"bob:viewing"}) {
+				t.Fatalf("presence after leave = %v", got)
+			}
+
+			// Channel client yang keluar ditutup setelah message yang tersisa
+			for {
+				if _, ok := next(t, alice); !ok {
+					break
+				}
+			}
+
+			hub.Leave(ctx, bob)
+			hub.mu.Lock()
+			rooms := len(hub.rooms)
+			hub.mu.Unlock()
+			if rooms != 0 {
+				t.Fatalf("rooms = %d after everyone left, want 0", rooms)
+			}
+		})
+	}
+}
+
+func TestSoftLock(t *testing.T) {
+	hub := newTestHub(t, time.Minute)
+	ctx := context.Background()
+
+	alice := join(t, hub, "brand-a", 1, "alice")
+	bob := join(t, hub, "brand-a", 1, "bob")
+	for _, client := range []*Client{alice, bob} {
+		for len(client.Messages()) > 0 {
+			<-client.Messages()
+		}
+	}
+
+	hub.Handle(ctx, alice, ClientEdit)
+	for _, client := range []*Client{alice, bob} {
+		if lock := expect(t, client, MessageLock); lock.Lock == nil || lock.Lock.User != "alice" {
+			t.Fatalf("lock = %+v, want held by alice", lock.Lock)
+		}
+		if got := users(expect(t, client, MessagePresence)); !equal(got, []string{"alice:editing", "bob:viewing"}) {
+			t.Fatalf("presence = %v", got)
+		}
+	}
+
+	// Perpanjang lock tidak mengirim presence lagi karena mode tidak berubah
+	hub.Handle(ctx, alice, ClientEdit)
+	expect(t, alice, MessageLock)
+	expect(t, bob, MessageLock)
+	expectNothing(t, alice)
+
+	// Session lain ditolak, hanya peminta yang diberi tahu
+	hub.Handle(ctx, bob, ClientEdit)
+	if denied := expect(t, bob, MessageLockDenied); denied.Lock == nil || denied.Lock.User != "alice" {
+		t.Fatalf("lock_denied = %+v, want current lock of alice", denied)
+	}
+	expectNothing(t, alice)
+
+	// Release oleh yang bukan pemegang lock tidak berpengaruh
+	hub.Handle(ctx, bob, ClientRelease)
+	expectNothing(t, alice)
+	expectNothing(t, bob)
+
+	hub.Handle(ctx, alice, ClientRelease)
+	for _, client := range []*Client{alice, bob} {
+		if lock := expect(t, client, MessageLock); lock.Lock != nil {
+			t.Fatalf("lock after release = %+v", lock.Lock)
+		}
+		if got := users(expect(t, client, MessagePresence)); !equal(got, []string{"alice:viewing", "bob:viewing"}) {
+			t.Fatalf("presence after release = %v", got)
+		}
+	}
+
+	hub.Handle(ctx, bob, ClientEdit)
+	if lock := expect(t, alice, MessageLock); lock.Lock == nil || lock.Lock.User != "bob" {
+		t.Fatalf("lock = %+v, want held by bob", lock.Lock)
+	}
+
+	if got := users(expect(t, alice, MessagePresence)); !equal(got, []string{"alice:viewing", "bob:editing"}) {
+		t.Fatalf("presence = %v", got)
+	}
+
+	hub.Handle(ctx, alice, "shout")
+	if got := expect(t, alice, MessageError); got.Error != "unknown message type" {
+		t.Fatalf("error = %+v", got)
+	}
+}
+
+func TestLockExpiry(t *testing.T) {
+	hub := newTestHub(t, 20*time.Millisecond)
+	ctx := context.Background()
+
+	alice := join(t, hub, "brand-a", 1, "alice")
+	bob := join(t, hub, "brand-a", 1, "bob")
+	hub.Handle(ctx, alice, ClientEdit)
+
+	time.Sleep(30 * time.Millisecond)
+	hub.expireLocks()
+
+	// Lock yang expired tanpa release dikabarkan ke room dan pemegangnya kembali viewing
+	for _, client := range []*Client{alice, bob} {
+		var lock, presence *Message
+		for lock == nil || presence == nil || lock.Lock != nil || !equal(users(*presence), []string{"alice:viewing", "bob:viewing"}) {
+			m, ok := next(t, client)
+			if !ok {
+				t.Fatal("channel closed")
+			}
+			switch m.Type {
+			case MessageLock:
+				lock = &m
+			case MessagePresence:
+				presence = &m
+			}
+		}
+	}
+
+	if alice.setEditing(false) {
+		t.Fatal("expired lock holder must no longer be marked as editing")
+	}
+}
+
+func TestSlowClientIsDisconnected(t *testing.T) {
+	hub := newTestHub(t, time.Minute)
+	ctx := tenant.WithTenant(context.Background(), "brand-a")
+
+	slow := join(t, hub, "brand-a", 1, "slow")
+	fast := join(t, hub, "brand-a", 1, "fast")
+
+	// Client cepat membaca setiap message sebelum message berikutnya di kirim
+	changes := make(chan struct{})
+	go func() {
+		for message := range fast.Messages() {
+			if message.Type == MessageArticleChanged {
+				changes <- struct{}{}
+			}
+		}
+	}()
+
+	for i := 0; i < clientBuffer; i++ {
+		hub.ArticleChanged(ctx, 1, "editor", time.Now())
+
+		select {
+		case <-changes:
+		case <-time.After(time.Second):
+			t.Fatalf("fast client did not receive change %d", i)
+		}
+	}
+
+	// Client lambat menerima isi buffernya lalu channel ditutup supaya reconnect dengan state terbaru
+	received := 0
+	for {
+		if _, ok := next(t, slow); !ok {
+			break
+		}
+		received++
+	}
+	if received != clientBuffer {
+		t.Fatalf("slow client received %d messages before disconnect, want %d", received, clientBuffer)
+	}
+
+	hub.mu.Lock()
+	_, stillJoined := hub.rooms[slow.Room][slow.SessionID()]
+	_, fastJoined := hub.rooms[fast.Room][fast.SessionID()]
+	hub.mu.Unlock()
+	if stillJoined || !fastJoined {
+		t.Fatalf("slow joined = %v, fast joined = %v, want only fast", stillJoined, fastJoined)
+	}
+
+	// Handler tetap memanggil Leave untuk client yang diputus, member di backend ikut dihapus
+	hub.Leave(context.Background(), slow)
+	members, _ := hub.backend.Members(ctx, slow.Room)
+	if len(members) != 1 || members[0].User != "fast" {
+		t.Fatalf("members = %+v, want only fast", members)
+	}
+}
+
+func TestClose(t *testing.T) {
+	hub := newTestHub(t, time.Minute)
+
+	client := join(t, hub, "brand-a", 1, "alice")
+	if err := hub.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if err := hub.Close(); err != nil {
+		t.Fatalf("second Close() error = %v", err)
+	}
+
+	for {
+		if _, ok := next(t, client); !ok {
+			break
+		}
+	}
+
+	// Join setelah Close langsung mendapat client yang sudah diputus
+	late := join(t, hub, "brand-a", 1, "bob")
+	if _, ok := next(t, late); ok {
+		t.Fatal("client joined after Close must be disconnected")
+	}
+}
@@ -0,0 +1,143 @@
+package presence
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MemoryBackend state di memory proses, cukup untuk satu node
+type MemoryBackend struct {
+	mu       sync.Mutex
+	members  map[string]map[string]Member
+	locks    map[string]Lock
+	handlers map[int]func(room string, message Message)
+	nextID   int
+}
+
+func NewMemoryBackend() *MemoryBackend {
+	return &MemoryBackend{
+		members:  make(map[string]map[string]Member),
+		locks:    make(map[string]Lock),
+		handlers: make(map[int]func(room string, message Message)),
+	}
+}
+
+func (b *MemoryBackend) Join(ctx context.Context, room string, member Member) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.members[room] == nil {
+		b.members[room] = make(map[string]Member)
+	}
+	b.members[room][member.SessionID] = member
+
+	return nil
+}
+
+func (b *MemoryBackend) Leave(ctx context.Context, room, sessionID string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.members[room], sessionID)
+	if len(b.members[room]) == 0 {
+		delete(b.members, room)
+	}
+
+	return nil
+}
+
+func (b *MemoryBackend) Members(ctx context.Context, room string) ([]Member, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	members := make([]Member, 0, len(b.members[room]))
+	for _, member := range b.members[room] {
+		members = append(members, member)
+	}
+	sortMembers(members)
+
+	return members, nil
+}
+
+func (b *MemoryBackend) AcquireLock(ctx context.Context, room string, lock Lock) (*Lock, bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if current, ok := b.locks[room]; ok && current.SessionID != lock.SessionID && time.Now().Before(current.ExpiresAt) {
+		return &current, false, nil
+	}
+
+	b.locks[room] = lock
+	return &lock, true, nil
+}
+
+func (b *MemoryBackend) ReleaseLock(ctx context.Context, room, sessionID string) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if current, ok := b.locks[room]; ok && current.SessionID == sessionID {
+		delete(b.locks, room)
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (b *MemoryBackend) CurrentLock(ctx context.Context, room string) (*Lock, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	current, ok := b.locks[room]
+	if !ok {
+		return nil, nil
+	}
+
+	if !time.Now().Before(current.ExpiresAt) {
+		delete(b.locks, room)
+		return nil, nil
+	}
+
+	return &current, nil
+}
+
+func (b *MemoryBackend) Publish(ctx context.Context, room string, message Message) error {
+	b.mu.Lock()
+	handlers := make([]func(string, Message), 0, len(b.handlers))
+	for _, handler := range b.handlers {
+		handlers = append(handlers, handler)
+	}
+	b.mu.Unlock()
+
+	for _, handler := range handlers {
+		handler(room, message)
+	}
+
+	return nil
+}
+
+func (b *MemoryBackend) Subscribe(handler func(room string, message Message)) (func(), error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := b.nextID
+	b.nextID++
+	b.handlers[id] = handler
+
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.handlers, id)
+	}, nil
+}
+
+// sortMembers urutkan berdasarkan waktu join supaya urutan di UI stabil
+func sortMembers(members []Member) {
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].JoinedAt.Equal(members[j].JoinedAt) {
+			return members[i].SessionID < members[j].SessionID
+		}
+		return members[i].JoinedAt.Before(members[j].JoinedAt)
+	})
+}
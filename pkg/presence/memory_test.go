@@ -0,0 +1,105 @@
+package presence
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemoryBackendLock(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		current   *Lock
+		acquire   Lock
+		wantOK    bool
+		wantOwner string
+	}{
+		{name: "free", acquire: Lock{SessionID: "s1", ExpiresAt: now.Add(time.Minute)}, wantOK: true, wantOwner: "s1"},
+		{
+			name:      "extend own lock",
+			current:   &Lock{SessionID: "s1", ExpiresAt: now.Add(time.Second)},
+			acquire:   Lock{SessionID: "s1", ExpiresAt: now.Add(time.Minute)},
+			wantOK:    true,
+			wantOwner: "s1",
+		},
+		{
+			name:      "held by other session",
+			current:   &Lock{SessionID: "s1", ExpiresAt: now.Add(time.Minute)},
+			acquire:   Lock{SessionID: "s2", ExpiresAt: now.Add(time.Minute)},
+			wantOwner: "s1",
+		},
+		{
+			name:      "expired lock of other session",
+			current:   &Lock{SessionID: "s1", ExpiresAt: now.Add(-time.Second)},
+			acquire:   Lock{SessionID: "s2", ExpiresAt: now.Add(time.Minute)},
+			wantOK:    true,
+			wantOwner: "s2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backend := NewMemoryBackend()
+			if tt.current != nil {
+				backend.locks["room"] = *tt.current
+			}
+
+			lock, ok, err := backend.AcquireLock(ctx, "room", tt.acquire)
+			if err != nil {
+				t.Fatalf("AcquireLock() error = %v", err)
+			}
+			if ok != tt.wantOK || lock.SessionID != tt.wantOwner {
+				t.Fatalf("AcquireLock() = %+v, %v, want owner %s ok %v", lock, ok, tt.wantOwner, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestMemoryBackendReleaseAndExpiry(t *testing.T) {
+	ctx := context.Background()
+	backend := NewMemoryBackend()
+	backend.AcquireLock(ctx, "room", Lock{SessionID: "s1", ExpiresAt: time.Now().Add(time.Minute)})
+
+	if released, _ := backend.ReleaseLock(ctx, "room", "s2"); released {
+		t.Fatal("ReleaseLock() by other session must not release")
+	}
+	if released, _ := backend.ReleaseLock(ctx, "room", "s1"); !released {
+		t.Fatal("ReleaseLock() by owner must release")
+	}
+	if lock, _ := backend.CurrentLock(ctx, "room"); lock != nil {
+		t.Fatalf("CurrentLock() = %+v after release", lock)
+	}
+
+	backend.AcquireLock(ctx, "room", Lock{SessionID: "s1", ExpiresAt: time.Now().Add(-time.Millisecond)})
+	if lock, _ := backend.CurrentLock(ctx, "room"); lock != nil {
+		t.Fatalf("CurrentLock() = %+v, want expired lock hidden", lock)
+	}
+}
+
+func TestMemoryBackendMembersOrder(t *testing.T) {
+	ctx := context.Background()
+	backend := NewMemoryBackend()
+	joined := time.Now()
+
+	backend.Join(ctx, "room", Member{SessionID: "c", User: "carol", JoinedAt: joined.Add(time.Second)})
+	backend.Join(ctx, "room", Member{SessionID: "b", User: "bob", JoinedAt: joined})
+	backend.Join(ctx, "room", Member{SessionID: "a", User: "alice", JoinedAt: joined})
+	backend.Join(ctx, "other", Member{SessionID: "d", User: "dave", JoinedAt: joined})
+
+	members, _ := backend.Members(ctx, "room")
+	var got []string
+	for _, member := range members {
+		got = append(got, member.User)
+	}
+	if want := []string{"alice", "bob", "carol"}; !equal(got, want) {
+		t.Fatalf("members = %v, want %v", got, want)
+	}
+
+	backend.Leave(ctx, "room", "b")
+	if members, _ := backend.Members(ctx, "room"); len(members) != 2 {
+		t.Fatalf("members after leave = %+v", members)
+	}
+}
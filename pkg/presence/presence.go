@@ -0,0 +1,117 @@
+package presence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/database"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+// Mode aktivitas user di sebuah artikel
+const (
+	ModeViewing = "viewing"
+	ModeEditing = "editing"
+)
+
+// Default masa berlaku soft lock dan member tanpa aktivitas
+const (
+	defaultLockTTL   = 60 * time.Second
+	defaultMemberTTL = 90 * time.Second
+)
+
+// Tipe message dari client
+const (
+	ClientEdit    = "edit"    // ambil atau perpanjang soft lock
+	ClientRelease = "release" // lepas soft lock
+	ClientPing    = "ping"    // tanda masih aktif
+)
+
+// Tipe message dari server
+const (
+	MessagePresence       = "presence"
+	MessageLock           = "lock"
+	MessageLockDenied     = "lock_denied"
+	MessageArticleChanged = "article_changed"
+	MessageError          = "error"
+)
+
+// Member satu koneksi (tab) yang sedang membuka artikel
+type Member struct {
+	SessionID string    `json:"session_id"`
+	User      string    `json:"user"`
+	Mode      string    `json:"mode"`
+	JoinedAt  time.Time `json:"joined_at"`
+	SeenAt    time.Time `json:"seen_at"`
+}
+
+// Lock soft lock, hanya penanda siapa yang sedang mengedit. Update artikel tidak di blok
+type Lock struct {
+	SessionID string    `json:"session_id"`
+	User      string    `json:"user"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Message dikirim ke semua client di room yang sama
+type Message struct {
+	Type      string     `json:"type"`
+	Members   []Member   `json:"members,omitempty"`
+	Lock      *Lock      `json:"lock,omitempty"`
+	ArticleID uint       `json:"article_id,omitempty"`
+	Actor     string     `json:"actor,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	Error     string     `json:"error,omitempty"`
+
+	// Kalau di isi, message hanya untuk session ini
+	SessionID string `json:"-"`
+}
+
+// Backend state presence, lock dan fan-out message antar node.
+// Memory untuk single node, Redis untuk beberapa replica
+type Backend interface {
+	Join(ctx context.Context, room string, member Member) error
+	Leave(ctx context.Context, room, sessionID string) error
+	Members(ctx context.Context, room string) ([]Member, error)
+
+	// AcquireLock return lock yang berlaku setelah percobaan, ok false kalau dipegang session lain
+	AcquireLock(ctx context.Context, room string, lock Lock) (current *Lock, ok bool, err error)
+	ReleaseLock(ctx context.Context, room, sessionID string) (bool, error)
+	CurrentLock(ctx context.Context, room string) (*Lock, error)
+
+	Publish(ctx context.Context, room string, message Message) error
+	// Subscribe terima message dari semua room, termasuk yang di publish node ini
+	Subscribe(handler func(room string, message Message)) (unsubscribe func(), err error)
+}
+
+// envelope format message di channel pub/sub
+type envelope struct {
+	Room      string          `json:"room"`
+	SessionID string          `json:"session_id,omitempty"`
+	Message   json.RawMessage `json:"message"`
+}
+
+// NewBackend pilih backend dari PRESENCE_BACKEND (memory | redis)
+func NewBackend(config *viper.Viper, log *zap.Logger) (Backend, error) {
+	switch driver := strings.ToLower(strings.TrimSpace(config.GetString("PRESENCE_BACKEND"))); driver {
+	case "", "memory":
+		return NewMemoryBackend(), nil
+	case "redis":
+		client, err := database.NewRedis(config, log)
+		if err != nil {
+			return nil, err
+		}
+
+		memberTTL := config.GetDuration("PRESENCE_MEMBER_TTL")
+		if memberTTL <= 0 {
+			memberTTL = defaultMemberTTL
+		}
+
+		return NewRedisBackend(client, config.GetString("PRESENCE_REDIS_PREFIX"), memberTTL, log), nil
+	default:
+		return nil, fmt.Errorf("unknown presence backend: %s", driver)
+	}
+}
@@ -0,0 +1,228 @@
+package presence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// Compare-and-set lock: ambil kalau kosong, perpanjang kalau masih milik session yang sama
+var acquireLockScript = redis.NewScript(`
+local current = redis.call("GET", KEYS[1])
+if current then
+	local lock = cjson.decode(current)
+	if lock.session_id ~= ARGV[2] then
+		return current
+	end
+end
+redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[3])
+return ARGV[1]
+`)
+
+// Hapus lock hanya kalau masih milik session tersebut
+var releaseLockScript = redis.NewScript(`
+local current = redis.call("GET", KEYS[1])
+if not current then
+	return 0
+end
+local lock = cjson.decode(current)
+if lock.session_id ~= ARGV[1] then
+	return 0
+end
+redis.call("DEL", KEYS[1])
+return 1
+`)
+
+// RedisBackend state presence di redis supaya bisa dibagi antar replica,
+// message di fan-out lewat satu channel pub/sub
+type RedisBackend struct {
+	client    *redis.Client
+	prefix    string
+	channel   string
+	memberTTL time.Duration
+	log       *zap.Logger
+}
+
+func NewRedisBackend(client *redis.Client, prefix string, memberTTL time.Duration, log *zap.Logger) *RedisBackend {
+	if prefix == "" {
+		prefix = "presence"
+	}
+
+	return &RedisBackend{
+		client:    client,
+		prefix:    prefix,
+		channel:   prefix + ":events",
+		memberTTL: memberTTL,
+		log:       log,
+	}
+}
+
+func (b *RedisBackend) membersKey(room string) string {
+	return b.prefix + ":members:" + room
+}
+
+func (b *RedisBackend) lockKey(room string) string {
+	return b.prefix + ":lock:" + room
+}
+
+func (b *RedisBackend) Join(ctx context.Context, room string, member Member) error {
+	raw, err := json.Marshal(member)
+	if err != nil {
+		return err
+	}
+
+	// Key room ikut di perpanjang, room yang ditinggal semua node (crash) hilang sendiri
+	pipe := b.client.TxPipeline()
+	pipe.HSet(ctx, b.membersKey(room), member.SessionID, raw)
+	pipe.Expire(ctx, b.membersKey(room), b.memberTTL)
+	_, err = pipe.Exec(ctx)
+
+	return err
+}
+
+func (b *RedisBackend) Leave(ctx context.Context, room, sessionID string) error {
+	return b.client.HDel(ctx, b.membersKey(room), sessionID).Err()
+}
+
+func (b *RedisBackend) Members(ctx context.Context, room string) ([]Member, error) {
+	values, err := b.client.HGetAll(ctx, b.membersKey(room)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	// Member yang tidak pernah ping lagi (node mati) di anggap sudah keluar
+	staleBefore := time.Now().Add(-b.memberTTL)
+	members := make([]Member, 0, len(values))
+	var stale []string
+
+	for sessionID, raw := range values {
+		var member Member
+		if err := json.Unmarshal([]byte(raw), &member); err != nil || member.SeenAt.Before(staleBefore) {
+			stale = append(stale, sessionID)
+			continue
+		}
+		members = append(members, member)
+	}
+
+	if len(stale) > 0 {
+		if err := b.client.HDel(ctx, b.membersKey(room), stale...).Err(); err != nil {
+			b.log.Warn("presence: failed to remove stale members", zap.String("room", room), zap.Error(err))
+		}
+	}
+
+	sortMembers(members)
+
+	return members, nil
+}
+
+func (b *RedisBackend) AcquireLock(ctx context.Context, room string, lock Lock) (*Lock, bool, error) {
+	raw, err := json.Marshal(lock)
+	if err != nil {
+		return nil, false, err
+	}
+
+	ttl := time.Until(lock.ExpiresAt).Milliseconds()
+	if ttl <= 0 {
+		ttl = 1
+	}
+
+	result, err := acquireLockScript.Run(ctx, b.client, []string{b.lockKey(room)}, string(raw), lock.SessionID, ttl).Text()
+	if err != nil {
+		return nil, false, err
+	}
+
+	var current Lock
+	if err := json.Unmarshal([]byte(result), &current); err != nil {
+		return nil, false, err
+	}
+
+	return &current, current.SessionID == lock.SessionID, nil
+}
+
+func (b *RedisBackend) ReleaseLock(ctx context.Context, room, sessionID string) (bool, error) {
+	released, err := releaseLockScript.Run(ctx, b.client, []string{b.lockKey(room)}, sessionID).Int()
+	if err != nil {
+		return false, err
+	}
+
+	return released == 1, nil
+}
+
+func (b *RedisBackend) CurrentLock(ctx context.Context, room string) (*Lock, error) {
+	raw, err := b.client.Get(ctx, b.lockKey(room)).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var current Lock
+	if err := json.Unmarshal([]byte(raw), &current); err != nil {
+		return nil, err
+	}
+
+	return &current, nil
+}
+
+func (b *RedisBackend) Publish(ctx context.Context, room string, message Message) error {
+	raw, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(envelope{Room: room, SessionID: message.SessionID, Message: raw})
+	if err != nil {
+		return err
+	}
+
+	return b.client.Publish(ctx, b.channel, payload).Err()
+}
+
+func (b *RedisBackend) Subscribe(handler func(room string, message Message)) (func(), error) {
+	pubsub := b.client.Subscribe(context.Background(), b.channel)
+
+	// Pastikan subscribe sudah aktif sebelum return supaya tidak ada message yang terlewat
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		return nil, err
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		for msg := range pubsub.Channel() {
+			var env envelope
+			if err := json.Unmarshal([]byte(msg.Payload), &env); err != nil {
+				b.log.Warn("presence: invalid pubsub payload", zap.Error(err))
+				continue
+			}
+
+			var message Message
+			if err := json.Unmarshal(env.Message, &message); err != nil {
+				b.log.Warn("presence: invalid pubsub message", zap.Error(err))
+				continue
+			}
+			message.SessionID = env.SessionID
+
+			handler(env.Room, message)
+		}
+	}()
+
+	return func() {
+		pubsub.Close()
+		<-done
+	}, nil
+}
+
+// Close tutup koneksi redis milik backend, dipanggil oleh Hub.Close
+func (b *RedisBackend) Close() error {
+	return b.client.Close()
+}
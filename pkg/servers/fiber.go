@@ -4,10 +4,17 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"os/signal"
+	"strings"
+	"syscall"
 	"time"
 
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/routes"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/response"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/health"
+	middleware "github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/middlewares"
 	"github.com/gofiber/fiber/v2"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
@@ -19,26 +26,57 @@ type FiberServer struct {
 	fiber  *fiber.App
 	config *viper.Viper
 	log    *zap.Logger
+	router *routes.Router
+
+	logLevel      zap.AtomicLevel // di ubah lewat endpoint admin
+	metricsServer *http.Server    // hanya ada kalau METRICS_PORT di isi
+	health        *health.Checker
 
 	DB *gorm.DB
 }
 
-func NewFiberServer(config *viper.Viper, log *zap.Logger, DB *gorm.DB) *FiberServer {
+func NewFiberServer(config *viper.Viper, log *zap.Logger, logLevel zap.AtomicLevel, DB *gorm.DB) *FiberServer {
+	// Body limit dalam MB, dinaikkan untuk upload file import
+	bodyLimitMB := config.GetInt("BODY_LIMIT_MB")
+	if bodyLimitMB <= 0 {
+		bodyLimitMB = 4 // default fiber 4MB
+	}
+
 	app := fiber.New(fiber.Config{
 		AppName:               config.GetString("APP_NAME"),
 		ReadTimeout:           30 * time.Second,
 		WriteTimeout:          30 * time.Second,
+		BodyLimit:             bodyLimitMB * 1024 * 1024,
 		DisableStartupMessage: false,
+		ErrorHandler:          middleware.HandleError,
 	})
 
+	// Type problem+json mengarah ke dokumentasi error kalau di set, default about:blank
+	response.SetProblemTypeBase(config.GetString("PROBLEM_TYPE_BASE_URL"))
+
+	// Pesan error internal hanya ditampilkan di development / local
+	middleware.SetExposeInternalErrors(exposeInternalErrors(config.GetString("APP_ENV")))
+
 	return &FiberServer{
-		fiber:  app,
-		config: config,
-		log:    log,
-		DB:     DB,
+		fiber:    app,
+		config:   config,
+		log:      log,
+		logLevel: logLevel,
+		DB:       DB,
 	}
 }
 
+// exposeInternalErrors hanya true untuk APP_ENV yang eksplisit development atau local,
+// APP_ENV kosong atau salah ketik (misal "prod") diperlakukan seperti production
+func exposeInternalErrors(env string) bool {
+	switch strings.ToLower(strings.TrimSpace(env)) {
+	case "development", "local":
+		return true
+	}
+
+	return false
+}
+
 func (s *FiberServer) Start() error {
 	// Get host and port with fallback
 	host := s.config.GetString("HOST")
@@ -64,10 +102,12 @@ func (s *FiberServer) Start() error {
 	// Fiber native log
 	log.Printf("⚡ Fiber server starting on http://%s", address)
 
-	// Manage server lifecycle
-	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	// Manage server lifecycle, SIGTERM dikirim kubernetes saat pod dihentikan
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
+	s.startMetricsServer()
+
 	// Start server in go routine
 	go func() {
 		if err := s.fiber.Listen(address); err != nil {
@@ -84,6 +124,9 @@ func (s *FiberServer) Start() error {
 	// Wait for shutdown signal
 	<-ctx.Done()
 
+	// Readiness gagal lebih dulu supaya traffic sempat dipindah sebelum server berhenti
+	s.drainHealth()
+
 	// Gracefull shutdown with configrable timeout
 	shutdownTimeout := s.config.GetDuration("TIMEOUT_GRACEFUL_SHUTDOWN")
 	if shutdownTimeout == 0 {
@@ -103,12 +146,27 @@ func (s *FiberServer) Shutdown(ctx context.Context) error {
 	shutdownCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
+	// Koneksi SSE di putus lebih dulu supaya fiber tidak menunggu sampai timeout
+	if s.router != nil {
+		s.router.CloseStreams()
+	}
+
 	if err := s.fiber.ShutdownWithContext(shutdownCtx); err != nil {
 		s.log.Error("Fiber server forced to shutdown", zap.Error(err))
 		log.Fatalf("Server shutdown error: %v", err)
 		return err
 	}
 
+	s.shutdownMetricsServer(shutdownCtx)
+	s.closeHealth()
+
+	// Worker background (outbox relay, dsb) di stop setelah tidak ada request baru
+	if s.router != nil {
+		if err := s.router.Close(shutdownCtx); err != nil {
+			s.log.Error("Failed to stop background workers", zap.Error(err))
+		}
+	}
+
 	s.log.Info("Fiber server exited gracefully")
 	log.Println("Server exited gracefully")
 	return nil
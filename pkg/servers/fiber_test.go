@@ -0,0 +1,26 @@
+package servers
+
+import "testing"
+
+func TestExposeInternalErrors(t *testing.T) {
+	tests := []struct {
+		env  string
+		want bool
+	}{
+		{env: "development", want: true},
+		{env: "local", want: true},
+		{env: " Development ", want: true},
+		{env: "production", want: false},
+		{env: "prod", want: false},
+		{env: "staging", want: false},
+		{env: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.env, func(t *testing.T) {
+			if got := exposeInternalErrors(tt.env); got != tt.want {
+				t.Errorf("exposeInternalErrors(%q) = %v, want %v", tt.env, got, tt.want)
+			}
+		})
+	}
+}
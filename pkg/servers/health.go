@@ -0,0 +1,83 @@
+package servers
+
+import (
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/routes"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/database"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/health"
+	"go.uber.org/zap"
+)
+
+// Default jeda antara readiness gagal dan server berhenti menerima request
+const defaultHealthDrainDelay = 5 * time.Second
+
+// healthTimeout timeout per dependency, HEALTH_<NAME>_TIMEOUT menimpa HEALTH_TIMEOUT
+func (s *FiberServer) healthTimeout(key string) time.Duration {
+	if timeout := s.config.GetDuration(key); timeout > 0 {
+		return timeout
+	}
+
+	return s.config.GetDuration("HEALTH_TIMEOUT")
+}
+
+func (s *FiberServer) healthDrainDelay() time.Duration {
+	if !s.config.IsSet("HEALTH_DRAIN_DELAY") {
+		return defaultHealthDrainDelay
+	}
+
+	return s.config.GetDuration("HEALTH_DRAIN_DELAY")
+}
+
+// setupHealth pasang /healthz dan /readyz. MySQL selalu di cek, Redis dan Elasticsearch hanya kalau di konfigurasi.
+// Harus dipanggil sebelum route lain supaya tidak melewati middleware tenant
+func (s *FiberServer) setupHealth() {
+	checker := health.NewChecker()
+
+	checker.Register("mysql", s.healthTimeout("HEALTH_MYSQL_TIMEOUT"), health.SQLCheck(s.DB))
+
+	if s.config.GetString("REDIS_HOST") != "" {
+		client := database.NewRedisClient(s.config)
+		checker.Register("redis", s.healthTimeout("HEALTH_REDIS_TIMEOUT"), health.RedisCheck(client))
+		checker.OnClose(client.Close)
+	}
+
+	if s.config.GetString("ELASTICSEARCH_URLS") != "" {
+		client, err := database.NewElasticsearchClient(s.config)
+		if err != nil {
+			s.log.Error("Failed to create elasticsearch client for health check", zap.Error(err))
+		} else {
+			checker.Register("elasticsearch", s.healthTimeout("HEALTH_ELASTICSEARCH_TIMEOUT"), health.ElasticsearchCheck(client))
+		}
+	}
+
+	s.health = checker
+	routes.HealthRoutes(s.fiber, checker, s.log)
+}
+
+// drainHealth buat readiness gagal lalu tunggu supaya load balancer sempat melepas pod ini
+func (s *FiberServer) drainHealth() {
+	if s.health == nil {
+		return
+	}
+
+	s.health.MarkShuttingDown()
+
+	delay := s.healthDrainDelay()
+	if delay <= 0 {
+		return
+	}
+
+	s.log.Info("Readiness marked as shutting down, draining traffic", zap.Duration("delay", delay))
+	time.Sleep(delay)
+}
+
+func (s *FiberServer) closeHealth() {
+	if s.health == nil {
+		return
+	}
+
+	if err := s.health.Close(); err != nil {
+		s.log.Error("Failed to close health check clients", zap.Error(err))
+	}
+}
@@ -0,0 +1,76 @@
+package servers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/metrics"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
+	"go.uber.org/zap"
+)
+
+func (s *FiberServer) metricsEnabled() bool {
+	return !s.config.IsSet("METRICS_ENABLED") || s.config.GetBool("METRICS_ENABLED")
+}
+
+func (s *FiberServer) metricsPath() string {
+	if path := s.config.GetString("METRICS_PATH"); path != "" {
+		return path
+	}
+
+	return "/metrics"
+}
+
+// setupMetrics pasang endpoint metrics di app utama, atau di port terpisah kalau METRICS_PORT di isi.
+// Harus dipanggil sebelum route lain supaya tidak melewati middleware tenant
+func (s *FiberServer) setupMetrics() {
+	if !s.metricsEnabled() {
+		return
+	}
+
+	port := s.config.GetString("METRICS_PORT")
+	if port == "" {
+		s.fiber.Get(s.metricsPath(), adaptor.HTTPHandler(metrics.Handler()))
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(s.metricsPath(), metrics.Handler())
+
+	host := s.config.GetString("HOST")
+	if host == "" {
+		host = "0.0.0.0"
+	}
+
+	s.metricsServer = &http.Server{
+		Addr:              fmt.Sprintf("%s:%s", host, port),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+}
+
+func (s *FiberServer) startMetricsServer() {
+	if s.metricsServer == nil {
+		return
+	}
+
+	go func() {
+		s.log.Info("Starting metrics server", zap.String("address", s.metricsServer.Addr), zap.String("path", s.metricsPath()))
+		if err := s.metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.log.Error("Metrics server error", zap.Error(err))
+		}
+	}()
+}
+
+func (s *FiberServer) shutdownMetricsServer(ctx context.Context) {
+	if s.metricsServer == nil {
+		return
+	}
+
+	if err := s.metricsServer.Shutdown(ctx); err != nil {
+		s.log.Error("Failed to shutdown metrics server", zap.Error(err))
+	}
+}
@@ -3,7 +3,9 @@ package servers
 import (
 	"time"
 
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/internal/routes"
 	middleware "github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/middlewares"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/tracing"
 	"github.com/gofiber/fiber/v2"
 	fiberlogger "github.com/gofiber/fiber/v2/middleware/logger"
 	"go.uber.org/zap"
@@ -16,11 +18,20 @@ func (s *FiberServer) SetupMiddlewares() {
 	// Recovery Middleware
 	s.fiber.Use(middleware.NewRecoveryMiddleware(s.log))
 
+	// Server span per request, parent dari header traceparent
+	// Probe kubernetes tidak di trace dan tidak di hitung
+	s.fiber.Use(middleware.NewTracingMiddleware(s.metricsPath(), routes.LivenessPath, routes.ReadinessPath))
+
+	// Jumlah dan latency request per route untuk Prometheus
+	if s.metricsEnabled() {
+		s.fiber.Use(middleware.NewMetricsMiddleware(s.metricsPath(), routes.LivenessPath, routes.ReadinessPath))
+	}
+
 	// Cors
 	s.fiber.Use(cors.New(cors.Config{
 		AllowOrigins: "*",
 		AllowMethods: "GET,POST,PUT,PATCH,DELETE",
-		AllowHeaders: "Origin,Content-Type,Accept,Authorization",
+		AllowHeaders: "Origin,Content-Type,Accept,Authorization,X-Tenant-ID,X-Tenant-Token,X-Actor,X-Request-ID",
 	}))
 
 	// Request ID - TAMBAHKAN .Handle()
@@ -35,6 +46,12 @@ func (s *FiberServer) SetupMiddlewares() {
 
 	// Custom Zap logger middleware
 	s.fiber.Use(s.loggerMiddleware())
+
+	// Bahasa pesan response dari Accept-Language, default en-US
+	s.fiber.Use(middleware.NewLanguageMiddleware())
+
+	// Error di ubah jadi problem+json di sini, supaya middleware di atas mencatat status yang benar
+	s.fiber.Use(middleware.NewErrorMiddleware())
 }
 
 func (s *FiberServer) loggerMiddleware() fiber.Handler {
@@ -42,8 +59,8 @@ func (s *FiberServer) loggerMiddleware() fiber.Handler {
 		start := time.Now()
 		err := c.Next()
 
-		// Zap structured logging
-		s.log.Info("HTTP Request",
+		// Zap structured logging, trace_id & span_id ikut kalau request di trace
+		fields := []zap.Field{
 			zap.String("framework", "Fiber"),
 			zap.String("request_id", c.GetRespHeader("X-Request-ID")),
 			zap.String("method", c.Method()),
@@ -51,7 +68,8 @@ func (s *FiberServer) loggerMiddleware() fiber.Handler {
 			zap.String("remote_ip", c.IP()),
 			zap.Int("status", c.Response().StatusCode()),
 			zap.Int64("latency_ms", time.Since(start).Milliseconds()),
-		)
+		}
+		s.log.Info("HTTP Request", append(fields, tracing.LogFields(c.Context())...)...)
 
 		return err
 	}
@@ -5,11 +5,21 @@ import (
 )
 
 func (s *FiberServer) SetupRoutes() {
+	// Probe liveness dan readiness
+	s.setupHealth()
+
+	// Endpoint operasional (log level), di proteksi ADMIN_TOKEN
+	routes.AdminRoutes(s.fiber, s.config, s.logLevel, s.log)
+
+	// Endpoint metrics untuk Prometheus
+	s.setupMetrics()
+
 	// Setup Routes group
 	apiGroup := s.fiber.Group("/api")
 
 	// Initialize router
 	router := routes.NewRouter(s.fiber, s.config, s.log, s.DB)
+	s.router = router
 
 	// Setup all routes
 	router.SetupRoutes(apiGroup, s.config, s.log, s.DB)
@@ -0,0 +1,117 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+type LocalConfig struct {
+	BaseDir   string
+	URLPrefix string // path publik untuk serve file, contoh /media
+	BaseURL   string
+}
+
+type LocalStorage struct {
+	config LocalConfig
+	log    *zap.Logger
+}
+
+func NewLocalStorage(env *viper.Viper, log *zap.Logger) (*LocalStorage, error) {
+	config := LocalConfig{
+		BaseDir:   env.GetString("STORAGE_LOCAL_DIR"),
+		URLPrefix: env.GetString("STORAGE_LOCAL_URL_PREFIX"),
+		BaseURL:   strings.TrimRight(env.GetString("PUBLIC_BASE_URL"), "/"),
+	}
+
+	if config.BaseDir == "" {
+		config.BaseDir = "./uploads"
+	}
+	if config.URLPrefix == "" {
+		config.URLPrefix = "/media"
+	}
+
+	if err := os.MkdirAll(config.BaseDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create storage directory: %w", err)
+	}
+
+	log.Info("Local storage initialized",
+		zap.String("dir", config.BaseDir),
+		zap.String("url_prefix", config.URLPrefix),
+	)
+
+	return &LocalStorage{
+		config: config,
+		log:    log,
+	}, nil
+}
+
+func (s *LocalStorage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	// Tulis ke file sementara dulu supaya tidak ada file setengah jadi
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close file: %w", err)
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete file: %w", err)
+	}
+
+	return nil
+}
+
+func (s *LocalStorage) URL(key string) string {
+	return s.config.BaseURL + s.config.URLPrefix + "/" + key
+}
+
+func (s *LocalStorage) BaseDir() string {
+	return s.config.BaseDir
+}
+
+func (s *LocalStorage) URLPrefix() string {
+	return s.config.URLPrefix
+}
+
+// path pastikan key tidak bisa keluar dari base directory
+func (s *LocalStorage) path(key string) (string, error) {
+	cleaned := filepath.Clean("/" + key)
+	if cleaned == "/" {
+		return "", fmt.Errorf("invalid storage key: %q", key)
+	}
+
+	return filepath.Join(s.config.BaseDir, cleaned), nil
+}
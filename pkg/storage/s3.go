@@ -0,0 +1,113 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+type S3Config struct {
+	Endpoint  string
+	AccessKey string
+	SecretKey string
+	Bucket    string
+	Region    string
+	UseSSL    bool
+	PublicURL string // base url publik bucket, contoh CDN
+}
+
+// S3Storage driver untuk AWS S3 dan storage yang compatible (MinIO, dsb)
+type S3Storage struct {
+	client *minio.Client
+	config S3Config
+	log    *zap.Logger
+}
+
+func NewS3Storage(env *viper.Viper, log *zap.Logger) (*S3Storage, error) {
+	config := S3Config{
+		Endpoint:  env.GetString("S3_ENDPOINT"),
+		AccessKey: env.GetString("S3_ACCESS_KEY"),
+		SecretKey: env.GetString("S3_SECRET_KEY"),
+		Bucket:    env.GetString("S3_BUCKET"),
+		Region:    env.GetString("S3_REGION"),
+		UseSSL:    env.GetBool("S3_USE_SSL"),
+		PublicURL: strings.TrimRight(env.GetString("S3_PUBLIC_URL"), "/"),
+	}
+
+	if config.Endpoint == "" || config.Bucket == "" {
+		return nil, fmt.Errorf("S3_ENDPOINT and S3_BUCKET are required for s3 storage")
+	}
+
+	client, err := minio.New(config.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(config.AccessKey, config.SecretKey, ""),
+		Secure: config.UseSSL,
+		Region: config.Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create s3 client: %w", err)
+	}
+
+	// Pastikan bucket tersedia
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	exists, err := client.BucketExists(ctx, config.Bucket)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check s3 bucket: %w", err)
+	}
+
+	if !exists {
+		if err := client.MakeBucket(ctx, config.Bucket, minio.MakeBucketOptions{Region: config.Region}); err != nil {
+			return nil, fmt.Errorf("failed to create s3 bucket: %w", err)
+		}
+	}
+
+	if config.PublicURL == "" {
+		scheme := "http"
+		if config.UseSSL {
+			scheme = "https"
+		}
+		config.PublicURL = fmt.Sprintf("%s://%s/%s", scheme, config.Endpoint, config.Bucket)
+	}
+
+	log.Info("S3 storage connected successfully",
+		zap.String("endpoint", config.Endpoint),
+		zap.String("bucket", config.Bucket),
+	)
+
+	return &S3Storage{
+		client: client,
+		config: config,
+		log:    log,
+	}, nil
+}
+
+func (s *S3Storage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	_, err := s.client.PutObject(ctx, s.config.Bucket, key, r, size, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload object: %w", err)
+	}
+
+	return nil
+}
+
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	if err := s.client.RemoveObject(ctx, s.config.Bucket, key, minio.RemoveObjectOptions{}); err != nil {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+
+	return nil
+}
+
+func (s *S3Storage) URL(key string) string {
+	return s.config.PublicURL + "/" + key
+}
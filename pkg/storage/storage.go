@@ -0,0 +1,39 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+// Storage abstraksi penyimpanan file, implementasi: local filesystem dan S3 compatible
+type Storage interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Delete(ctx context.Context, key string) error
+	URL(key string) string
+}
+
+const (
+	DriverLocal = "local"
+	DriverS3    = "s3"
+)
+
+// NewStorage pilih driver berdasarkan STORAGE_DRIVER, default local
+func NewStorage(config *viper.Viper, log *zap.Logger) (Storage, error) {
+	driver := config.GetString("STORAGE_DRIVER")
+	if driver == "" {
+		driver = DriverLocal
+	}
+
+	switch driver {
+	case DriverLocal:
+		return NewLocalStorage(config, log)
+	case DriverS3:
+		return NewS3Storage(config, log)
+	default:
+		return nil, fmt.Errorf("unsupported storage driver: %s", driver)
+	}
+}
@@ -0,0 +1,275 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+// fakeS3 server S3 minimal: HEAD bucket, PUT object dan DELETE object
+type fakeS3 struct {
+	mu           sync.Mutex
+	objects      map[string][]byte
+	contentTypes map[string]string
+}
+
+func newFakeS3(t *testing.T, bucket string) (*fakeS3, *httptest.Server) {
+	fake := &fakeS3{objects: map[string][]byte{}, contentTypes: map[string]string{}}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fake.mu.Lock()
+		defer fake.mu.Unlock()
+
+		key, ok := strings.CutPrefix(r.URL.Path, "/"+bucket+"/")
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		switch {
+		case r.Method == http.MethodHead && key == "":
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodPut && key != "":
+			body, err := readPayload(r)
+			if err != nil {
+				t.Errorf("fake s3: failed to read payload: %v", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			fake.objects[key] = body
+			fake.contentTypes[key] = r.Header.Get("Content-Type")
+			w.Header().Set("ETag", `"etag"`)
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodDelete && key != "":
+			// S3 juga membalas 204 untuk object yang tidak ada
+			delete(fake.objects, key)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return fake, server
+}
+
+// readPayload decode body aws-chunked yang dikirim minio lewat http biasa
+func readPayload(r *http.Request) ([]byte, error) {
+	if r.Header.Get("X-Amz-Content-Sha256") != "STREAMING-AWS4-HMAC-SHA256-PAYLOAD" {
+		return io.ReadAll(r.Body)
+	}
+
+	var body bytes.Buffer
+	reader := bufio.NewReader(r.Body)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+
+		sizeHex, _, _ := strings.Cut(strings.TrimSpace(line), ";")
+		size, err := strconv.ParseInt(sizeHex, 16, 64)
+		if err != nil {
+			return nil, err
+		}
+		if size == 0 {
+			return body.Bytes(), nil
+		}
+
+		if _, err := io.CopyN(&body, reader, size); err != nil {
+			return nil, err
+		}
+		if _, err := reader.Discard(2); err != nil {
+			return nil, err
+		}
+	}
+}
+
+type storageDriver struct {
+	name    string
+	storage Storage
+	read    func(key string) ([]byte, string, bool)
+}
+
+func newDrivers(t *testing.T) []storageDriver {
+	localConfig := viper.New()
+	localConfig.Set("STORAGE_LOCAL_DIR", t.TempDir())
+	local, err := NewLocalStorage(localConfig, zap.NewNop())
+	if err != nil {
+		t.Fatalf("NewLocalStorage() error = %v", err)
+	}
+
+	fake, server := newFakeS3(t, "media")
+	s3Config := viper.New()
+	s3Config.Set("S3_ENDPOINT", strings.TrimPrefix(server.URL, "http://"))
+	s3Config.Set("S3_BUCKET", "media")
+	s3Config.Set("S3_REGION", "us-east-1")
+	s3Config.Set("S3_ACCESS_KEY", "access")
+	s3Config.Set("S3_SECRET_KEY", "secret")
+	s3, err := NewS3Storage(s3Config, zap.NewNop())
+	if err != nil {
+		t.Fatalf("NewS3Storage() error = %v", err)
+	}
+
+	return []storageDriver{
+		{
+			name:    DriverLocal,
+			storage: local,
+			read: func(key string) ([]byte, string, bool) {
+				data, err := os.ReadFile(filepath.Join(local.BaseDir(), key))
+				return data, "", err == nil
+			},
+		},
+		{
+			name:    DriverS3,
+			storage: s3,
+			read: func(key string) ([]byte, string, bool) {
+				fake.mu.Lock()
+				defer fake.mu.Unlock()
+				data, ok := fake.objects[key]
+				return data, fake.contentTypes[key], ok
+			},
+		},
+	}
+}
+
+func TestStoragePutDeleteContract(t *testing.T) {
+	type step struct {
+		op      string // put | delete
+		key     string
+		data    string
+		wantErr bool
+	}
+
+	tests := []struct {
+		name      string
+		steps     []step
+		wantKey   string
+		wantData  string
+		wantExist bool
+	}{
+		{
+			name:      "put stores the exact bytes",
+			steps:     []step{{op: "put", key: "images/a.png", data: "png-bytes"}},
+			wantKey:   "images/a.png",
+			wantData:  "png-bytes",
+			wantExist: true,
+		},
+		{
+			name:      "put on an existing key replaces the content",
+			steps:     []step{{op: "put", key: "b.txt", data: "old"}, {op: "put", key: "b.txt", data: "new content"}},
+			wantKey:   "b.txt",
+			wantData:  "new content",
+			wantExist: true,
+		},
+		{
+			name:      "nested key",
+			steps:     []step{{op: "put", key: "2026/10/19/c.jpg", data: "jpg"}},
+			wantKey:   "2026/10/19/c.jpg",
+			wantData:  "jpg",
+			wantExist: true,
+		},
+		{
+			name:    "delete removes the object",
+			steps:   []step{{op: "put", key: "d.txt", data: "bye"}, {op: "delete", key: "d.txt"}},
+			wantKey: "d.txt",
+		},
+		{
+			name:    "delete of a missing key is not an error",
+			steps:   []step{{op: "delete", key: "missing.txt"}},
+			wantKey: "missing.txt",
+		},
+		{
+			name:  "empty key is rejected",
+			steps: []step{{op: "put", key: "", data: "x", wantErr: true}},
+		},
+	}
+
+	for _, driver := range newDrivers(t) {
+		for _, tt := range tests {
+			t.Run(driver.name+"/"+tt.name, func(t *testing.T) {
+				ctx := context.Background()
+
+				for _, s := range tt.steps {
+					var err error
+					switch s.op {
+					case "put":
+						err = driver.storage.Put(ctx, s.key, strings.NewReader(s.data), int64(len(s.data)), "text/plain")
+					case "delete":
+						err = driver.storage.Delete(ctx, s.key)
+					}
+
+					if (err != nil) != s.wantErr {
+						t.Fatalf("%s(%q) error = %v, wantErr %v", s.op, s.key, err, s.wantErr)
+					}
+				}
+
+				if tt.wantKey == "" {
+					return
+				}
+
+				data, contentType, exists := driver.read(tt.wantKey)
+				if exists != tt.wantExist {
+					t.Fatalf("object %q exists = %v, want %v", tt.wantKey, exists, tt.wantExist)
+				}
+				if string(data) != tt.wantData {
+					t.Errorf("object %q = %q, want %q", tt.wantKey, data, tt.wantData)
+				}
+				if exists && driver.name == DriverS3 && contentType != "text/plain" {
+					t.Errorf("content type = %q, want text/plain", contentType)
+				}
+			})
+		}
+	}
+}
+
+func TestLocalStorageKeyStaysInBaseDir(t *testing.T) {
+	baseDir := t.TempDir()
+	config := viper.New()
+	config.Set("STORAGE_LOCAL_DIR", baseDir)
+	local, err := NewLocalStorage(config, zap.NewNop())
+	if err != nil {
+		t.Fatalf("NewLocalStorage() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		key      string
+		wantPath string
+		wantErr  bool
+	}{
+		{name: "plain key", key: "a.png", wantPath: "a.png"},
+		{name: "parent traversal", key: "../../etc/passwd", wantPath: "etc/passwd"},
+		{name: "absolute key", key: "/tmp/x.png", wantPath: "tmp/x.png"},
+		{name: "dot key", key: ".", wantErr: true},
+		{name: "traversal to root", key: "../..", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := local.Put(context.Background(), tt.key, strings.NewReader("data"), 4, "text/plain")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Put(%q) error = %v, wantErr %v", tt.key, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if _, err := os.Stat(filepath.Join(baseDir, tt.wantPath)); err != nil {
+				t.Errorf("file not written inside base dir at %s: %v", tt.wantPath, err)
+			}
+		})
+	}
+}
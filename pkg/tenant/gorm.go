@@ -0,0 +1,103 @@
+package tenant
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ScopedTables tabel yang punya kolom tenant_id. Tabel relasi (series_articles,
+// article_attachments, article_reviews) tidak punya tenant_id, query ke tabel tersebut
+// harus di join atau di filter dengan id parent yang sudah ter-scope
+var ScopedTables = []string{
+	"posts", "article_translations", "comments", "series", "media", "audit_logs",
+	"webhook_subscriptions", "webhook_deliveries", "outbox_events",
+}
+
+// RegisterScope pasang callback gorm sehingga setiap query ke tabel tenant otomatis
+// di filter tenant_id dari context, dan insert otomatis mengisi tenant_id.
+// Query tanpa tenant di context ditolak supaya tidak ada query yang lupa di scope,
+// kecuali context worker yang ditandai WithAllTenants
+func RegisterScope(db *gorm.DB, tables ...string) error {
+	scoped := make(map[string]bool, len(tables))
+	for _, table := range tables {
+		scoped[table] = true
+	}
+
+	scope := func(tx *gorm.DB) {
+		table := database.TableName(tx.Statement)
+		if !scoped[table] {
+			return
+		}
+
+		id, ok := FromContext(tx.Statement.Context)
+		if !ok {
+			if allTenants(tx.Statement.Context) {
+				return
+			}
+			tx.AddError(fmt.Errorf("%w: query on %s", ErrTenantRequired, table))
+			return
+		}
+
+		// Statement.Table berisi alias jika ada, misal "o" dari "outbox_events AS o"
+		tx.Statement.AddClause(clause.Where{Exprs: []clause.Expression{
+			clause.Eq{Column: clause.Column{Table: tx.Statement.Table, Name: "tenant_id"}, Value: id},
+		}})
+	}
+
+	assign := func(tx *gorm.DB) {
+		table := database.TableName(tx.Statement)
+		if !scoped[table] {
+			return
+		}
+
+		id, ok := FromContext(tx.Statement.Context)
+		if !ok {
+			tx.AddError(fmt.Errorf("%w: insert into %s", ErrTenantRequired, table))
+			return
+		}
+
+		if tx.Statement.Schema == nil {
+			tx.AddError(fmt.Errorf("tenant: insert into %s needs a model", table))
+			return
+		}
+
+		field := tx.Statement.Schema.LookUpField("TenantID")
+		if field == nil {
+			tx.AddError(fmt.Errorf("tenant: model for %s has no TenantID field", table))
+			return
+		}
+
+		switch value := tx.Statement.ReflectValue; value.Kind() {
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < value.Len(); i++ {
+				if err := field.Set(tx.Statement.Context, reflect.Indirect(value.Index(i)), id); err != nil {
+					tx.AddError(err)
+				}
+			}
+		case reflect.Struct:
+			if err := field.Set(tx.Statement.Context, value, id); err != nil {
+				tx.AddError(err)
+			}
+		}
+	}
+
+	callbacks := db.Callback()
+	if err := callbacks.Query().Before("gorm:query").Register("tenant:query", scope); err != nil {
+		return err
+	}
+	if err := callbacks.Row().Before("gorm:row").Register("tenant:row", scope); err != nil {
+		return err
+	}
+	if err := callbacks.Update().Before("gorm:update").Register("tenant:update", scope); err != nil {
+		return err
+	}
+	if err := callbacks.Delete().Before("gorm:delete").Register("tenant:delete", scope); err != nil {
+		return err
+	}
+
+	return callbacks.Create().Before("gorm:create").Register("tenant:create", assign)
+}
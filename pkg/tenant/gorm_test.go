@@ -0,0 +1,129 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type scopedRow struct {
+	ID       uint
+	TenantID string
+	Status   string
+}
+
+func newDryRunDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	// DryRun hanya build SQL, tidak ada koneksi ke database
+	db, err := gorm.Open(mysql.New(mysql.Config{DSN: "user:pass@tcp(127.0.0.1:1)/test", SkipInitializeWithVersion: true}), &gorm.Config{
+		DryRun:                 true,
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to open dry run db: %v", err)
+	}
+
+	if err := RegisterScope(db, ScopedTables...); err != nil {
+		t.Fatalf("RegisterScope() error = %v", err)
+	}
+	return db
+}
+
+func TestRegisterScope(t *testing.T) {
+	db := newDryRunDB(t)
+	background := context.Background()
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		run       func(tx *gorm.DB) *gorm.DB
+		wantWhere string // kosong berarti tidak boleh ada filter tenant
+		wantErr   error
+	}{
+		{
+			name:      "query is filtered by tenant",
+			ctx:       WithTenant(background, "brand-a"),
+			run:       func(tx *gorm.DB) *gorm.DB { return tx.Table("webhook_deliveries").Find(&[]scopedRow{}) },
+			wantWhere: "`webhook_deliveries`.`tenant_id` = ?",
+		},
+		{
+			name:      "aliased table is filtered through the alias",
+			ctx:       WithTenant(background, "brand-a"),
+			run:       func(tx *gorm.DB) *gorm.DB { return tx.Table("outbox_events AS o").Find(&[]scopedRow{}) },
+			wantWhere: "`o`.`tenant_id` = ?",
+		},
+		{
+			name:      "update is filtered by tenant",
+			ctx:       WithTenant(background, "brand-a"),
+			run:       func(tx *gorm.DB) *gorm.DB { return tx.Table("outbox_events").Where("id = ?", 1).Update("status", "x") },
+			wantWhere: "`outbox_events`.`tenant_id` = ?",
+		},
+		{
+			name:    "query without tenant is rejected",
+			ctx:     background,
+			run:     func(tx *gorm.DB) *gorm.DB { return tx.Table("outbox_events AS o").Find(&[]scopedRow{}) },
+			wantErr: ErrTenantRequired,
+		},
+		{
+			name: "worker context reads all tenants",
+			ctx:  WithAllTenants(background),
+			run:  func(tx *gorm.DB) *gorm.DB { return tx.Table("outbox_events AS o").Find(&[]scopedRow{}) },
+		},
+		{
+			name:      "tenant wins over the worker marker",
+			ctx:       WithTenant(WithAllTenants(background), "brand-a"),
+			run:       func(tx *gorm.DB) *gorm.DB { return tx.Table("webhook_subscriptions").Find(&[]scopedRow{}) },
+			wantWhere: "`webhook_subscriptions`.`tenant_id` = ?",
+		},
+		{
+			name: "unscoped table is untouched",
+			ctx:  background,
+			run:  func(tx *gorm.DB) *gorm.DB { return tx.Table("article_reviews").Find(&[]scopedRow{}) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.run(db.WithContext(tt.ctx))
+			if !errors.Is(result.Error, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", result.Error, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			sql := result.Statement.SQL.String()
+			if tt.wantWhere == "" {
+				if strings.Contains(sql, "tenant_id") {
+					t.Errorf("sql %q must not filter by tenant", sql)
+				}
+				return
+			}
+			if !strings.Contains(sql, tt.wantWhere) {
+				t.Errorf("sql %q does not contain %q", sql, tt.wantWhere)
+			}
+		})
+	}
+}
+
+func TestRegisterScopeFillsTenantOnCreate(t *testing.T) {
+	db := newDryRunDB(t)
+
+	rows := []scopedRow{{Status: "pending"}, {Status: "pending", TenantID: "spoofed"}}
+	result := db.WithContext(WithTenant(context.Background(), "brand-a")).Table("webhook_deliveries").Create(&rows)
+	if result.Error != nil {
+		t.Fatalf("Create() error = %v", result.Error)
+	}
+
+	for i, row := range rows {
+		if row.TenantID != "brand-a" {
+			t.Errorf("rows[%d].TenantID = %q, want brand-a", i, row.TenantID)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package tenant
+
+import (
+	"net"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/spf13/viper"
+)
+
+const (
+	DefaultHeader      = "X-Tenant-ID"
+	DefaultTokenHeader = "X-Tenant-Token"
+	DefaultJWTClaim    = "tenant_id"
+	DefaultTenant      = "default"
+)
+
+// Resolver tentukan tenant dari JWT claim, header dan subdomain.
+// Jika JWTSecret di set, claim di token wajib ada dan header atau subdomain hanya boleh
+// menunjuk tenant yang sama. Header saja hanya dipercaya di belakang gateway (TrustedGateway).
+// Token dikirim di header sendiri karena Authorization dipakai token moderator, admin dan editor
+type Resolver struct {
+	Header         string
+	TokenHeader    string // header yang membawa JWT tenant, prefix "Bearer " opsional
+	BaseDomain     string // contoh "example.com", maka "brand.example.com" menjadi tenant "brand"
+	JWTSecret      []byte
+	JWTClaim       string
+	TrustedGateway bool   // true jika header tenant di set oleh gateway, bukan oleh client
+	Default        string // kosong berarti tenant wajib dikirim
+}
+
+func NewResolver(config *viper.Viper) *Resolver {
+	r := &Resolver{
+		Header:         config.GetString("TENANT_HEADER"),
+		TokenHeader:    config.GetString("TENANT_JWT_HEADER"),
+		BaseDomain:     strings.ToLower(strings.TrimPrefix(config.GetString("TENANT_BASE_DOMAIN"), ".")),
+		JWTSecret:      []byte(config.GetString("TENANT_JWT_SECRET")),
+		JWTClaim:       config.GetString("TENANT_JWT_CLAIM"),
+		TrustedGateway: config.GetBool("TENANT_TRUSTED_GATEWAY"),
+		Default:        DefaultTenant,
+	}
+
+	if r.Header == "" {
+		r.Header = DefaultHeader
+	}
+	if r.TokenHeader == "" {
+		r.TokenHeader = DefaultTokenHeader
+	}
+	if r.JWTClaim == "" {
+		r.JWTClaim = DefaultJWTClaim
+	}
+	if config.IsSet("TENANT_DEFAULT") {
+		r.Default = config.GetString("TENANT_DEFAULT")
+	}
+
+	return r
+}
+
+func (r *Resolver) Resolve(header, host, token string) (string, error) {
+	if len(r.JWTSecret) > 0 {
+		return r.resolveWithToken(header, host, token)
+	}
+
+	return r.ResolvePublic(header, host)
+}
+
+// ResolvePublic untuk route baca publik (feed, sitemap) yang tidak membawa token tenant,
+// tenant dari subdomain, header di belakang gateway atau Default walaupun JWTSecret di set
+func (r *Resolver) ResolvePublic(header, host string) (string, error) {
+	fromHost := r.fromHost(host)
+	if header != "" && fromHost == "" && !r.TrustedGateway {
+		return "", ErrUntrustedHeader
+	}
+
+	resolved := ""
+	for _, candidate := range []string{fromHost, header} {
+		if candidate == "" {
+			continue
+		}
+
+		id, err := Normalize(candidate)
+		if err != nil {
+			return "", err
+		}
+
+		if resolved != "" && resolved != id {
+			return "", ErrTenantMismatch
+		}
+		resolved = id
+	}
+
+	if resolved == "" {
+		if r.Default == "" {
+			return "", ErrTenantRequired
+		}
+		return Normalize(r.Default)
+	}
+
+	return resolved, nil
+}
+
+// resolveWithToken tenant selalu dari claim, header dan subdomain hanya dicocokkan
+func (r *Resolver) resolveWithToken(header, host, token string) (string, error) {
+	resolved, err := r.fromToken(token)
+	if err != nil {
+		return "", err
+	}
+
+	for _, candidate := range []string{header, r.fromHost(host)} {
+		if candidate == "" {
+			continue
+		}
+
+		id, err := Normalize(candidate)
+		if err != nil {
+			return "", err
+		}
+
+		if id != resolved {
+			return "", ErrTenantMismatch
+		}
+	}
+
+	return resolved, nil
+}
+
+func (r *Resolver) fromToken(token string) (string, error) {
+	raw := strings.TrimSpace(strings.TrimPrefix(token, "Bearer "))
+	if raw == "" {
+		return "", ErrTokenRequired
+	}
+
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(raw, claims, func(*jwt.Token) (any, error) {
+		return r.JWTSecret, nil
+	}, jwt.WithValidMethods([]string{"HS256", "HS384", "HS512"}))
+	if err != nil {
+		return "", ErrInvalidToken
+	}
+
+	value, _ := claims[r.JWTClaim].(string)
+	if value == "" {
+		return "", ErrInvalidToken
+	}
+
+	id, err := Normalize(value)
+	if err != nil {
+		return "", ErrInvalidToken
+	}
+
+	return id, nil
+}
+
+func (r *Resolver) fromHost(host string) string {
+	if r.BaseDomain == "" {
+		return ""
+	}
+
+	host = strings.ToLower(host)
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	sub, ok := strings.CutSuffix(host, "."+r.BaseDomain)
+	if !ok {
+		return ""
+	}
+
+	return sub
+}
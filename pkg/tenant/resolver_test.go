@@ -0,0 +1,115 @@
+package tenant
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func signToken(t *testing.T, secret string, method jwt.SigningMethod, claims jwt.MapClaims) string {
+	t.Helper()
+
+	var key any = []byte(secret)
+	if method == jwt.SigningMethodNone {
+		key = jwt.UnsafeAllowNoneSignatureType
+	}
+
+	token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return token
+}
+
+func TestResolveWithJWTSecret(t *testing.T) {
+	resolver := &Resolver{
+		Header:      DefaultHeader,
+		TokenHeader: DefaultTokenHeader,
+		BaseDomain:  "example.com",
+		JWTSecret:   []byte("s3cret"),
+		JWTClaim:    DefaultJWTClaim,
+		Default:     DefaultTenant,
+	}
+
+	brandA := signToken(t, "s3cret", jwt.SigningMethodHS256, jwt.MapClaims{"tenant_id": "Brand-A"})
+
+	tests := []struct {
+		name    string
+		header  string
+		host    string
+		token   string
+		want    string
+		wantErr error
+	}{
+		{name: "claim only", token: "Bearer " + brandA, want: "brand-a"},
+		{name: "bearer prefix is optional", token: brandA, want: "brand-a"},
+		{name: "matching header and subdomain", header: "brand-a", host: "brand-a.example.com:3000", token: "Bearer " + brandA, want: "brand-a"},
+		{name: "missing token", header: "brand-a", wantErr: ErrTokenRequired},
+		{name: "missing token does not fall back to default", wantErr: ErrTokenRequired},
+		{name: "header for another tenant", header: "brand-b", token: "Bearer " + brandA, wantErr: ErrTenantMismatch},
+		{name: "subdomain for another tenant", host: "brand-b.example.com", token: "Bearer " + brandA, wantErr: ErrTenantMismatch},
+		{name: "token signed with another secret", token: "Bearer " + signToken(t, "other", jwt.SigningMethodHS256, jwt.MapClaims{"tenant_id": "brand-a"}), wantErr: ErrInvalidToken},
+		{name: "token without claim", token: "Bearer " + signToken(t, "s3cret", jwt.SigningMethodHS256, jwt.MapClaims{"sub": "user"}), wantErr: ErrInvalidToken},
+		{name: "token with invalid tenant id", token: "Bearer " + signToken(t, "s3cret", jwt.SigningMethodHS256, jwt.MapClaims{"tenant_id": "no spaces allowed"}), wantErr: ErrInvalidToken},
+		{name: "non jwt bearer", token: "Bearer moderator-token", wantErr: ErrInvalidToken},
+		{name: "unsigned token", token: "Bearer " + signToken(t, "", jwt.SigningMethodNone, jwt.MapClaims{"tenant_id": "brand-a"}), wantErr: ErrInvalidToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolver.Resolve(tt.header, tt.host, tt.token)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Resolve() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Resolve() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveWithoutJWTSecret(t *testing.T) {
+	tests := []struct {
+		name     string
+		trusted  bool
+		fallback string
+		header   string
+		host     string
+		want     string
+		wantErr  error
+	}{
+		{name: "default tenant", fallback: DefaultTenant, want: DefaultTenant},
+		{name: "tenant required without default", wantErr: ErrTenantRequired},
+		{name: "subdomain", fallback: DefaultTenant, host: "Brand-A.example.com", want: "brand-a"},
+		{name: "host outside base domain uses default", fallback: DefaultTenant, host: "example.org", want: DefaultTenant},
+		{name: "header only is rejected without gateway", fallback: DefaultTenant, header: "brand-a", wantErr: ErrUntrustedHeader},
+		{name: "header only behind trusted gateway", trusted: true, header: "brand-a", want: "brand-a"},
+		{name: "header matching subdomain", fallback: DefaultTenant, header: "brand-a", host: "brand-a.example.com", want: "brand-a"},
+		{name: "header for another tenant than subdomain", fallback: DefaultTenant, header: "brand-b", host: "brand-a.example.com", wantErr: ErrTenantMismatch},
+		{name: "invalid header behind gateway", trusted: true, header: "brand a", wantErr: ErrInvalidTenant},
+		{name: "invalid subdomain", fallback: DefaultTenant, host: "-bad-.example.com", wantErr: ErrInvalidTenant},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver := &Resolver{
+				Header:         DefaultHeader,
+				TokenHeader:    DefaultTokenHeader,
+				BaseDomain:     "example.com",
+				JWTClaim:       DefaultJWTClaim,
+				TrustedGateway: tt.trusted,
+				Default:        tt.fallback,
+			}
+
+			// Bearer token diabaikan tanpa secret, misal MODERATOR_TOKEN
+			got, err := resolver.Resolve(tt.header, tt.host, "Bearer moderator-token")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Resolve() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Resolve() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
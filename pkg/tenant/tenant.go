@@ -0,0 +1,67 @@
+package tenant
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/apperror"
+)
+
+var (
+	ErrTenantRequired = apperror.Validation("TENANT_REQUIRED", "tenant is required")
+	ErrInvalidTenant  = apperror.Validation("TENANT_INVALID", "invalid tenant id")
+	ErrTenantMismatch = apperror.Forbidden("TENANT_MISMATCH", "tenant does not match the authenticated tenant")
+	ErrInvalidToken   = apperror.Unauthorized("UNAUTHORIZED", "invalid tenant token").WithID("tenant_token")
+	ErrTokenRequired  = apperror.Unauthorized("UNAUTHORIZED", "tenant token is required").WithID("tenant_token_required")
+
+	ErrUntrustedHeader = apperror.Forbidden("TENANT_HEADER_UNTRUSTED", "tenant header is only accepted from a trusted gateway")
+)
+
+// Tenant id dipakai di subdomain, jadi dibatasi huruf kecil, angka dan dash
+var idPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,62}[a-z0-9])?$`)
+
+type contextKey struct{}
+
+type allTenantsKey struct{}
+
+// ContextKey key tenant di context. Middleware menyimpannya lewat fiber Locals
+// sehingga ikut terbaca dari ctx.Context() yang diteruskan handler ke usecase
+var ContextKey = contextKey{}
+
+func Normalize(id string) (string, error) {
+	id = strings.ToLower(strings.TrimSpace(id))
+	if !idPattern.MatchString(id) {
+		return "", ErrInvalidTenant
+	}
+
+	return id, nil
+}
+
+// WithAllTenants tandai context worker yang memproses antrian semua tenant (outbox, webhook).
+// Query tanpa tenant dengan context ini tidak di filter, tenant di context tetap diutamakan
+func WithAllTenants(ctx context.Context) context.Context {
+	return context.WithValue(ctx, allTenantsKey{}, true)
+}
+
+func allTenants(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+
+	all, _ := ctx.Value(allTenantsKey{}).(bool)
+	return all
+}
+
+func WithTenant(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, ContextKey, id)
+}
+
+func FromContext(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+
+	id, ok := ctx.Value(ContextKey).(string)
+	return id, ok && id != ""
+}
@@ -0,0 +1,26 @@
+package tracing
+
+import (
+	"github.com/valyala/fasthttp"
+)
+
+// HeaderCarrier adaptor header fasthttp untuk propagator OpenTelemetry
+type HeaderCarrier struct {
+	Header *fasthttp.RequestHeader
+}
+
+func (c HeaderCarrier) Get(key string) string {
+	return string(c.Header.Peek(key))
+}
+
+func (c HeaderCarrier) Set(key, value string) {
+	c.Header.Set(key, value)
+}
+
+func (c HeaderCarrier) Keys() []string {
+	keys := make([]string, 0, c.Header.Len())
+	c.Header.VisitAll(func(key, _ []byte) {
+		keys = append(keys, string(key))
+	})
+	return keys
+}
@@ -0,0 +1,81 @@
+package tracing
+
+import (
+	"errors"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/database"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+// Key di statement gorm untuk span query yang sedang berjalan
+const gormSpanKey = "tracing:span"
+
+// RegisterGorm pasang plugin yang membuat span untuk setiap statement SQL, system contoh "mysql"
+func RegisterGorm(db *gorm.DB, system string) error {
+	cb := db.Callback()
+	for _, err := range []error{
+		cb.Create().Before("gorm:create").Register("tracing:before_create", startSpan("create", system)),
+		cb.Create().After("gorm:create").Register("tracing:after_create", endSpan),
+		cb.Query().Before("gorm:query").Register("tracing:before_query", startSpan("query", system)),
+		cb.Query().After("gorm:query").Register("tracing:after_query", endSpan),
+		cb.Update().Before("gorm:update").Register("tracing:before_update", startSpan("update", system)),
+		cb.Update().After("gorm:update").Register("tracing:after_update", endSpan),
+		cb.Delete().Before("gorm:delete").Register("tracing:before_delete", startSpan("delete", system)),
+		cb.Delete().After("gorm:delete").Register("tracing:after_delete", endSpan),
+		cb.Row().Before("gorm:row").Register("tracing:before_row", startSpan("row", system)),
+		cb.Row().After("gorm:row").Register("tracing:after_row", endSpan),
+		cb.Raw().Before("gorm:raw").Register("tracing:before_raw", startSpan("raw", system)),
+		cb.Raw().After("gorm:raw").Register("tracing:after_raw", endSpan),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func startSpan(operation, system string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		// Query tanpa parent (polling worker background) tidak di trace supaya tidak membanjiri exporter
+		if db.Statement.Context == nil || !SpanFromContext(db.Statement.Context).SpanContext().IsValid() {
+			return
+		}
+
+		_, span := Start(db.Statement.Context, "gorm."+operation,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				semconv.DBSystemKey.String(system),
+				semconv.DBOperationName(operation),
+			),
+		)
+		db.InstanceSet(gormSpanKey, span)
+	}
+}
+
+func endSpan(db *gorm.DB) {
+	value, ok := db.InstanceGet(gormSpanKey)
+	if !ok {
+		return
+	}
+	span, ok := value.(trace.Span)
+	if !ok {
+		return
+	}
+
+	// SQL di set setelah statement dibangun, nilai parameter tidak ikut
+	span.SetAttributes(
+		semconv.DBQueryText(db.Statement.SQL.String()),
+		semconv.DBCollectionName(database.TableName(db.Statement)),
+		attribute.Int64("db.rows_affected", db.RowsAffected),
+	)
+
+	err := db.Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		err = nil
+	}
+	End(span, err)
+}
@@ -0,0 +1,149 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// Nama instrumentation untuk semua span dari service ini
+const instrumentationName = "github.com/enrichoalkalas01/test-sharing-vision-golang"
+
+// SpanKey key Locals untuk span request. ctx.Context() fiber adalah *fasthttp.RequestCtx yang
+// tidak bisa di bungkus context.WithValue, jadi span disimpan sebagai user value
+const SpanKey = "tracing_span"
+
+// Exporter yang didukung di TRACING_EXPORTER
+const (
+	ExporterNone   = "none"
+	ExporterStdout = "stdout"
+	ExporterOTLP   = "otlp"
+)
+
+// Setup pasang tracer provider global dari TRACING_EXPORTER (none | stdout | otlp).
+// Shutdown yang dikembalikan flush span yang tersisa, harus dipanggil saat aplikasi berhenti
+func Setup(config *viper.Viper, log *zap.Logger) (func(context.Context) error, error) {
+	// Propagasi W3C traceparent & baggage tetap aktif walaupun exporter none
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	exporter, err := newExporter(config)
+	if err != nil {
+		return nil, err
+	}
+
+	if exporter == nil {
+		log.Info("tracing disabled", zap.String("exporter", ExporterNone))
+		return func(context.Context) error { return nil }, nil
+	}
+
+	provider := NewProvider(config, exporter)
+	otel.SetTracerProvider(provider)
+
+	log.Info("tracing enabled", zap.String("exporter", config.GetString("TRACING_EXPORTER")))
+
+	return provider.Shutdown, nil
+}
+
+// NewProvider buat tracer provider dengan exporter tertentu. Test bisa memakai
+// tracetest.NewInMemoryExporter() lalu otel.SetTracerProvider dengan provider ini
+func NewProvider(config *viper.Viper, exporter sdktrace.SpanExporter) *sdktrace.TracerProvider {
+	serviceName := config.GetString("TRACING_SERVICE_NAME")
+	if serviceName == "" {
+		serviceName = config.GetString("APP_NAME")
+	}
+
+	ratio := 1.0
+	if config.IsSet("TRACING_SAMPLE_RATIO") {
+		ratio = config.GetFloat64("TRACING_SAMPLE_RATIO")
+	}
+
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+		sdktrace.WithResource(resource.NewSchemaless(
+			semconv.ServiceName(serviceName),
+			semconv.ServiceVersion(config.GetString("APP_VERSION")),
+			semconv.DeploymentEnvironment(config.GetString("APP_ENV")),
+		)),
+	)
+}
+
+func newExporter(config *viper.Viper) (sdktrace.SpanExporter, error) {
+	switch exporter := strings.ToLower(strings.TrimSpace(config.GetString("TRACING_EXPORTER"))); exporter {
+	case "", ExporterNone:
+		return nil, nil
+	case ExporterStdout:
+		return stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case ExporterOTLP:
+		// Tanpa TRACING_OTLP_ENDPOINT, exporter membaca OTEL_EXPORTER_OTLP_* dari environment
+		var options []otlptracehttp.Option
+		if endpoint := config.GetString("TRACING_OTLP_ENDPOINT"); endpoint != "" {
+			options = append(options, otlptracehttp.WithEndpoint(endpoint))
+		}
+		if config.GetBool("TRACING_OTLP_INSECURE") {
+			options = append(options, otlptracehttp.WithInsecure())
+		}
+		return otlptracehttp.New(context.Background(), options...)
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter: %s", exporter)
+	}
+}
+
+// Start buat child span dari span di ctx. Span request dari middleware juga di cari di
+// user value fasthttp, sehingga ctx.Context() dari handler bisa langsung dipakai
+func Start(ctx context.Context, name string, options ...trace.SpanStartOption) (context.Context, trace.Span) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		if span, ok := ctx.Value(SpanKey).(trace.Span); ok {
+			ctx = trace.ContextWithSpan(ctx, span)
+		}
+	}
+
+	return otel.Tracer(instrumentationName).Start(ctx, name, options...)
+}
+
+// SpanFromContext span aktif di ctx, termasuk span request yang disimpan middleware
+func SpanFromContext(ctx context.Context) trace.Span {
+	if span := trace.SpanFromContext(ctx); span.SpanContext().IsValid() {
+		return span
+	}
+
+	if span, ok := ctx.Value(SpanKey).(trace.Span); ok {
+		return span
+	}
+
+	return trace.SpanFromContext(ctx)
+}
+
+// End tutup span dan tandai error kalau ada
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// LogFields trace_id dan span_id untuk log zap, kosong kalau tidak ada span
+func LogFields(ctx context.Context) []zap.Field {
+	spanContext := SpanFromContext(ctx).SpanContext()
+	if !spanContext.IsValid() {
+		return nil
+	}
+
+	return []zap.Field{
+		zap.String("trace_id", spanContext.TraceID().String()),
+		zap.String("span_id", spanContext.SpanID().String()),
+	}
+}
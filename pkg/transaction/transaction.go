@@ -0,0 +1,40 @@
+package transaction
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type contextKey struct{}
+
+// Transactor jalankan beberapa operasi repository dalam satu transaction database.
+// Transaction dibawa lewat context sehingga usecase tidak perlu tahu soal gorm
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type gormTransactor struct {
+	db *gorm.DB
+}
+
+func NewTransactor(db *gorm.DB) Transactor {
+	return &gormTransactor{db: db}
+}
+
+// WithinTransaction commit kalau fn tidak error, selain itu rollback.
+// Transaction bersarang ikut transaction luar (savepoint dari gorm)
+func (t *gormTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return DB(ctx, t.db).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, contextKey{}, tx))
+	})
+}
+
+// DB return transaction yang sedang berjalan di context, atau fallback kalau tidak ada
+func DB(ctx context.Context, fallback *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(contextKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+
+	return fallback.WithContext(ctx)
+}
@@ -0,0 +1,229 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/apperror"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/response"
+	"github.com/go-playground/validator/v10"
+)
+
+// Code error per field, dipakai client untuk menampilkan pesan sendiri
+const (
+	CodeRequired     = "REQUIRED"
+	CodeTooShort     = "TOO_SHORT"
+	CodeTooLong      = "TOO_LONG"
+	CodeTooSmall     = "TOO_SMALL"
+	CodeTooLarge     = "TOO_LARGE"
+	CodeNotAllowed   = "NOT_ALLOWED"
+	CodeInvalidEmail = "INVALID_EMAIL"
+	CodeInvalidURL   = "INVALID_URL"
+	CodeInvalid      = "INVALID"
+
+	// CodeValidation error_code response jika field yang gagal tidak punya error domain
+	CodeValidation = "VALIDATION_ERROR"
+)
+
+// Rules error domain per field (nama json, tanpa index) lalu per tag validate, tag "*" berlaku
+// untuk tag lain di field tersebut. Field yang punya rule memakai code dan pesan error domain itu,
+// misal TITLE_REQUIRED, sehingga error_code yang sudah dipakai client tidak berubah
+type Rules map[string]map[string]*apperror.Error
+
+func (r Rules) lookup(field, tag string) *apperror.Error {
+	if index := strings.IndexByte(field, '['); index >= 0 {
+		field = field[:index]
+	}
+
+	tags := r[field]
+	if err, ok := tags[tag]; ok {
+		return err
+	}
+
+	return tags["*"]
+}
+
+var validate = newValidator()
+
+func newValidator() *validator.Validate {
+	v := validator.New(validator.WithRequiredStructEnabled())
+
+	// Nama field di error memakai tag json supaya sama dengan body request
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		if name == "" {
+			return field.Name
+		}
+		return name
+	})
+
+	return v
+}
+
+// Errors semua kegagalan validasi satu request, di render error handler sebagai 400 dengan array errors
+type Errors []response.FieldError
+
+func (e Errors) Error() string {
+	messages := make([]string, len(e))
+	for i, fieldErr := range e {
+		messages[i] = fieldErr.Message
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// Add tambah error untuk aturan yang tidak bisa di tulis sebagai tag validate
+func (e *Errors) Add(field, code, message string) {
+	e.add(field, code, message, map[string]string{"field": field})
+}
+
+// AddError sama seperti Add dengan code dan pesan dari error domain
+func (e *Errors) AddError(field string, err *apperror.Error) {
+	*e = append(*e, response.FieldError{
+		Field:     field,
+		Code:      err.Code,
+		Message:   err.Message,
+		Params:    map[string]string{"field": field},
+		MessageID: err.ID,
+	})
+}
+
+func (e *Errors) add(field, code, message string, params map[string]string) {
+	*e = append(*e, response.FieldError{Field: field, Code: code, Message: message, Params: params})
+}
+
+// Has cek apakah field sudah punya error, supaya cek tambahan tidak menduplikasi error dari tag
+func (e Errors) Has(field string) bool {
+	for _, fieldErr := range e {
+		if fieldErr.Field == field {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Code error_code response. Jika field pertama yang gagal memakai error domain, code itu yang dipakai
+// (sama seperti sebelum semua field di validasi sekaligus), selain itu CodeValidation
+func (e Errors) Code() string {
+	if len(e) == 0 || isFieldCode(e[0].Code) {
+		return CodeValidation
+	}
+
+	return e[0].Code
+}
+
+func isFieldCode(code string) bool {
+	switch code {
+	case CodeRequired, CodeTooShort, CodeTooLong, CodeTooSmall, CodeTooLarge,
+		CodeNotAllowed, CodeInvalidEmail, CodeInvalidURL, CodeInvalid:
+		return true
+	}
+
+	return false
+}
+
+// Err nil kalau tidak ada error, supaya hasilnya aman di return sebagai error
+func (e Errors) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+
+	return e
+}
+
+// Struct jalankan semua tag validate dan kumpulkan setiap field yang gagal, bukan hanya yang pertama
+func Struct(s any) Errors {
+	return StructWithRules(s, nil)
+}
+
+// StructWithRules sama seperti Struct, field yang punya rule memakai code dan pesan error domain
+func StructWithRules(s any, rules Rules) Errors {
+	err := validate.Struct(s)
+	if err == nil {
+		return nil
+	}
+
+	validationErrs, ok := err.(validator.ValidationErrors)
+	if !ok {
+		return Errors{{Field: "", Code: CodeInvalid, Message: err.Error()}}
+	}
+
+	errs := make(Errors, 0, len(validationErrs))
+	for _, fieldErr := range validationErrs {
+		field := fieldName(fieldErr)
+		if domainErr := rules.lookup(field, fieldErr.Tag()); domainErr != nil {
+			errs.AddError(field, domainErr)
+			continue
+		}
+
+		code, message, params := describe(field, fieldErr)
+		errs.add(field, code, message, params)
+	}
+
+	return errs
+}
+
+// fieldName namespace tanpa nama struct, misal "reviewers[0]"
+func fieldName(fieldErr validator.FieldError) string {
+	_, field, found := strings.Cut(fieldErr.Namespace(), ".")
+	if !found {
+		return fieldErr.Field()
+	}
+
+	return field
+}
+
+// describe code, pesan English dan params (field, param, unit) untuk menerjemahkan pesan
+func describe(field string, fieldErr validator.FieldError) (string, string, map[string]string) {
+	param := fieldErr.Param()
+	countable := isCountable(fieldErr.Kind())
+	params := map[string]string{"field": field, "param": param}
+
+	switch fieldErr.Tag() {
+	case "required":
+		return CodeRequired, fmt.Sprintf("%s is required", field), params
+	case "min":
+		if countable {
+			params["unit"] = unit(fieldErr.Kind())
+			return CodeTooShort, fmt.Sprintf("%s must be at least %s %s", field, param, params["unit"]), params
+		}
+		return CodeTooSmall, fmt.Sprintf("%s must be at least %s", field, param), params
+	case "max":
+		if countable {
+			params["unit"] = unit(fieldErr.Kind())
+			return CodeTooLong, fmt.Sprintf("%s must be at most %s %s", field, param, params["unit"]), params
+		}
+		return CodeTooLarge, fmt.Sprintf("%s must be at most %s", field, param), params
+	case "oneof":
+		params["param"] = strings.Join(strings.Fields(param), ", ")
+		return CodeNotAllowed, fmt.Sprintf("%s must be one of: %s", field, params["param"]), params
+	case "email":
+		return CodeInvalidEmail, fmt.Sprintf("%s must be a valid email address", field), params
+	case "url":
+		return CodeInvalidURL, fmt.Sprintf("%s must be a valid URL", field), params
+	default:
+		return CodeInvalid, fmt.Sprintf("%s is invalid", field), params
+	}
+}
+
+func isCountable(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+		return true
+	}
+
+	return false
+}
+
+func unit(kind reflect.Kind) string {
+	if kind == reflect.String {
+		return "characters"
+	}
+
+	return "items"
+}
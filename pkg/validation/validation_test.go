@@ -0,0 +1,184 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/apperror"
+	"github.com/enrichoalkalas01/test-sharing-vision-golang/pkg/common/response"
+)
+
+type sampleRequest struct {
+	Title   string   `json:"title" validate:"required,min=3,max=10"`
+	Email   string   `json:"email" validate:"omitempty,email"`
+	Website string   `json:"website" validate:"omitempty,url"`
+	Status  string   `json:"status" validate:"omitempty,oneof=Publish Draft"`
+	Count   int      `json:"count" validate:"omitempty,min=1,max=5"`
+	Tags    []string `json:"tags" validate:"omitempty,max=2,dive,required,max=5"`
+	NoTag   string   `validate:"omitempty,max=1"`
+}
+
+func TestStruct(t *testing.T) {
+	valid := sampleRequest{Title: "Hello"}
+
+	tests := []struct {
+		name   string
+		modify func(r *sampleRequest)
+		want   []response.FieldError
+	}{
+		{name: "valid request", modify: func(*sampleRequest) {}},
+		{
+			name:   "required",
+			modify: func(r *sampleRequest) { r.Title = "" },
+			want:   []response.FieldError{{Field: "title", Code: CodeRequired, Message: "title is required"}},
+		},
+		{
+			name:   "string too short",
+			modify: func(r *sampleRequest) { r.Title = "Hi" },
+			want:   []response.FieldError{{Field: "title", Code: CodeTooShort, Message: "title must be at least 3 characters"}},
+		},
+		{
+			name:   "number out of range",
+			modify: func(r *sampleRequest) { r.Count = 9 },
+			want:   []response.FieldError{{Field: "count", Code: CodeTooLarge, Message: "count must be at most 5"}},
+		},
+		{
+			name:   "oneof lists the allowed values",
+			modify: func(r *sampleRequest) { r.Status = "Thrash" },
+			want:   []response.FieldError{{Field: "status", Code: CodeNotAllowed, Message: "status must be one of: Publish, Draft"}},
+		},
+		{
+			name:   "email and url",
+			modify: func(r *sampleRequest) { r.Email = "not-an-email"; r.Website = "nope" },
+			want: []response.FieldError{
+				{Field: "email", Code: CodeInvalidEmail, Message: "email must be a valid email address"},
+				{Field: "website", Code: CodeInvalidURL, Message: "website must be a valid URL"},
+			},
+		},
+		{
+			name:   "slice items keep their index",
+			modify: func(r *sampleRequest) { r.Tags = []string{"go", "toolong"} },
+			want:   []response.FieldError{{Field: "tags[1]", Code: CodeTooLong, Message: "tags[1] must be at most 5 characters"}},
+		},
+		{
+			name:   "slice length uses items",
+			modify: func(r *sampleRequest) { r.Tags = []string{"a", "b", "c"} },
+			want:   []response.FieldError{{Field: "tags", Code: CodeTooLong, Message: "tags must be at most 2 items"}},
+		},
+		{
+			name:   "every failing field is reported",
+			modify: func(r *sampleRequest) { r.Title = ""; r.Status = "x"; r.NoTag = "xx" },
+			want: []response.FieldError{
+				{Field: "title", Code: CodeRequired, Message: "title is required"},
+				{Field: "status", Code: CodeNotAllowed, Message: "status must be one of: Publish, Draft"},
+				{Field: "NoTag", Code: CodeTooLong, Message: "NoTag must be at most 1 characters"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := valid
+			tt.modify(&request)
+
+			got := Struct(&request)
+			assertFieldErrors(t, got, tt.want)
+
+			if (got.Err() == nil) != (len(tt.want) == 0) {
+				t.Fatalf("Err() = %v, want error %v", got.Err(), len(tt.want) > 0)
+			}
+		})
+	}
+}
+
+func TestStructWithRules(t *testing.T) {
+	errTitleRequired := apperror.Validation("TITLE_REQUIRED", "title is required")
+	errTitleLength := apperror.Validation("TITLE_INVALID", "title must be between 3 and 10 characters")
+	errTag := apperror.Validation("TAG_INVALID", "tags are invalid")
+
+	rules := Rules{
+		"title": {"required": errTitleRequired, "*": errTitleLength},
+		"tags":  {"*": errTag},
+	}
+
+	tests := []struct {
+		name     string
+		request  sampleRequest
+		want     []response.FieldError
+		wantCode string
+	}{
+		{
+			name:     "tag specific rule",
+			request:  sampleRequest{},
+			want:     []response.FieldError{{Field: "title", Code: "TITLE_REQUIRED", Message: "title is required"}},
+			wantCode: "TITLE_REQUIRED",
+		},
+		{
+			name:     "wildcard rule covers other tags",
+			request:  sampleRequest{Title: "This title is too long"},
+			want:     []response.FieldError{{Field: "title", Code: "TITLE_INVALID", Message: "title must be between 3 and 10 characters"}},
+			wantCode: "TITLE_INVALID",
+		},
+		{
+			name:     "indexed field uses the rule of the slice",
+			request:  sampleRequest{Title: "Hello", Tags: []string{"toolong"}},
+			want:     []response.FieldError{{Field: "tags[0]", Code: "TAG_INVALID", Message: "tags are invalid"}},
+			wantCode: "TAG_INVALID",
+		},
+		{
+			name:     "field without rule keeps the generic code",
+			request:  sampleRequest{Title: "Hello", Status: "x"},
+			want:     []response.FieldError{{Field: "status", Code: CodeNotAllowed, Message: "status must be one of: Publish, Draft"}},
+			wantCode: CodeValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := StructWithRules(&tt.request, rules)
+			assertFieldErrors(t, got, tt.want)
+
+			if code := got.Code(); code != tt.wantCode {
+				t.Errorf("Code() = %q, want %q", code, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestErrors(t *testing.T) {
+	var errs Errors
+	if errs.Err() != nil {
+		t.Fatal("empty Errors must return a nil error")
+	}
+	if code := errs.Code(); code != CodeValidation {
+		t.Fatalf("Code() of empty Errors = %q, want %q", code, CodeValidation)
+	}
+
+	errs.Add("comment", CodeRequired, "comment is required")
+	errs.AddError("reviewers[0]", apperror.Validation("REVIEWER_INVALID", "author cannot review their own article"))
+
+	if !errs.Has("comment") || !errs.Has("reviewers[0]") || errs.Has("note") {
+		t.Fatalf("Has() does not match the added fields: %+v", errs)
+	}
+	if code := errs.Code(); code != CodeValidation {
+		t.Fatalf("Code() = %q, want %q when the first field has a generic code", code, CodeValidation)
+	}
+	if got, want := errs.Error(), "comment is required; author cannot review their own article"; got != want {
+		t.Fatalf("Error() = %q, want %q", got, want)
+	}
+	if errs[1].Code != "REVIEWER_INVALID" || errs[1].Params["field"] != "reviewers[0]" {
+		t.Fatalf("AddError() = %+v", errs[1])
+	}
+}
+
+func assertFieldErrors(t *testing.T, got Errors, want []response.FieldError) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d errors %+v, want %d %+v", len(got), got, len(want), want)
+	}
+	for i := range want {
+		if got[i].Field != want[i].Field || got[i].Code != want[i].Code || got[i].Message != want[i].Message {
+			t.Errorf("error[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
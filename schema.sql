@@ -1,9 +1,201 @@
 CREATE TABLE posts (
     id INT AUTO_INCREMENT PRIMARY KEY,
+    tenant_id VARCHAR(64) NOT NULL DEFAULT 'default',
     title VARCHAR(200) NOT NULL,
+    slug VARCHAR(220),
     content TEXT NOT NULL,
+    content_format VARCHAR(20) NOT NULL DEFAULT 'markdown' CHECK (content_format IN ('markdown', 'html', 'plain')),
+    content_html MEDIUMTEXT,
+    excerpt VARCHAR(500),
+    word_count INT NOT NULL DEFAULT 0,
+    reading_time INT NOT NULL DEFAULT 0,
+    cover_media_id INT NULL,
+    approved_at TIMESTAMP NULL,
     category VARCHAR(100),
     created_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     updated_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
-    status VARCHAR(100) CHECK (status IN ('Publish', 'Draft', 'Thrash'))
-);
\ No newline at end of file
+    status VARCHAR(100) CHECK (status IN ('Publish', 'Draft', 'In Review', 'Thrash')),
+    UNIQUE KEY uq_posts_tenant_slug (tenant_id, slug),
+    UNIQUE KEY uq_posts_tenant_title (tenant_id, title),
+    INDEX idx_posts_tenant_status (tenant_id, status)
+);
+
+CREATE TABLE media (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    tenant_id VARCHAR(64) NOT NULL DEFAULT 'default',
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size BIGINT NOT NULL,
+    storage_key VARCHAR(500) NOT NULL,
+    url VARCHAR(1000) NOT NULL,
+    thumbnail_key VARCHAR(500),
+    thumbnail_url VARCHAR(1000),
+    width INT NOT NULL DEFAULT 0,
+    height INT NOT NULL DEFAULT 0,
+    created_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_media_tenant (tenant_id)
+);
+
+CREATE TABLE article_attachments (
+    article_id INT NOT NULL,
+    media_id INT NOT NULL,
+    position INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (article_id, media_id),
+    FOREIGN KEY (article_id) REFERENCES posts(id) ON DELETE CASCADE,
+    FOREIGN KEY (media_id) REFERENCES media(id) ON DELETE CASCADE
+);
+
+ALTER TABLE posts ADD CONSTRAINT fk_posts_cover_media FOREIGN KEY (cover_media_id) REFERENCES media(id) ON DELETE SET NULL;
+
+CREATE TABLE comments (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    tenant_id VARCHAR(64) NOT NULL DEFAULT 'default',
+    article_id INT NOT NULL,
+    parent_id INT NULL,
+    author_name VARCHAR(100) NOT NULL,
+    author_email VARCHAR(255),
+    content TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')),
+    created_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX idx_comments_article_status (article_id, status, created_date),
+    INDEX idx_comments_tenant_status (tenant_id, status),
+    FOREIGN KEY (article_id) REFERENCES posts(id) ON DELETE CASCADE,
+    FOREIGN KEY (parent_id) REFERENCES comments(id) ON DELETE CASCADE
+);
+
+CREATE TABLE article_translations (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    tenant_id VARCHAR(64) NOT NULL DEFAULT 'default',
+    article_id INT NOT NULL,
+    locale VARCHAR(10) NOT NULL,
+    title VARCHAR(200) NOT NULL,
+    content TEXT NOT NULL,
+    content_format VARCHAR(20) NOT NULL DEFAULT 'markdown' CHECK (content_format IN ('markdown', 'html', 'plain')),
+    content_html MEDIUMTEXT,
+    excerpt VARCHAR(500),
+    word_count INT NOT NULL DEFAULT 0,
+    reading_time INT NOT NULL DEFAULT 0,
+    status VARCHAR(100) CHECK (status IN ('Publish', 'Draft', 'Thrash')),
+    created_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uq_article_translations_locale (article_id, locale),
+    UNIQUE KEY uq_article_translations_title (tenant_id, locale, title),
+    FOREIGN KEY (article_id) REFERENCES posts(id) ON DELETE CASCADE
+);
+
+CREATE TABLE series (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    tenant_id VARCHAR(64) NOT NULL DEFAULT 'default',
+    title VARCHAR(200) NOT NULL,
+    slug VARCHAR(220) NOT NULL,
+    description TEXT,
+    created_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uq_series_tenant_slug (tenant_id, slug)
+);
+
+CREATE TABLE series_articles (
+    series_id INT NOT NULL,
+    article_id INT NOT NULL,
+    position INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (series_id, article_id),
+    INDEX idx_series_articles_article (article_id),
+    FOREIGN KEY (series_id) REFERENCES series(id) ON DELETE CASCADE,
+    FOREIGN KEY (article_id) REFERENCES posts(id) ON DELETE CASCADE
+);
+
+CREATE TABLE article_reviews (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    article_id INT NOT NULL,
+    round INT NOT NULL,
+    author VARCHAR(100) NOT NULL,
+    reviewer VARCHAR(100) NOT NULL,
+    status VARCHAR(30) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'changes_requested', 'cancelled')),
+    note TEXT,
+    comment TEXT,
+    created_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    decided_date TIMESTAMP NULL,
+    INDEX idx_article_reviews_article (article_id, round),
+    FOREIGN KEY (article_id) REFERENCES posts(id) ON DELETE CASCADE
+);
+
+CREATE TABLE audit_logs (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    tenant_id VARCHAR(64) NOT NULL DEFAULT 'default',
+    actor VARCHAR(255) NOT NULL,
+    request_id VARCHAR(100),
+    ip VARCHAR(45),
+    action VARCHAR(20) NOT NULL CHECK (action IN ('create', 'update', 'delete')),
+    entity VARCHAR(50) NOT NULL,
+    entity_id INT NOT NULL,
+    `before` JSON NULL,
+    `after` JSON NULL,
+    created_date TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    INDEX idx_audit_logs_tenant_date (tenant_id, created_date),
+    INDEX idx_audit_logs_entity (tenant_id, entity, entity_id),
+    INDEX idx_audit_logs_actor (tenant_id, actor, created_date)
+);
+
+-- Audit log append-only, update dan delete ditolak di level database
+DELIMITER //
+CREATE TRIGGER audit_logs_no_update BEFORE UPDATE ON audit_logs
+FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'audit_logs is append-only'//
+CREATE TRIGGER audit_logs_no_delete BEFORE DELETE ON audit_logs
+FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'audit_logs is append-only'//
+DELIMITER ;
+
+-- Transactional outbox, domain event ditulis dalam transaction yang sama dengan perubahan posts
+CREATE TABLE outbox_events (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    event_id CHAR(36) NOT NULL,
+    tenant_id VARCHAR(64) NOT NULL DEFAULT 'default',
+    event_type VARCHAR(100) NOT NULL,
+    aggregate_type VARCHAR(50) NOT NULL,
+    aggregate_id INT NOT NULL,
+    payload JSON NULL,
+    occurred_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    published_at TIMESTAMP(6) NULL,
+    failed_at TIMESTAMP(6) NULL,
+    last_error TEXT,
+    UNIQUE KEY uq_outbox_events_event_id (event_id),
+    INDEX idx_outbox_events_due (published_at, failed_at, next_attempt_at),
+    INDEX idx_outbox_events_aggregate (tenant_id, aggregate_type, aggregate_id, id)
+);
+
+-- Webhook subscription partner, event_types berisi JSON array tipe event atau ["*"]
+CREATE TABLE webhook_subscriptions (
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    tenant_id VARCHAR(64) NOT NULL DEFAULT 'default',
+    url VARCHAR(2048) NOT NULL,
+    event_types JSON NOT NULL,
+    secret VARCHAR(255) NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX idx_webhook_subscriptions_tenant (tenant_id, active)
+);
+
+-- Satu baris per event per subscription, sekaligus menjadi delivery log
+CREATE TABLE webhook_deliveries (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    tenant_id VARCHAR(64) NOT NULL DEFAULT 'default',
+    subscription_id INT NOT NULL,
+    event_id CHAR(36) NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    payload JSON NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'delivered', 'dead')),
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    last_status_code INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    delivered_at TIMESTAMP(6) NULL,
+    created_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uq_webhook_deliveries_event (subscription_id, event_id),
+    INDEX idx_webhook_deliveries_due (status, next_attempt_at),
+    INDEX idx_webhook_deliveries_log (subscription_id, created_date),
+    FOREIGN KEY (subscription_id) REFERENCES webhook_subscriptions(id) ON DELETE CASCADE
+);